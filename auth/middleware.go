@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const principalKey = "auth.principal"
+
+// Claims are the JWT claims understood by the API
+type Claims struct {
+	Roles []string `json:"roles"`
+	// Tenant binds the token to one tenant
+	Tenant string `json:"tenant,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Middleware resolves the request principal from an HS256 bearer token, or
+// from the access_token query parameter of a WebSocket upgrade request.
+// Requests without a token run as Anonymous; invalid tokens are rejected
+// with 401. With an empty secret authentication is disabled and every
+// request runs as an admin, which keeps local development friction free.
+func Middleware(secret []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(secret) == 0 {
+			SetPrincipal(c, Developer)
+			c.Next()
+			return
+		}
+
+		header := c.GetHeader("Authorization")
+		if header == "" && isWebSocketUpgrade(c) && c.Query("access_token") != "" {
+			// Browsers cannot set headers on WebSocket requests
+			header = "Bearer " + c.Query("access_token")
+		}
+		if header == "" {
+			SetPrincipal(c, Anonymous)
+			c.Next()
+			return
+		}
+
+		principal, err := ParseToken(strings.TrimPrefix(header, "Bearer "), secret)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		SetPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// ParseToken validates an HS256 token and returns its principal
+func ParseToken(token string, secret []byte) (Principal, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return Principal{}, errors.New("invalid token: " + err.Error())
+	}
+	return Principal{Subject: claims.Subject, Roles: claims.Roles, Tenant: claims.Tenant}, nil
+}
+
+// SetPrincipal attaches the principal to the request and to its context
+func SetPrincipal(c *gin.Context, principal Principal) {
+	c.Set(principalKey, principal)
+	c.Request = c.Request.WithContext(NewContext(c.Request.Context(), principal))
+}
+
+// FromContext returns the principal resolved by Middleware, or Anonymous
+// when the middleware did not run
+func FromContext(c *gin.Context) Principal {
+	if value, ok := c.Get(principalKey); ok {
+		if principal, ok := value.(Principal); ok {
+			return principal
+		}
+	}
+	return Anonymous
+}
+
+// isWebSocketUpgrade reports whether the request asks to switch to the WebSocket protocol
+func isWebSocketUpgrade(c *gin.Context) bool {
+	return strings.EqualFold(c.GetHeader("Upgrade"), "websocket")
+}
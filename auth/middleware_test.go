@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/suite"
+)
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+	secret []byte
+	r      *gin.Engine
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.secret = []byte("test-secret")
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.New()
+	suite.r.Use(Middleware(suite.secret))
+	suite.r.GET("/whoami", func(c *gin.Context) {
+		principal := FromContext(c)
+		c.JSON(http.StatusOK, gin.H{"subject": principal.Subject, "override": principal.Can(PermSalaryOverride)})
+	})
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) token(secret []byte, subject string, roles ...string) string {
+	claims := Claims{Roles: roles, RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+	suite.Require().NoError(err)
+	return signed
+}
+
+func (suite *MiddlewareTestSuite) whoami(authorization string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/whoami", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *MiddlewareTestSuite) TestValidToken() {
+	w := suite.whoami("Bearer " + suite.token(suite.secret, "hr-lead", RoleHR))
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"subject":"hr-lead","override":true}`, w.Body.String())
+
+	w = suite.whoami("Bearer " + suite.token(suite.secret, "dev1", RoleEmployee))
+	suite.JSONEq(`{"subject":"dev1","override":false}`, w.Body.String())
+}
+
+func (suite *MiddlewareTestSuite) TestMissingToken() {
+	w := suite.whoami("")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"subject":"anonymous","override":false}`, w.Body.String())
+}
+
+func (suite *MiddlewareTestSuite) TestInvalidToken() {
+	w := suite.whoami("Bearer " + suite.token([]byte("other-secret"), "mallory", RoleAdmin))
+	suite.Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestDisabledWithoutSecret() {
+	r := gin.New()
+	r.Use(Middleware(nil))
+	r.GET("/whoami", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"admin": FromContext(c).HasRole(RoleAdmin)})
+	})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/whoami", nil)
+	r.ServeHTTP(w, req)
+	suite.JSONEq(`{"admin":true}`, w.Body.String())
+}
+
+func (suite *MiddlewareTestSuite) TestWebSocketQueryToken() {
+	url := "/whoami?access_token=" + suite.token(suite.secret, "hr-lead", RoleHR)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	suite.r.ServeHTTP(w, req)
+	suite.JSONEq(`{"subject":"hr-lead","override":true}`, w.Body.String())
+
+	// Other requests must send the token in the Authorization header
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", url, nil)
+	suite.r.ServeHTTP(w, req)
+	suite.JSONEq(`{"subject":"anonymous","override":false}`, w.Body.String())
+}
+
+func (suite *MiddlewareTestSuite) TestTenantClaim() {
+	claims := Claims{Roles: []string{RoleHR}, Tenant: "acme", RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   "acme-hr",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(suite.secret)
+	suite.Require().NoError(err)
+
+	principal, err := ParseToken(signed, suite.secret)
+	suite.NoError(err)
+	suite.Equal("acme", principal.Tenant)
+
+	principal, err = ParseToken(suite.token(suite.secret, "hr-lead", RoleHR), suite.secret)
+	suite.NoError(err)
+	suite.Empty(principal.Tenant)
+}
@@ -0,0 +1,33 @@
+// Package cache holds the caches behind the repository caching decorators:
+// the Cache interface an external cache such as Redis or memcached plugs
+// into, an in-process LRU implementation and request coalescing for loads.
+package cache
+
+import "time"
+
+// Cache stores encoded values under string keys. Implementations must be
+// safe for concurrent use. Errors are reported so callers can fall back to
+// the source of truth; a miss is not an error.
+type Cache interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, ttl time.Duration) error
+	Delete(keys ...string) error
+}
+
+// Stats counts how a cache has been used. Waiting is how many misses are
+// currently waiting on another caller's load of the same entry.
+type Stats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions,omitempty"`
+	Size      int    `json:"size,omitempty"`
+	Waiting   int    `json:"waiting"`
+}
+
+// HitRatio is the share of lookups served from the cache
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// DefaultLRUCapacity is how many entries an LRU holds when no capacity is given
+const DefaultLRUCapacity = 1000
+
+// LRU is an in-process Cache holding at most a fixed number of entries.
+// Entries expire after their TTL, and when the cache is full the least
+// recently used entry is evicted to make room.
+type LRU struct {
+	mu        sync.Mutex
+	capacity  int
+	entries   map[string]*list.Element
+	order     *list.List // front is the most recently used
+	evictions uint64
+	now       func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRU creates an LRU holding at most capacity entries. A non-positive
+// capacity uses DefaultLRUCapacity.
+func NewLRU(capacity int) *LRU {
+	if capacity <= 0 {
+		capacity = DefaultLRUCapacity
+	}
+	return &LRU{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+func (c *LRU) Get(key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*lruEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false, nil
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+// Set stores the value for ttl. A non-positive ttl does not store it.
+func (c *LRU) Set(key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := c.now().Add(ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return nil
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	if c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+		c.evictions++
+	}
+	return nil
+}
+
+func (c *LRU) Delete(keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if element, ok := c.entries[key]; ok {
+			c.remove(element)
+		}
+	}
+	return nil
+}
+
+// Len returns how many entries are held, including expired ones not yet removed
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Evictions returns how many entries were evicted to make room
+func (c *LRU) Evictions() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictions
+}
+
+func (c *LRU) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*lruEntry).key)
+}
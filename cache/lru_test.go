@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LRUTestSuite struct {
+	suite.Suite
+	now time.Time
+	lru *LRU
+}
+
+func (suite *LRUTestSuite) SetupTest() {
+	suite.now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	suite.lru = NewLRU(2)
+	suite.lru.now = func() time.Time { return suite.now }
+}
+
+func TestLRUTestSuite(t *testing.T) {
+	suite.Run(t, new(LRUTestSuite))
+}
+
+func (suite *LRUTestSuite) get(key string) string {
+	value, ok, err := suite.lru.Get(key)
+	suite.NoError(err)
+	if !ok {
+		return ""
+	}
+	return string(value)
+}
+
+func (suite *LRUTestSuite) TestEvictsLeastRecentlyUsed() {
+	suite.NoError(suite.lru.Set("a", []byte("1"), time.Minute))
+	suite.NoError(suite.lru.Set("b", []byte("2"), time.Minute))
+	suite.Equal("1", suite.get("a"))
+	suite.NoError(suite.lru.Set("c", []byte("3"), time.Minute))
+
+	suite.Equal("1", suite.get("a"))
+	suite.Empty(suite.get("b"), "b was used least recently")
+	suite.Equal("3", suite.get("c"))
+	suite.Equal(2, suite.lru.Len())
+	suite.Equal(uint64(1), suite.lru.Evictions())
+}
+
+func (suite *LRUTestSuite) TestEntriesExpire() {
+	suite.NoError(suite.lru.Set("a", []byte("1"), time.Minute))
+	suite.now = suite.now.Add(59 * time.Second)
+	suite.Equal("1", suite.get("a"))
+	suite.now = suite.now.Add(time.Second)
+	suite.Empty(suite.get("a"))
+	suite.Equal(0, suite.lru.Len(), "expired entries are removed when read")
+
+	suite.NoError(suite.lru.Set("b", []byte("2"), 0))
+	suite.Empty(suite.get("b"))
+}
+
+func (suite *LRUTestSuite) TestSetReplacesAndDeleteRemoves() {
+	suite.NoError(suite.lru.Set("a", []byte("1"), time.Minute))
+	suite.NoError(suite.lru.Set("a", []byte("2"), time.Minute))
+	suite.Equal("2", suite.get("a"))
+	suite.Equal(1, suite.lru.Len())
+
+	suite.NoError(suite.lru.Delete("a", "missing"))
+	suite.Empty(suite.get("a"))
+}
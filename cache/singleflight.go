@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+)
+
+// errLoadPanicked is returned to callers waiting on a load that panicked
+var errLoadPanicked = errors.New("cache: load panicked")
+
+// Group coalesces concurrent loads of the same key, so a burst of misses
+// for one entry reaches the source once instead of stampeding it
+type Group struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	done chan struct{}
+	// waiters counts the callers waiting on this load besides the one running it
+	waiters int
+	value   interface{}
+	err     error
+}
+
+// Do runs load for the key unless a load for it is already running, in
+// which case it waits for that one and returns its result. shared reports
+// whether the result came from another caller's load.
+func (g *Group) Do(key string, load func() (interface{}, error)) (value interface{}, shared bool, err error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	if c, ok := g.calls[key]; ok {
+		c.waiters++
+		g.mu.Unlock()
+		<-c.done
+		return c.value, true, c.err
+	}
+	c := &call{done: make(chan struct{})}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	returned := false
+	defer func() {
+		if !returned {
+			c.err = errLoadPanicked
+		}
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(c.done)
+	}()
+	c.value, c.err = load()
+	returned = true
+	return c.value, false, c.err
+}
+
+// Waiting returns how many callers are waiting on a load run by another caller
+func (g *Group) Waiting() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	waiting := 0
+	for _, c := range g.calls {
+		waiting += c.waiters
+	}
+	return waiting
+}
@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GroupTestSuite struct {
+	suite.Suite
+	group Group
+}
+
+func TestGroupTestSuite(t *testing.T) {
+	suite.Run(t, new(GroupTestSuite))
+}
+
+func (suite *GroupTestSuite) TestConcurrentCallsShareOneLoad() {
+	var loads atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var wg sync.WaitGroup
+	results := make([]interface{}, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			value, _, err := suite.group.Do("key", func() (interface{}, error) {
+				loads.Add(1)
+				close(started)
+				<-release
+				return "value", nil
+			})
+			suite.NoError(err)
+			results[i] = value
+		}(i)
+		if i == 0 {
+			<-started
+		}
+	}
+	// Wait for the other callers to join the running load
+	for suite.group.Waiting() < 4 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	suite.Equal(0, suite.group.Waiting())
+	suite.Equal(int32(1), loads.Load())
+	for _, value := range results {
+		suite.Equal("value", value)
+	}
+}
+
+func (suite *GroupTestSuite) TestLoadsAfterCompletionRunAgain() {
+	_, shared, err := suite.group.Do("key", func() (interface{}, error) { return nil, errors.New("boom") })
+	suite.EqualError(err, "boom")
+	suite.False(shared)
+
+	value, _, err := suite.group.Do("key", func() (interface{}, error) { return 1, nil })
+	suite.NoError(err)
+	suite.Equal(1, value)
+}
+
+func (suite *GroupTestSuite) TestPanickingLoadFailsWaiters() {
+	suite.Panics(func() {
+		suite.group.Do("key", func() (interface{}, error) { panic("boom") })
+	})
+	_, _, err := suite.group.Do("key", func() (interface{}, error) { return 1, nil })
+	suite.NoError(err, "the key is usable again")
+}
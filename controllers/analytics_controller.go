@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// AnalyticsController defines the interface for analytics controller
+type AnalyticsController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetHeadcount(c *gin.Context)
+	GetSalaryStats(c *gin.Context)
+	GetMovements(c *gin.Context)
+	GetTenureDistribution(c *gin.Context)
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// analyticsControllerImpl is the concrete implementation of AnalyticsController
+// (see analytics_controller.go for the interface definition)
+type analyticsControllerImpl struct {
+	analyticsService service.AnalyticsService
+}
+
+// NewAnalyticsController creates a new instance of AnalyticsController
+func NewAnalyticsController(analyticsService service.AnalyticsService) AnalyticsController {
+	return &analyticsControllerImpl{
+		analyticsService: analyticsService,
+	}
+}
+
+// RegisterRoutes registers the analytics routes with the given router group.
+func (ac *analyticsControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	analytics := router.Group("/analytics", requireAcceptable)
+	{
+		analytics.GET("/headcount", ac.GetHeadcount)
+		analytics.GET("/salaries", ac.GetSalaryStats)
+		analytics.GET("/movements", ac.GetMovements)
+		analytics.GET("/tenure", ac.GetTenureDistribution)
+	}
+}
+
+// GetHeadcount handles GET request to count employees per group
+// @Summary Get headcount
+// @Description Counts employees per position, department or status, largest group first. Terminated employees are left out unless include_terminated is set.
+// @Tags analytics
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param group_by query string false "Attribute to group by (default department)" Enums(position, department, status)
+// @Param include_terminated query bool false "Count terminated employees too"
+// @Success 200 {array} models.HeadcountGroup
+// @Failure 400 {object} map[string]interface{} "Invalid query parameters"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /analytics/headcount [get]
+func (ac *analyticsControllerImpl) GetHeadcount(c *gin.Context) {
+	var filter models.AnalyticsFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	groups, err := ac.analyticsService.GetHeadcount(c.Request.Context(), filter)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, groups)
+}
+
+// GetSalaryStats handles GET request to summarise salaries per group
+// @Summary Get salary statistics
+// @Description Returns the minimum, maximum, mean, median and 25th, 75th and 90th percentile salary per position, department or status and currency. Requires the salary:read permission.
+// @Tags analytics
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param group_by query string false "Attribute to group by (default department)" Enums(position, department, status)
+// @Param include_terminated query bool false "Include terminated employees"
+// @Success 200 {array} models.SalaryStats
+// @Failure 400 {object} map[string]interface{} "Invalid query parameters"
+// @Failure 403 {object} map[string]interface{} "Missing the salary:read permission"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /analytics/salaries [get]
+func (ac *analyticsControllerImpl) GetSalaryStats(c *gin.Context) {
+	if !auth.FromContext(c).Can(auth.PermSalaryRead) {
+		respond(c, http.StatusForbidden, gin.H{"error": "Salary statistics require the " + string(auth.PermSalaryRead) + " permission"})
+		return
+	}
+	var filter models.AnalyticsFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stats, err := ac.analyticsService.GetSalaryStats(c.Request.Context(), filter)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, stats)
+}
+
+// GetMovements handles GET request to count hires and terminations per month
+// @Summary Get hires and terminations per month
+// @Description Counts hires by join date and terminations by termination date for every month in the range, including months without any. Without from or to, the range starts or ends at the first or last month with a movement.
+// @Tags analytics
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param from query string false "First month, YYYY-MM"
+// @Param to query string false "Last month, YYYY-MM"
+// @Success 200 {array} models.MonthlyMovement
+// @Failure 400 {object} map[string]interface{} "Invalid month range"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /analytics/movements [get]
+func (ac *analyticsControllerImpl) GetMovements(c *gin.Context) {
+	var filter models.MovementFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	movements, err := ac.analyticsService.GetMovements(c.Request.Context(), filter)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, movements)
+}
+
+// GetTenureDistribution handles GET request to count employees per tenure bucket
+// @Summary Get tenure distribution
+// @Description Counts employees by years since their join date, up to today or their termination date. Employees without a join date are left out, and terminated employees unless include_terminated is set.
+// @Tags analytics
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param include_terminated query bool false "Include terminated employees"
+// @Success 200 {array} models.TenureBucket
+// @Failure 400 {object} map[string]interface{} "Invalid query parameters"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /analytics/tenure [get]
+func (ac *analyticsControllerImpl) GetTenureDistribution(c *gin.Context) {
+	var filter models.AnalyticsFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	buckets, err := ac.analyticsService.GetTenureDistribution(c.Request.Context(), filter)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, buckets)
+}
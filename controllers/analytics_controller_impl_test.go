@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type AnalyticsControllerTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	svc       *mocks.MockAnalyticsService
+	r         *gin.Engine
+	principal auth.Principal
+}
+
+func (suite *AnalyticsControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockAnalyticsService(suite.ctrl)
+	suite.principal = auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	controller := &analyticsControllerImpl{analyticsService: suite.svc}
+	v1 := suite.r.Group("/api/v1")
+	controller.RegisterRoutes(v1)
+}
+
+func (suite *AnalyticsControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestAnalyticsControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsControllerTestSuite))
+}
+
+func (suite *AnalyticsControllerTestSuite) get(url string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", url, nil)
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *AnalyticsControllerTestSuite) TestGetHeadcountHandler() {
+	filter := models.AnalyticsFilter{GroupBy: models.GroupByStatus, IncludeTerminated: true}
+	suite.svc.EXPECT().GetHeadcount(gomock.Any(), filter).Return([]models.HeadcountGroup{{Group: "active", Count: 4}}, nil)
+
+	w := suite.get("/api/v1/analytics/headcount?group_by=status&include_terminated=true")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`[{"group":"active","count":4}]`, w.Body.String())
+}
+
+func (suite *AnalyticsControllerTestSuite) TestGetHeadcountHandlerInvalidGroup() {
+	w := suite.get("/api/v1/analytics/headcount?group_by=salary")
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *AnalyticsControllerTestSuite) TestGetSalaryStatsHandler() {
+	stats := []models.SalaryStats{{Group: "Engineering", Currency: "USD", Count: 2, Min: 100, Max: 200, Mean: 150, Median: 150, P25: 125, P75: 175, P90: 190}}
+	suite.svc.EXPECT().GetSalaryStats(gomock.Any(), models.AnalyticsFilter{}).Return(stats, nil)
+
+	w := suite.get("/api/v1/analytics/salaries")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`[{"group":"Engineering","currency":"USD","count":2,"min":100,"max":200,"mean":150,"median":150,"p25":125,"p75":175,"p90":190}]`, w.Body.String())
+}
+
+func (suite *AnalyticsControllerTestSuite) TestGetSalaryStatsHandlerForbidden() {
+	suite.principal = auth.Principal{Subject: "employee", Roles: []string{auth.RoleEmployee}}
+
+	w := suite.get("/api/v1/analytics/salaries")
+	suite.Equal(http.StatusForbidden, w.Code)
+	suite.Contains(w.Body.String(), "salary:read")
+}
+
+func (suite *AnalyticsControllerTestSuite) TestGetMovementsHandler() {
+	filter := models.MovementFilter{From: "2024-01", To: "2024-02"}
+	suite.svc.EXPECT().GetMovements(gomock.Any(), filter).Return([]models.MonthlyMovement{
+		{Month: "2024-01", Hires: 2},
+		{Month: "2024-02", Terminations: 1},
+	}, nil)
+
+	w := suite.get("/api/v1/analytics/movements?from=2024-01&to=2024-02")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`[{"month":"2024-01","hires":2,"terminations":0},{"month":"2024-02","hires":0,"terminations":1}]`, w.Body.String())
+}
+
+func (suite *AnalyticsControllerTestSuite) TestGetMovementsHandlerInvalidRange() {
+	suite.svc.EXPECT().GetMovements(gomock.Any(), models.MovementFilter{From: "2024-13"}).Return(nil, models.ErrValidation)
+
+	w := suite.get("/api/v1/analytics/movements?from=2024-13")
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *AnalyticsControllerTestSuite) TestGetTenureDistributionHandler() {
+	suite.svc.EXPECT().GetTenureDistribution(gomock.Any(), models.AnalyticsFilter{}).Return(models.TenureBuckets, nil)
+
+	w := suite.get("/api/v1/analytics/tenure")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"label":"10y+"`)
+
+	suite.svc.EXPECT().GetTenureDistribution(gomock.Any(), models.AnalyticsFilter{}).Return(nil, errors.New("db error"))
+	w = suite.get("/api/v1/analytics/tenure")
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// CRUDController serves the standard routes of a resource: a filtered page
+// of records, and reading, creating, updating and deleting one by its ID
+type CRUDController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	List(c *gin.Context)
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
@@ -0,0 +1,300 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/openapi"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// CRUDResource describes a resource to NewCRUDController
+type CRUDResource[T any, ID comparable] struct {
+	// Path is the route of the collection, such as /employees
+	Path string
+	// Name is the resource in messages and documentation, such as employee,
+	// and Plural its plural, Name with an s when empty
+	Name, Plural string
+	// Tag groups the routes in the Swagger documentation
+	Tag string
+	// ParseID reads the ID in the path of the record routes
+	ParseID func(string) (ID, error)
+	// Filters are the query parameters the list route accepts besides page and page_size
+	Filters []models.FilterField
+	// Middleware runs before every route of the resource
+	Middleware []gin.HandlerFunc
+	// BeforeCreate and BeforeUpdate check and complete a request body before
+	// it is passed to the service, such as authorizing parts of it. Their
+	// errors are mapped to statuses like those of the service.
+	BeforeCreate func(c *gin.Context, entity *T) error
+	BeforeUpdate func(c *gin.Context, entity *T) error
+	// List and Get replace the standard read handlers for resources whose
+	// reads take more options. The Swagger annotations of the replacements
+	// take precedence over the generated documentation.
+	List, Get gin.HandlerFunc
+	// Descriptions extend the generated documentation of the routes, by
+	// handler: list, get, create, update or delete
+	Descriptions map[string]string
+}
+
+// crudControllerImpl is the concrete implementation of CRUDController
+// (see crud_controller.go for the interface definition)
+type crudControllerImpl[T any, ID comparable] struct {
+	service  service.Service[T, ID]
+	resource CRUDResource[T, ID]
+}
+
+// NewCRUDController creates the controller of the standard routes of a resource
+func NewCRUDController[T any, ID comparable](svc service.Service[T, ID], resource CRUDResource[T, ID]) CRUDController {
+	if resource.Plural == "" {
+		resource.Plural = resource.Name + "s"
+	}
+	return &crudControllerImpl[T, ID]{service: svc, resource: resource}
+}
+
+// RegisterRoutes registers the routes of the resource with the given router
+// group and documents them for Swagger. The routes respond in any format
+// the client accepts, like the employee routes.
+func (cc *crudControllerImpl[T, ID]) RegisterRoutes(router *gin.RouterGroup) {
+	list, get := cc.List, cc.Get
+	if cc.resource.List != nil {
+		list = cc.resource.List
+	}
+	if cc.resource.Get != nil {
+		get = cc.resource.Get
+	}
+	group := router.Group(cc.resource.Path, append([]gin.HandlerFunc{requireAcceptable}, cc.resource.Middleware...)...)
+	{
+		group.GET("/", list)
+		group.GET("/:id", get)
+		group.POST("/", cc.Create)
+		group.PUT("/:id", cc.Update)
+		group.DELETE("/:id", cc.Delete)
+	}
+	cc.document(strings.TrimSuffix(router.BasePath(), "/") + cc.resource.Path)
+}
+
+// List handles GET requests for a page of records, with the total match
+// count in the X-Total-Count header
+func (cc *crudControllerImpl[T, ID]) List(c *gin.Context) {
+	query, err := cc.bindListQuery(c)
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entities, total, err := cc.service.List(c.Request.Context(), query)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	setPaginationHeaders(c, query.Pagination, total)
+	respond(c, http.StatusOK, entities)
+}
+
+// Get handles GET requests for one record
+func (cc *crudControllerImpl[T, ID]) Get(c *gin.Context) {
+	id, ok := cc.parseID(c)
+	if !ok {
+		return
+	}
+
+	entity, err := cc.service.Get(c.Request.Context(), id)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusNotFound), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, entity)
+}
+
+// Create handles POST requests adding a record
+func (cc *crudControllerImpl[T, ID]) Create(c *gin.Context) {
+	var entity T
+	if err := bindBody(c, &entity); err != nil {
+		respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+	if cc.resource.BeforeCreate != nil {
+		if err := cc.resource.BeforeCreate(c, &entity); err != nil {
+			respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	created, err := cc.service.Create(c.Request.Context(), entity)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusCreated, created)
+}
+
+// Update handles PUT requests replacing a record
+func (cc *crudControllerImpl[T, ID]) Update(c *gin.Context) {
+	id, ok := cc.parseID(c)
+	if !ok {
+		return
+	}
+	var entity T
+	if err := bindBody(c, &entity); err != nil {
+		respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+	if cc.resource.BeforeUpdate != nil {
+		if err := cc.resource.BeforeUpdate(c, &entity); err != nil {
+			respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	updated, err := cc.service.Update(c.Request.Context(), id, entity)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, updated)
+}
+
+// Delete handles DELETE requests removing a record
+func (cc *crudControllerImpl[T, ID]) Delete(c *gin.Context) {
+	id, ok := cc.parseID(c)
+	if !ok {
+		return
+	}
+
+	if err := cc.service.Delete(c.Request.Context(), id); err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, gin.H{"message": capitalize(cc.resource.Name) + " deleted successfully"})
+}
+
+// parseID reads the ID in the path, answering 400 when it is malformed
+func (cc *crudControllerImpl[T, ID]) parseID(c *gin.Context) (ID, bool) {
+	id, err := cc.resource.ParseID(c.Param("id"))
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid " + cc.resource.Name + " ID"})
+		return id, false
+	}
+	return id, true
+}
+
+// bindListQuery reads the page options and the filters of the resource,
+// rejecting values the filters do not accept
+func (cc *crudControllerImpl[T, ID]) bindListQuery(c *gin.Context) (models.ListQuery, error) {
+	var query models.ListQuery
+	if err := c.ShouldBindQuery(&query.Pagination); err != nil {
+		return query, err
+	}
+	query.Normalize()
+	for _, field := range cc.resource.Filters {
+		value := c.Query(field.Name)
+		if value == "" {
+			continue
+		}
+		if field.Type == models.FilterInteger {
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return query, fmt.Errorf("%s must be an integer", field.Name)
+			}
+		}
+		if len(field.Enum) > 0 && !slices.Contains(field.Enum, value) {
+			return query, fmt.Errorf("%s must be one of %s", field.Name, strings.Join(field.Enum, ", "))
+		}
+		if query.Filters == nil {
+			query.Filters = make(map[string]string)
+		}
+		query.Filters[field.Name] = value
+	}
+	return query, nil
+}
+
+// negotiatedMIMETypes are the request and response formats of the routes, as documented
+var negotiatedMIMETypes = []string{"application/json", "application/xml", "application/x-yaml", "application/x-msgpack"}
+
+// document adds the routes of the resource under route to the Swagger documentation
+func (cc *crudControllerImpl[T, ID]) document(route string) {
+	r := cc.resource
+	model := openapi.SchemaOf(reflect.TypeOf((*T)(nil)).Elem())
+	idParam := openapi.Parameter{Name: "id", In: "path", Required: true, Description: capitalize(r.Name) + " ID", Type: "string"}
+	if openapi.SchemaOf(reflect.TypeOf((*ID)(nil)).Elem()).Type == "integer" {
+		idParam.Type = "integer"
+	}
+	body := openapi.Parameter{Name: r.Name, In: "body", Required: true, Description: capitalize(r.Name) + " object", Schema: model}
+	failure := func(description string) openapi.Response {
+		return openapi.Response{Description: description, Schema: openapi.ObjectSchema()}
+	}
+	operation := func(handler, summary, description string, params []openapi.Parameter, responses map[string]openapi.Response) openapi.Operation {
+		if extra := r.Descriptions[handler]; extra != "" {
+			description += " " + extra
+		}
+		return openapi.Operation{
+			Summary: summary, Description: description, Tags: []string{r.Tag},
+			Consumes: negotiatedMIMETypes, Produces: negotiatedMIMETypes,
+			Parameters: params, Responses: responses,
+		}
+	}
+
+	listParams := []openapi.Parameter{
+		{Name: "page", In: "query", Type: "integer", Description: "Page number (default 1)"},
+		{Name: "page_size", In: "query", Type: "integer", Description: "Page size (default 20, max 100)"},
+	}
+	for _, field := range r.Filters {
+		param := openapi.Parameter{Name: field.Name, In: "query", Type: string(field.Type), Enum: field.Enum, Description: field.Description}
+		if param.Type == "" {
+			param.Type = string(models.FilterString)
+		}
+		listParams = append(listParams, param)
+	}
+
+	openapi.Document(route, http.MethodGet, operation("list", "Get all "+r.Plural,
+		"Retrieves a page of "+r.Plural+", optionally filtered. The total match count is returned in the X-Total-Count header.",
+		listParams, map[string]openapi.Response{
+			"200": {Description: "OK", Schema: &openapi.Schema{Type: "array", Items: model}},
+			"400": failure("Invalid query parameters"),
+			"500": failure("Error response"),
+		}))
+	openapi.Document(route+"/{id}", http.MethodGet, operation("get", "Get "+r.Name+" by ID",
+		"Retrieves a specific "+r.Name+" by its ID.",
+		[]openapi.Parameter{idParam}, map[string]openapi.Response{
+			"200": {Description: "OK", Schema: model},
+			"400": failure("Invalid " + r.Name + " ID"),
+			"404": failure(capitalize(r.Name) + " not found"),
+		}))
+	openapi.Document(route, http.MethodPost, operation("create", "Create "+r.Name,
+		"Creates a new "+r.Name+".",
+		[]openapi.Parameter{body}, map[string]openapi.Response{
+			"201": {Description: "Created", Schema: model},
+			"400": failure("Invalid request data"),
+			"500": failure("Error response"),
+		}))
+	openapi.Document(route+"/{id}", http.MethodPut, operation("update", "Update "+r.Name,
+		"Updates an existing "+r.Name+".",
+		[]openapi.Parameter{idParam, body}, map[string]openapi.Response{
+			"200": {Description: "OK", Schema: model},
+			"400": failure("Invalid " + r.Name + " ID or request data"),
+			"404": failure(capitalize(r.Name) + " not found"),
+			"500": failure("Error response"),
+		}))
+	openapi.Document(route+"/{id}", http.MethodDelete, operation("delete", "Delete "+r.Name,
+		"Removes a "+r.Name+".",
+		[]openapi.Parameter{idParam}, map[string]openapi.Response{
+			"200": {Description: "Success message", Schema: openapi.ObjectSchema()},
+			"400": failure("Invalid " + r.Name + " ID"),
+			"404": failure(capitalize(r.Name) + " not found"),
+			"500": failure("Error response"),
+		}))
+}
+
+// capitalize upper-cases the first letter of s
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/openapi"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/swaggo/swag"
+	"go.uber.org/mock/gomock"
+)
+
+type CRUDControllerTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	svc  *mocks.MockService[models.Department, uint]
+	r    *gin.Engine
+}
+
+func (suite *CRUDControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockService[models.Department, uint](suite.ctrl)
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	controller := NewCRUDController(suite.svc, CRUDResource[models.Department, uint]{
+		Path:    "/units",
+		Name:    "unit",
+		Tag:     "units",
+		ParseID: parseUintID,
+		Filters: []models.FilterField{
+			{Name: "name", Partial: true, Description: "Filter by name substring"},
+			{Name: "floor", Type: models.FilterInteger},
+			{Name: "kind", Enum: []string{"team", "guild"}},
+		},
+		BeforeCreate: func(_ *gin.Context, d *models.Department) error {
+			if d.Name == "secret" {
+				return models.ErrForbidden
+			}
+			return nil
+		},
+	})
+	controller.RegisterRoutes(suite.r.Group("/api/v1"))
+}
+
+func (suite *CRUDControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestCRUDControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(CRUDControllerTestSuite))
+}
+
+func (suite *CRUDControllerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *CRUDControllerTestSuite) TestListHandler() {
+	expected := models.ListQuery{
+		Pagination: models.Pagination{Page: 2, PageSize: 5},
+		Filters:    map[string]string{"name": "ops", "floor": "3"},
+	}
+	suite.svc.EXPECT().List(gomock.Any(), expected).Return([]models.Department{{ID: 6, Name: "DevOps"}}, int64(6), nil)
+
+	w := suite.request("GET", "/api/v1/units/?page=2&page_size=5&name=ops&floor=3&other=x", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("6", w.Header().Get("X-Total-Count"))
+	suite.Equal("2", w.Header().Get("X-Page"))
+	suite.Contains(w.Body.String(), `"name":"DevOps"`)
+}
+
+func (suite *CRUDControllerTestSuite) TestListHandlerRejectsInvalidFilters() {
+	w := suite.request("GET", "/api/v1/units/?floor=top", "")
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), "floor must be an integer")
+
+	w = suite.request("GET", "/api/v1/units/?kind=squad", "")
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), "kind must be one of team, guild")
+
+	w = suite.request("GET", "/api/v1/units/?page_size=500", "")
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *CRUDControllerTestSuite) TestGetHandler() {
+	suite.svc.EXPECT().Get(gomock.Any(), uint(9)).Return(models.Department{}, models.ErrDepartmentNotFound)
+	w := suite.request("GET", "/api/v1/units/9", "")
+	suite.Equal(http.StatusNotFound, w.Code)
+
+	w = suite.request("GET", "/api/v1/units/abc", "")
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), "Invalid unit ID")
+}
+
+func (suite *CRUDControllerTestSuite) TestCreateHandler() {
+	suite.svc.EXPECT().Create(gomock.Any(), models.Department{Name: "Ops"}).DoAndReturn(func(_ context.Context, d models.Department) (models.Department, error) {
+		d.ID = 4
+		return d, nil
+	})
+	w := suite.request("POST", "/api/v1/units/", `{"name":"Ops"}`)
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Contains(w.Body.String(), `"id":4`)
+
+	// Errors of the request hook are mapped like service errors
+	w = suite.request("POST", "/api/v1/units/", `{"name":"secret"}`)
+	suite.Equal(http.StatusForbidden, w.Code)
+}
+
+func (suite *CRUDControllerTestSuite) TestUpdateAndDeleteHandlers() {
+	suite.svc.EXPECT().Update(gomock.Any(), uint(4), models.Department{Name: "Ops"}).Return(models.Department{}, models.ErrConflict)
+	w := suite.request("PUT", "/api/v1/units/4", `{"name":"Ops"}`)
+	suite.Equal(http.StatusConflict, w.Code)
+
+	suite.svc.EXPECT().Delete(gomock.Any(), uint(4)).Return(nil)
+	w = suite.request("DELETE", "/api/v1/units/4", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"message":"Unit deleted successfully"}`, w.Body.String())
+}
+
+func (suite *CRUDControllerTestSuite) TestRoutesAreDocumented() {
+	spec := &swag.Spec{BasePath: "/api/v1", SwaggerTemplate: `{"swagger":"2.0","paths":{}}`}
+	suite.Require().NoError(openapi.Merge(spec))
+
+	doc := spec.ReadDoc()
+	suite.Contains(doc, `"/units/{id}"`)
+	suite.Contains(doc, `"summary": "Get all units"`)
+	suite.Contains(doc, `"name": "floor"`)
+	suite.Contains(doc, `"$ref": "#/definitions/models.Department"`)
+}
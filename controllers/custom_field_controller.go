@@ -0,0 +1,7 @@
+package controllers
+
+// CustomFieldController serves the custom field definitions of employees
+// through the standard CRUD routes
+type CustomFieldController interface {
+	CRUDController
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/openapi"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// customFieldControllerImpl is the concrete implementation of CustomFieldController
+// (see custom_field_controller.go for the interface definition)
+type customFieldControllerImpl struct {
+	CRUDController
+	customFieldService service.CustomFieldService
+}
+
+// NewCustomFieldController creates a new instance of CustomFieldController
+func NewCustomFieldController(customFieldService service.CustomFieldService) CustomFieldController {
+	return &customFieldControllerImpl{
+		CRUDController: NewCRUDController(customFieldService, CRUDResource[models.CustomField, uint]{
+			Path:       "/custom-fields",
+			Name:       "custom field",
+			Tag:        "custom-fields",
+			ParseID:    parseUintID,
+			Filters:    models.CustomFieldFilters,
+			Middleware: []gin.HandlerFunc{requireManageForWrites},
+			Descriptions: map[string]string{
+				"create": "Names are lowercase identifiers, such as shirt_size, and key the custom_fields object of employees. Only string fields take enum_values and a pattern, which values must match in full. Requires the custom_fields:manage permission.",
+				"update": "The name and type cannot change. Values employees already hold are not checked again. Requires the custom_fields:manage permission.",
+				"delete": "Removes the field's values from every employee. Requires the custom_fields:manage permission.",
+			},
+		}),
+		customFieldService: customFieldService,
+	}
+}
+
+// RegisterRoutes registers the custom field routes with the given router
+// group. The served Swagger spec describes the custom fields of the
+// caller's tenant in the custom_fields of employees.
+func (cc *customFieldControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	cc.CRUDController.RegisterRoutes(router)
+	openapi.DocumentProperty("models.Employee", "custom_fields", cc.customFieldsSchema)
+	openapi.DocumentProperty("models.EmployeePatch", "custom_fields", cc.customFieldsSchema)
+}
+
+// customFieldsSchema describes the custom fields of the tenant of ctx
+func (cc *customFieldControllerImpl) customFieldsSchema(ctx context.Context) (*openapi.Schema, error) {
+	fields, err := cc.customFieldService.GetAllCustomFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schema := &openapi.Schema{
+		Type:                 "object",
+		Description:          "Values of the tenant's custom fields, by name",
+		Properties:           make(map[string]*openapi.Schema, len(fields)),
+		AdditionalProperties: false,
+	}
+	for _, field := range fields {
+		property := &openapi.Schema{Type: string(field.Type), Description: field.Description, Enum: field.EnumValues}
+		if field.Type == models.CustomFieldDate {
+			property.Type, property.Format = "string", "date"
+		}
+		if field.Pattern != "" {
+			property.Pattern = "^(?:" + field.Pattern + ")$"
+		}
+		schema.Properties[field.Name] = property
+		if field.Required {
+			schema.Required = append(schema.Required, field.Name)
+		}
+	}
+	return schema, nil
+}
+
+// requireManageForWrites lets every caller read the custom fields, which
+// describe what employees may carry, and only callers with the
+// custom_fields:manage permission change them
+func requireManageForWrites(c *gin.Context) {
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		return
+	}
+	requirePermission(auth.PermCustomFieldManage)(c)
+}
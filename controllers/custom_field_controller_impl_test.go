@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/openapi"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/swaggo/swag"
+	"go.uber.org/mock/gomock"
+)
+
+type CustomFieldControllerTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	svc       *mocks.MockCustomFieldService
+	r         *gin.Engine
+	principal auth.Principal
+}
+
+func (suite *CustomFieldControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockCustomFieldService(suite.ctrl)
+	suite.principal = auth.Principal{Subject: "admin", Roles: []string{auth.RoleAdmin}}
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	NewCustomFieldController(suite.svc).RegisterRoutes(suite.r.Group("/api/v1"))
+}
+
+func (suite *CustomFieldControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestCustomFieldControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomFieldControllerTestSuite))
+}
+
+func (suite *CustomFieldControllerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *CustomFieldControllerTestSuite) TestCreateCustomField() {
+	expected := models.CustomField{Name: "shirt_size", Type: models.CustomFieldString, EnumValues: []string{"S", "M"}}
+	suite.svc.EXPECT().Create(gomock.Any(), expected).DoAndReturn(func(_ context.Context, f models.CustomField) (models.CustomField, error) {
+		f.ID = 3
+		return f, nil
+	})
+	w := suite.request("POST", "/api/v1/custom-fields/", `{"name":"shirt_size","type":"string","enum_values":["S","M"]}`)
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Contains(w.Body.String(), `"id":3`)
+
+	w = suite.request("POST", "/api/v1/custom-fields/", `{"name":"shoe_size","type":"float"}`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *CustomFieldControllerTestSuite) TestWritesRequireManagePermission() {
+	suite.principal = auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}
+
+	w := suite.request("POST", "/api/v1/custom-fields/", `{"name":"shirt_size","type":"string"}`)
+	suite.Equal(http.StatusForbidden, w.Code)
+	w = suite.request("DELETE", "/api/v1/custom-fields/3", "")
+	suite.Equal(http.StatusForbidden, w.Code)
+
+	suite.svc.EXPECT().Get(gomock.Any(), uint(3)).Return(models.CustomField{ID: 3, Name: "shirt_size"}, nil)
+	w = suite.request("GET", "/api/v1/custom-fields/3", "")
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *CustomFieldControllerTestSuite) TestEmployeeCustomFieldsAreDocumented() {
+	suite.svc.EXPECT().GetAllCustomFields(gomock.Any()).Return([]models.CustomField{
+		{Name: "hired_on", Type: models.CustomFieldDate, Required: true},
+		{Name: "shirt_size", Type: models.CustomFieldString, EnumValues: []string{"S", "M"}, Pattern: "[A-Z]+"},
+	}, nil).Times(2)
+	spec := &swag.Spec{SwaggerTemplate: `{"swagger":"2.0","definitions":{
+		"models.Employee":{"type":"object","properties":{"custom_fields":{"type":"object"}}},
+		"models.EmployeePatch":{"type":"object","properties":{"custom_fields":{"type":"object"}}}}}`}
+
+	doc, err := openapi.Resolve(context.Background(), spec)
+	suite.Require().NoError(err)
+	var resolved struct {
+		Definitions map[string]openapi.Schema `json:"definitions"`
+	}
+	suite.Require().NoError(json.Unmarshal(doc, &resolved))
+	fields := resolved.Definitions["models.Employee"].Properties["custom_fields"]
+	suite.Equal([]string{"hired_on"}, fields.Required)
+	suite.Equal("date", fields.Properties["hired_on"].Format)
+	suite.Equal("^(?:[A-Z]+)$", fields.Properties["shirt_size"].Pattern)
+	suite.Equal([]string{"S", "M"}, fields.Properties["shirt_size"].Enum)
+}
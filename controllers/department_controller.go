@@ -0,0 +1,16 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// DepartmentController defines the interface for department controller
+type DepartmentController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetDepartments(c *gin.Context)
+	GetDepartment(c *gin.Context)
+	CreateDepartment(c *gin.Context)
+	UpdateDepartment(c *gin.Context)
+	DeleteDepartment(c *gin.Context)
+	GetDepartmentEmployees(c *gin.Context)
+}
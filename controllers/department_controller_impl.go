@@ -0,0 +1,228 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// departmentControllerImpl is the concrete implementation of DepartmentController
+// (see department_controller.go for the interface definition)
+type departmentControllerImpl struct {
+	departmentService service.DepartmentService
+}
+
+// NewDepartmentController creates a new instance of DepartmentController
+func NewDepartmentController(departmentService service.DepartmentService) DepartmentController {
+	return &departmentControllerImpl{
+		departmentService: departmentService,
+	}
+}
+
+// RegisterRoutes registers the department routes with the given router group.
+func (dc *departmentControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	departments := router.Group("/departments")
+	{
+		departments.GET("/", dc.GetDepartments)
+		departments.GET("/:id", dc.GetDepartment)
+		departments.POST("/", dc.CreateDepartment)
+		departments.PUT("/:id", dc.UpdateDepartment)
+		departments.DELETE("/:id", dc.DeleteDepartment)
+		departments.GET("/:id/employees", dc.GetDepartmentEmployees)
+	}
+}
+
+// GetDepartments handles GET request to fetch all departments
+// @Summary Get all departments
+// @Description Retrieves all departments from the database
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Department
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /departments [get]
+func (dc *departmentControllerImpl) GetDepartments(c *gin.Context) {
+	departments, err := dc.departmentService.GetAllDepartments(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, departments)
+}
+
+// GetDepartment handles GET request to fetch a specific department by ID
+// @Summary Get department by ID
+// @Description Retrieves a specific department by its ID
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Param id path int true "Department ID"
+// @Success 200 {object} models.Department
+// @Failure 400 {object} map[string]interface{} "Invalid department ID"
+// @Failure 404 {object} map[string]interface{} "Department not found"
+// @Router /departments/{id} [get]
+func (dc *departmentControllerImpl) GetDepartment(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department ID"})
+		return
+	}
+
+	department, err := dc.departmentService.GetDepartmentByID(c.Request.Context(), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, department)
+}
+
+// CreateDepartment handles POST request to create a new department
+// @Summary Create department
+// @Description Creates a new department record
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Param department body models.Department true "Department object"
+// @Success 201 {object} models.Department
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /departments [post]
+func (dc *departmentControllerImpl) CreateDepartment(c *gin.Context) {
+	var department models.Department
+	if err := c.ShouldBindJSON(&department); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	createdDepartment, err := dc.departmentService.CreateDepartment(c.Request.Context(), department)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, createdDepartment)
+}
+
+// UpdateDepartment handles PUT request to update an existing department
+// @Summary Update department
+// @Description Updates an existing department record
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Param id path int true "Department ID"
+// @Param department body models.Department true "Updated department object"
+// @Success 200 {object} models.Department
+// @Failure 400 {object} map[string]interface{} "Invalid department ID or request data"
+// @Failure 404 {object} map[string]interface{} "Department not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /departments/{id} [put]
+func (dc *departmentControllerImpl) UpdateDepartment(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department ID"})
+		return
+	}
+
+	var department models.Department
+	if err := c.ShouldBindJSON(&department); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updatedDepartment, err := dc.departmentService.UpdateDepartment(c.Request.Context(), uint(id), department)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedDepartment)
+}
+
+// DeleteDepartment handles DELETE request to remove a department
+// @Summary Delete department
+// @Description Removes a department from the database. Departments with employees assigned cannot be deleted.
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Param id path int true "Department ID"
+// @Success 200 {object} map[string]interface{} "Success message"
+// @Failure 400 {object} map[string]interface{} "Invalid department ID"
+// @Failure 404 {object} map[string]interface{} "Department not found"
+// @Failure 409 {object} map[string]interface{} "Department still has employees"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /departments/{id} [delete]
+func (dc *departmentControllerImpl) DeleteDepartment(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department ID"})
+		return
+	}
+
+	err = dc.departmentService.DeleteDepartment(c.Request.Context(), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Department deleted successfully"})
+}
+
+// GetDepartmentEmployees handles GET request to fetch a page of a department's employees
+// @Summary Get department employees
+// @Description Retrieves a page of the employees assigned to a department, with the same filters as the employee list. The total match count is returned in the X-Total-Count header.
+// @Tags departments
+// @Accept json
+// @Produce json
+// @Param id path int true "Department ID"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Param name query string false "Filter by name substring"
+// @Param email query string false "Filter by exact email"
+// @Param position query string false "Filter by exact position"
+// @Param tags query string false "Comma separated tags employees must all carry"
+// @Param tags_any query string false "Comma separated tags employees must carry at least one of"
+// @Param tags_not query string false "Comma separated tags employees must not carry"
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,position"
+// @Param expand query string false "Comma separated relations to embed: department, manager, tags"
+// @Success 200 {array} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid department ID or query parameters"
+// @Failure 404 {object} map[string]interface{} "Department not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /departments/{id}/employees [get]
+func (dc *departmentControllerImpl) GetDepartmentEmployees(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department ID"})
+		return
+	}
+
+	var filter models.EmployeeFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.Normalize()
+	if filter.View, err = bindEmployeeView(c); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.Tags, err = bindTagQuery(c); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	employees, total, err := dc.departmentService.GetDepartmentEmployees(c.Request.Context(), uint(id), filter)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	setPaginationHeaders(c, filter.Pagination, total)
+	c.JSON(http.StatusOK, projectEmployees(employees, filter.View))
+}
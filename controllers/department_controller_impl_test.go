@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type DepartmentControllerTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	svc  *mocks.MockDepartmentService
+	r    *gin.Engine
+}
+
+func (suite *DepartmentControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockDepartmentService(suite.ctrl)
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	controller := &departmentControllerImpl{departmentService: suite.svc}
+	v1 := suite.r.Group("/api/v1")
+	controller.RegisterRoutes(v1)
+}
+
+func (suite *DepartmentControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestDepartmentControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(DepartmentControllerTestSuite))
+}
+
+func (suite *DepartmentControllerTestSuite) TestGetDepartmentsHandler() {
+	departments := []models.Department{{ID: 1, Name: "Engineering"}, {ID: 2, Name: "Design"}}
+	suite.svc.EXPECT().GetAllDepartments(gomock.Any()).Return(departments, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/departments/", nil)
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "Engineering")
+	suite.Contains(w.Body.String(), "Design")
+}
+
+func (suite *DepartmentControllerTestSuite) TestGetDepartmentHandler() {
+	suite.svc.EXPECT().GetDepartmentByID(gomock.Any(), uint(1)).Return(models.Department{ID: 1, Name: "Engineering"}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/departments/1", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "Engineering")
+
+	suite.svc.EXPECT().GetDepartmentByID(gomock.Any(), uint(2)).Return(models.Department{}, models.ErrDepartmentNotFound)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/departments/2", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *DepartmentControllerTestSuite) TestCreateDepartmentHandler() {
+	input := `{"name":"Engineering","description":"Builds things"}`
+	created := models.Department{ID: 1, Name: "Engineering", Description: "Builds things"}
+	suite.svc.EXPECT().CreateDepartment(gomock.Any(), models.Department{Name: "Engineering", Description: "Builds things"}).Return(created, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/departments/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Contains(w.Body.String(), "Engineering")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/departments/", strings.NewReader(`{"description":"no name"}`))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *DepartmentControllerTestSuite) TestDeleteDepartmentHandler() {
+	suite.svc.EXPECT().DeleteDepartment(gomock.Any(), uint(1)).Return(nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/departments/1", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "Department deleted successfully")
+
+	suite.svc.EXPECT().DeleteDepartment(gomock.Any(), uint(2)).Return(models.ErrConflict)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("DELETE", "/api/v1/departments/2", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusConflict, w.Code)
+}
+
+func (suite *DepartmentControllerTestSuite) TestGetDepartmentEmployeesHandler() {
+	departmentID := uint(1)
+	employees := []models.Employee{{ID: 1, Name: "Alice", DepartmentID: &departmentID}}
+	expectedFilter := models.EmployeeFilter{Pagination: models.Pagination{Page: 1, PageSize: 5}, Name: "Ali"}
+	suite.svc.EXPECT().GetDepartmentEmployees(gomock.Any(), departmentID, expectedFilter).Return(employees, int64(1), nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/departments/1/employees?page_size=5&name=Ali", nil)
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "Alice")
+	suite.Equal("1", w.Header().Get("X-Total-Count"))
+}
@@ -9,7 +9,21 @@ type EmployeeController interface {
 	RegisterRoutes(router *gin.RouterGroup)
 	GetEmployees(c *gin.Context)
 	GetEmployee(c *gin.Context)
-	CreateEmployee(c *gin.Context)
-	UpdateEmployee(c *gin.Context)
-	DeleteEmployee(c *gin.Context)
+	GetDirectReports(c *gin.Context)
+	GetReportingChain(c *gin.Context)
+	GetSubordinates(c *gin.Context)
+	GetOrgChart(c *gin.Context)
+	GetSalaryOverrides(c *gin.Context)
+	ActivateEmployee(c *gin.Context)
+	PutEmployeeOnLeave(c *gin.Context)
+	TerminateEmployee(c *gin.Context)
+	GetStatusHistory(c *gin.Context)
+	BulkCreateEmployees(c *gin.Context)
+	BulkUpdateEmployees(c *gin.Context)
+	BulkDeleteEmployees(c *gin.Context)
+	ImportEmployees(c *gin.Context)
+	ExportEmployees(c *gin.Context)
+	SearchEmployees(c *gin.Context)
+	StreamEvents(c *gin.Context)
+	SubscribeEvents(c *gin.Context)
 }
@@ -0,0 +1,216 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// BulkCreateEmployees handles POST request to create many employees at once
+// @Summary Bulk create employees
+// @Description Creates every employee in the array. In atomic mode (default) either all are created in one transaction or none are; in best_effort mode every valid employee is created. Each item gets its own status and error.
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param mode query string false "atomic (default) or best_effort" Enums(atomic, best_effort)
+// @Param employees body []models.Employee true "Employees to create"
+// @Success 201 {object} models.BulkResponse "All items created"
+// @Success 207 {object} models.BulkResponse "Some items failed in best_effort mode"
+// @Failure 400 {object} map[string]interface{} "Invalid body, mode or item count, or an atomic batch failed validation"
+// @Failure 413 {object} map[string]interface{} "Body larger than 10 MiB"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/bulk [post]
+func (ec *employeeControllerImpl) BulkCreateEmployees(c *gin.Context) {
+	mode, ok := bulkMode(c)
+	if !ok {
+		return
+	}
+	var employees []models.Employee
+	limitBulkBody(c)
+	if err := decodeBody(c, &employees); err != nil {
+		respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	checks := make([]error, len(employees))
+	for i := range employees {
+		checks[i] = validateBulkItem(&employees[i])
+		if checks[i] == nil {
+			checks[i] = authorizeSalaryOverride(c, employees[i].SalaryOverride)
+		}
+	}
+
+	runBulk(c, http.StatusCreated, mode, checks, func(accepted []int) ([]models.BulkItemResult, error) {
+		batch := make([]models.Employee, len(accepted))
+		for j, i := range accepted {
+			batch[j] = employees[i]
+		}
+		return ec.employeeService.BulkCreateEmployees(c.Request.Context(), batch, mode)
+	})
+}
+
+// BulkUpdateEmployees handles PATCH request to update many employees at once
+// @Summary Bulk update employees
+// @Description Applies a partial update to every employee in the array; fields left out are unchanged. Modes work as for bulk create.
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param mode query string false "atomic (default) or best_effort" Enums(atomic, best_effort)
+// @Param patches body []models.EmployeePatch true "Partial updates, each with the employee id"
+// @Success 200 {object} models.BulkResponse "All items updated"
+// @Success 207 {object} models.BulkResponse "Some items failed in best_effort mode"
+// @Failure 400 {object} map[string]interface{} "Invalid body, mode or item count, or an atomic batch failed validation"
+// @Failure 413 {object} map[string]interface{} "Body larger than 10 MiB"
+// @Failure 404 {object} map[string]interface{} "An atomic batch referenced a missing employee"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/bulk [patch]
+func (ec *employeeControllerImpl) BulkUpdateEmployees(c *gin.Context) {
+	mode, ok := bulkMode(c)
+	if !ok {
+		return
+	}
+	var patches []models.EmployeePatch
+	limitBulkBody(c)
+	if err := decodeBody(c, &patches); err != nil {
+		respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	checks := make([]error, len(patches))
+	for i := range patches {
+		checks[i] = validateBulkItem(&patches[i])
+		if checks[i] == nil {
+			checks[i] = authorizeSalaryOverride(c, patches[i].SalaryOverride)
+		}
+	}
+
+	runBulk(c, http.StatusOK, mode, checks, func(accepted []int) ([]models.BulkItemResult, error) {
+		batch := make([]models.EmployeePatch, len(accepted))
+		for j, i := range accepted {
+			batch[j] = patches[i]
+		}
+		return ec.employeeService.BulkUpdateEmployees(c.Request.Context(), batch, mode)
+	})
+}
+
+// BulkDeleteEmployees handles DELETE request to remove many employees at once
+// @Summary Bulk delete employees
+// @Description Deletes every employee whose ID is in the array. Modes work as for bulk create.
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param mode query string false "atomic (default) or best_effort" Enums(atomic, best_effort)
+// @Param ids body []int true "Employee IDs to delete"
+// @Success 200 {object} models.BulkResponse "All items deleted"
+// @Success 207 {object} models.BulkResponse "Some items failed in best_effort mode"
+// @Failure 400 {object} map[string]interface{} "Invalid body, mode or item count"
+// @Failure 413 {object} map[string]interface{} "Body larger than 10 MiB"
+// @Failure 404 {object} map[string]interface{} "An atomic batch referenced a missing employee"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/bulk [delete]
+func (ec *employeeControllerImpl) BulkDeleteEmployees(c *gin.Context) {
+	mode, ok := bulkMode(c)
+	if !ok {
+		return
+	}
+	var ids []uint
+	limitBulkBody(c)
+	if err := decodeBody(c, &ids); err != nil {
+		respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	runBulk(c, http.StatusOK, mode, make([]error, len(ids)), func([]int) ([]models.BulkItemResult, error) {
+		return ec.employeeService.BulkDeleteEmployees(c.Request.Context(), ids, mode)
+	})
+}
+
+// maxBulkBodyBytes caps the body of a bulk request, which is decoded whole
+// before the service checks its item count. It leaves room for well over the
+// default item limit of full employees.
+const maxBulkBodyBytes = 10 << 20
+
+// limitBulkBody makes reading more than maxBulkBodyBytes of the request body
+// fail, so that an oversized request is rejected without being decoded
+func limitBulkBody(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBulkBodyBytes)
+}
+
+// bulkMode reads the mode query parameter, answering 400 when it is unknown
+func bulkMode(c *gin.Context) (models.BulkMode, bool) {
+	mode := models.BulkMode(c.DefaultQuery("mode", string(models.BulkAtomic)))
+	if mode != models.BulkAtomic && mode != models.BulkBestEffort {
+		respond(c, http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Unsupported mode %q, use atomic or best_effort", mode)})
+		return "", false
+	}
+	return mode, true
+}
+
+// validateBulkItem applies the binding rules to one item, so that a bad item
+// fails on its own instead of failing the whole request
+func validateBulkItem(item interface{}) error {
+	if err := binding.Validator.ValidateStruct(item); err != nil {
+		return fmt.Errorf("%w: %s", models.ErrValidation, err.Error())
+	}
+	return nil
+}
+
+// runBulk passes the items whose checks succeeded to apply, merges the
+// results back into request order and writes the bulk response. In atomic
+// mode a failed check means nothing is applied.
+func runBulk(c *gin.Context, successStatus int, mode models.BulkMode, checks []error,
+	apply func(accepted []int) ([]models.BulkItemResult, error)) {
+	results := make([]models.BulkItemResult, len(checks))
+	var accepted []int
+	for i, err := range checks {
+		results[i] = models.BulkItemResult{Index: i, Err: err}
+		if err == nil {
+			accepted = append(accepted, i)
+		}
+	}
+
+	if len(accepted) > 0 && (mode == models.BulkBestEffort || len(accepted) == len(checks)) {
+		applied, err := apply(accepted)
+		if err != nil {
+			respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		for j, i := range accepted {
+			applied[j].Index = i
+			results[i] = applied[j]
+		}
+	} else if len(checks) == 0 {
+		respond(c, http.StatusBadRequest, gin.H{"error": "a bulk request needs at least one item"})
+		return
+	} else {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Err = models.ErrRolledBack
+			}
+		}
+	}
+
+	response := models.BulkResponse{Mode: mode, Results: results}
+	status := successStatus
+	for i := range response.Results {
+		result := &response.Results[i]
+		if result.Err == nil {
+			result.Status = successStatus
+			response.Succeeded++
+			continue
+		}
+		result.Status = errorStatus(result.Err, http.StatusInternalServerError)
+		result.Error = result.Err.Error()
+		response.Failed++
+		if status == successStatus && result.Status != http.StatusFailedDependency {
+			status = result.Status
+		}
+	}
+	if response.Failed > 0 && mode == models.BulkBestEffort {
+		status = http.StatusMultiStatus
+	}
+	respond(c, status, response)
+}
@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *EmployeeControllerTestSuite) TestBulkCreateEmployeesHandler() {
+	created := &models.Employee{ID: 1, Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000}
+	employees := []models.Employee{{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000}}
+	suite.svc.EXPECT().BulkCreateEmployees(gomock.Any(), employees, models.BulkAtomic).
+		Return([]models.BulkItemResult{{ID: 1, Employee: created}}, nil)
+
+	w := httptest.NewRecorder()
+	body := `[{"name":"John","email":"john@example.com","position":"Dev","salary":60000}]`
+	req, _ := http.NewRequest("POST", "/api/v1/employees/bulk", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Contains(w.Body.String(), `"succeeded":1`)
+	suite.Contains(w.Body.String(), `"status":201`)
+}
+
+func (suite *EmployeeControllerTestSuite) TestBulkCreateEmployeesHandlerAtomicInvalidItem() {
+	w := httptest.NewRecorder()
+	body := `[{"name":"John","email":"john@example.com","position":"Dev","salary":60000},{"name":"Jane","email":"not-an-email"}]`
+	req, _ := http.NewRequest("POST", "/api/v1/employees/bulk", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), `"status":424`)
+	suite.Contains(w.Body.String(), `"failed":2`)
+}
+
+func (suite *EmployeeControllerTestSuite) TestBulkCreateEmployeesHandlerBestEffort() {
+	employees := []models.Employee{{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000}}
+	suite.svc.EXPECT().BulkCreateEmployees(gomock.Any(), employees, models.BulkBestEffort).
+		Return([]models.BulkItemResult{{ID: 1, Employee: &models.Employee{ID: 1}}}, nil)
+
+	w := httptest.NewRecorder()
+	body := `[{"name":"Jane","email":"not-an-email"},{"name":"John","email":"john@example.com","position":"Dev","salary":60000}]`
+	req, _ := http.NewRequest("POST", "/api/v1/employees/bulk?mode=best_effort", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusMultiStatus, w.Code)
+	suite.Contains(w.Body.String(), `"index":1,"id":1,"status":201`)
+	suite.Contains(w.Body.String(), `"index":0,"status":400`)
+}
+
+func (suite *EmployeeControllerTestSuite) TestBulkHandlerInvalidMode() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/employees/bulk?mode=partial", strings.NewReader(`[1]`))
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *EmployeeControllerTestSuite) TestBulkDeleteEmployeesHandler() {
+	suite.svc.EXPECT().BulkDeleteEmployees(gomock.Any(), []uint{1, 9}, models.BulkAtomic).Return([]models.BulkItemResult{
+		{ID: 1, Err: models.ErrRolledBack},
+		{ID: 9, Err: models.ErrEmployeeNotFound},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/employees/bulk", strings.NewReader(`[1, 9]`))
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+	suite.Contains(w.Body.String(), `"status":424`)
+	suite.Contains(w.Body.String(), "employee not found")
+}
+
+func (suite *EmployeeControllerTestSuite) TestBulkHandlersRejectOversizedBodies() {
+	ids := "[" + strings.Repeat("1,", maxBulkBodyBytes/2) + "1]"
+	employees := `[{"name":"` + strings.Repeat("a", maxBulkBodyBytes) + `"}]`
+	for _, tc := range []struct{ method, body string }{
+		{"POST", employees},
+		{"PATCH", employees},
+		{"DELETE", ids},
+	} {
+		// The service is never called
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(tc.method, "/api/v1/employees/bulk", strings.NewReader(tc.body))
+		suite.r.ServeHTTP(w, req)
+
+		suite.Equal(http.StatusRequestEntityTooLarge, w.Code, tc.method)
+	}
+}
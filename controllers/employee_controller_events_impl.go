@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+// eventHeartbeatInterval is how often an idle event stream sends a comment
+// line, so that proxies do not close it for inactivity
+var eventHeartbeatInterval = 15 * time.Second
+
+// StreamEvents handles GET request to stream employee changes as server-sent events
+// @Summary Stream employee changes
+// @Description Streams created, updated and deleted employee events as Server-Sent Events. Each event's id can be sent back as the Last-Event-ID header (or last_event_id query parameter) to resume after it from the retained event log. A reset event means events after that ID are no longer retained and the client should reload its data. Comment lines are sent as heartbeats while the stream is idle.
+// @Tags employees
+// @Produce text/event-stream
+// @Param department_id query int false "Only events for employees in this department"
+// @Param id query []int false "Only events for these employee IDs" collectionFormat(multi)
+// @Param last_event_id query int false "Resume after this event ID when the Last-Event-ID header is not set"
+// @Param Last-Event-ID header int false "Resume after this event ID"
+// @Success 200 {object} models.EmployeeEvent "One event per message"
+// @Failure 400 {object} map[string]interface{} "Invalid filter or event ID"
+// @Router /employees/events [get]
+func (ec *employeeControllerImpl) StreamEvents(c *gin.Context) {
+	var filter models.EventFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var after *uint64
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	if lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid last event ID"})
+			return
+		}
+		after = &id
+	}
+
+	subscription, err := ec.employeeService.SubscribeEvents(c.Request.Context(), filter, after)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	defer subscription.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	if subscription.Missed {
+		c.Render(-1, sse.Event{Event: "reset", Data: gin.H{"last_event_id": after}})
+	}
+	for _, event := range subscription.Replay {
+		writeEvent(c, event)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(eventHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-subscription.C:
+			if !ok {
+				// Dropped for falling behind; the client reconnects and resumes
+				return
+			}
+			writeEvent(c, event)
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": heartbeat\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
+
+func writeEvent(c *gin.Context, event models.EmployeeEvent) {
+	c.Render(-1, sse.Event{
+		Id:    strconv.FormatUint(event.ID, 10),
+		Event: string(event.Type),
+		Data:  event,
+	})
+}
@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	"go.uber.org/mock/gomock"
+)
+
+// openEventStream starts a server for the suite's router, requests the event
+// stream and returns its lines as they arrive
+func (suite *EmployeeControllerTestSuite) openEventStream(url string, header http.Header) (*http.Response, <-chan string) {
+	server := httptest.NewServer(suite.r)
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.T().Cleanup(func() {
+		cancel()
+		server.Close()
+	})
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+url, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	resp, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	return resp, lines
+}
+
+// nextLines returns the next n lines of the stream, failing after a second
+func (suite *EmployeeControllerTestSuite) nextLines(lines <-chan string, n int) []string {
+	var result []string
+	timeout := time.After(time.Second)
+	for len(result) < n {
+		select {
+		case line := <-lines:
+			result = append(result, line)
+		case <-timeout:
+			suite.FailNow("timed out waiting for the event stream", "got %q", result)
+		}
+	}
+	return result
+}
+
+func (suite *EmployeeControllerTestSuite) TestStreamEventsHandler() {
+	broker := events.NewBroker(10)
+	departmentID := uint(2)
+	filter := models.EventFilter{DepartmentID: &departmentID, EmployeeIDs: []uint{1, 3}}
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), filter, nil).DoAndReturn(subscribeTo(broker))
+
+	resp, lines := suite.openEventStream("/api/v1/employees/events?department_id=2&id=1&id=3", nil)
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	suite.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+
+	broker.Publish(
+		models.EmployeeEvent{Type: models.EventEmployeeCreated, EmployeeID: 1, Employee: models.Employee{ID: 1, DepartmentID: &departmentID}},
+		models.EmployeeEvent{Type: models.EventEmployeeCreated, EmployeeID: 2, Employee: models.Employee{ID: 2, DepartmentID: &departmentID}},
+		models.EmployeeEvent{Type: models.EventEmployeeDeleted, EmployeeID: 3, Employee: models.Employee{ID: 3, DepartmentID: &departmentID}},
+	)
+	received := suite.nextLines(lines, 8)
+	suite.Equal("id:1", received[0])
+	suite.Equal("event:employee.created", received[1])
+	suite.Contains(received[2], `"employee_id":1`)
+	suite.Equal("id:3", received[4])
+	suite.Equal("event:employee.deleted", received[5])
+}
+
+func (suite *EmployeeControllerTestSuite) TestStreamEventsHandlerResume() {
+	broker := events.NewBroker(2)
+	for id := uint(1); id <= 3; id++ {
+		broker.Publish(models.EmployeeEvent{Type: models.EventEmployeeUpdated, EmployeeID: id})
+	}
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), models.EventFilter{}, gomock.Any()).DoAndReturn(subscribeTo(broker)).Times(2)
+
+	_, lines := suite.openEventStream("/api/v1/employees/events", http.Header{"Last-Event-ID": {"2"}})
+	suite.Equal([]string{"id:3", "event:employee.updated"}, suite.nextLines(lines, 2))
+
+	// Event 1 is no longer retained
+	_, lines = suite.openEventStream("/api/v1/employees/events?last_event_id=0", nil)
+	received := suite.nextLines(lines, 6)
+	suite.Equal("event:reset", received[0])
+	suite.Equal("id:2", received[3])
+}
+
+func (suite *EmployeeControllerTestSuite) TestStreamEventsHandlerHeartbeat() {
+	interval := eventHeartbeatInterval
+	eventHeartbeatInterval = 10 * time.Millisecond
+	defer func() { eventHeartbeatInterval = interval }()
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), models.EventFilter{}, nil).DoAndReturn(subscribeTo(events.NewBroker(1)))
+
+	_, lines := suite.openEventStream("/api/v1/employees/events", nil)
+	suite.True(strings.HasPrefix(suite.nextLines(lines, 1)[0], ":"))
+}
+
+func (suite *EmployeeControllerTestSuite) TestStreamEventsHandlerInvalidEventID() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/events", nil)
+	req.Header.Set("Last-Event-ID", "abc")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// subscribeTo answers SubscribeEvents calls from the broker
+func subscribeTo(broker events.Broker) func(context.Context, models.EventFilter, *uint64) (*events.Subscription, error) {
+	return func(_ context.Context, filter models.EventFilter, after *uint64) (*events.Subscription, error) {
+		return broker.Subscribe(filter, after), nil
+	}
+}
@@ -0,0 +1,307 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+)
+
+// exportColumn is one column of an employee export. Columns with a
+// permission are left out for callers who lack it.
+type exportColumn struct {
+	name       string
+	permission auth.Permission
+	value      func(e *models.Employee) interface{}
+}
+
+// exportColumns lists the exported fields in output order
+var exportColumns = []exportColumn{
+	{name: "id", value: func(e *models.Employee) interface{} { return e.ID }},
+	{name: "name", value: func(e *models.Employee) interface{} { return e.Name }},
+	{name: "email", value: func(e *models.Employee) interface{} { return e.Email }},
+	{name: "position", value: func(e *models.Employee) interface{} { return e.Position }},
+	{name: "position_id", value: func(e *models.Employee) interface{} { return optionalID(e.PositionID) }},
+	{name: "department_id", value: func(e *models.Employee) interface{} { return optionalID(e.DepartmentID) }},
+	{name: "manager_id", value: func(e *models.Employee) interface{} { return optionalID(e.ManagerID) }},
+	{name: "status", value: func(e *models.Employee) interface{} { return string(e.Status) }},
+	{name: "join_date", value: func(e *models.Employee) interface{} { return exportDate(&e.JoinDate) }},
+	{name: "termination_date", value: func(e *models.Employee) interface{} { return exportDate(e.TerminationDate) }},
+	{name: "termination_reason", value: func(e *models.Employee) interface{} { return e.TerminationReason }},
+	{name: "salary", permission: auth.PermSalaryRead, value: func(e *models.Employee) interface{} { return e.Salary }},
+	{name: "currency", permission: auth.PermSalaryRead, value: func(e *models.Employee) interface{} { return e.Currency }},
+	{name: "salary_out_of_band", permission: auth.PermSalaryRead, value: func(e *models.Employee) interface{} { return e.SalaryOutOfBand }},
+}
+
+// exportWriter writes export rows in one format. Flush is called after each
+// batch to push the rows to the client; Close finishes the document.
+type exportWriter interface {
+	WriteRow(values []interface{}) error
+	Flush() error
+	Close() error
+}
+
+// exportFormat describes one export format
+type exportFormat struct {
+	contentType string
+	extension   string
+	newWriter   func(w gin.ResponseWriter, columns []string) (exportWriter, error)
+}
+
+var exportFormats = map[string]exportFormat{
+	"csv":    {contentType: "text/csv; charset=utf-8", extension: "csv", newWriter: newCSVExportWriter},
+	"ndjson": {contentType: "application/x-ndjson", extension: "ndjson", newWriter: newNDJSONExportWriter},
+	"xlsx": {
+		contentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		extension:   "xlsx",
+		newWriter:   newXLSXExportWriter,
+	},
+}
+
+// ExportEmployees handles GET request to export all matching employees
+// @Summary Export employees
+// @Description Streams every employee matching the filters as CSV, NDJSON or XLSX. Rows are read from the database in batches and sent with chunked encoding as they are read. Salary columns are only included for callers with the salary:read permission.
+// @Tags employees
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "Export format (default csv)" Enums(csv, ndjson, xlsx)
+// @Param name query string false "Filter by name substring"
+// @Param email query string false "Filter by exact email"
+// @Param position query string false "Filter by exact position"
+// @Param department_id query int false "Filter by department ID"
+// @Param manager_id query int false "Filter by manager ID"
+// @Param status query string false "Filter by lifecycle status" Enums(onboarding, active, on_leave, terminated)
+// @Param custom_fields[name] query string false "Filter by the value of a custom field, e.g. custom_fields[shirt_size]=M; repeat for several fields"
+// @Param tags query string false "Comma separated tags employees must all carry"
+// @Param tags_any query string false "Comma separated tags employees must carry at least one of"
+// @Param tags_not query string false "Comma separated tags employees must not carry"
+// @Success 200 {file} file "Employee export"
+// @Failure 400 {object} map[string]interface{} "Invalid format or query parameters, unknown custom field or invalid tag"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/export [get]
+func (ec *employeeControllerImpl) ExportEmployees(c *gin.Context) {
+	formatName := c.DefaultQuery("format", "csv")
+	format, ok := exportFormats[formatName]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Unsupported format %q, use csv, ndjson or xlsx", formatName)})
+		return
+	}
+	var filter models.EmployeeFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	tags, err := bindTagQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.Tags = tags
+	filter.CustomFields = bindCustomFieldQuery(c)
+
+	columns := visibleExportColumns(auth.FromContext(c))
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.name
+	}
+
+	c.Header("Content-Type", format.contentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="employees.%s"`, format.extension))
+	writer, err := format.newWriter(c.Writer, names)
+	if err == nil {
+		err = ec.employeeService.ExportEmployees(c.Request.Context(), filter, func(batch []models.Employee) error {
+			values := make([]interface{}, len(columns))
+			for i := range batch {
+				for j, column := range columns {
+					values[j] = column.value(&batch[i])
+				}
+				if err := writer.WriteRow(values); err != nil {
+					return err
+				}
+			}
+			return writer.Flush()
+		})
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		if c.Writer.Written() {
+			// The rows sent so far cannot be taken back; the client sees a truncated export
+			c.Error(err)
+			return
+		}
+		c.Writer.Header().Del("Content-Disposition")
+		c.Writer.Header().Set("Content-Type", gin.MIMEJSON)
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+	}
+}
+
+// visibleExportColumns returns the columns the principal may see
+func visibleExportColumns(principal auth.Principal) []exportColumn {
+	columns := make([]exportColumn, 0, len(exportColumns))
+	for _, column := range exportColumns {
+		if column.permission == "" || principal.Can(column.permission) {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// optionalID exports a nullable ID, nil when unset
+func optionalID(id *uint) interface{} {
+	if id == nil {
+		return nil
+	}
+	return *id
+}
+
+// exportDate exports a date as YYYY-MM-DD, nil when unset
+func exportDate(date *time.Time) interface{} {
+	if date == nil || date.IsZero() {
+		return nil
+	}
+	return date.Format(time.DateOnly)
+}
+
+// csvExportWriter writes a header row followed by one record per employee
+type csvExportWriter struct {
+	w      gin.ResponseWriter
+	writer *csv.Writer
+	record []string
+}
+
+func newCSVExportWriter(w gin.ResponseWriter, columns []string) (exportWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return nil, err
+	}
+	return &csvExportWriter{w: w, writer: writer, record: make([]string, len(columns))}, nil
+}
+
+func (cw *csvExportWriter) WriteRow(values []interface{}) error {
+	for i, value := range values {
+		switch v := value.(type) {
+		case nil:
+			cw.record[i] = ""
+		case float64:
+			cw.record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case string:
+			cw.record[i] = csvText(v)
+		default:
+			cw.record[i] = fmt.Sprint(v)
+		}
+	}
+	return cw.writer.Write(cw.record)
+}
+
+// csvText keeps a text cell from being read as a formula when the export is
+// opened in a spreadsheet, by quoting text that starts like one
+func csvText(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+func (cw *csvExportWriter) Flush() error {
+	cw.writer.Flush()
+	if err := cw.writer.Error(); err != nil {
+		return err
+	}
+	cw.w.Flush()
+	return nil
+}
+
+func (cw *csvExportWriter) Close() error {
+	return cw.Flush()
+}
+
+// ndjsonExportWriter writes one JSON object per employee per line
+type ndjsonExportWriter struct {
+	w       gin.ResponseWriter
+	encoder *json.Encoder
+	columns []string
+}
+
+func newNDJSONExportWriter(w gin.ResponseWriter, columns []string) (exportWriter, error) {
+	return &ndjsonExportWriter{w: w, encoder: json.NewEncoder(w), columns: columns}, nil
+}
+
+func (nw *ndjsonExportWriter) WriteRow(values []interface{}) error {
+	object := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		object[nw.columns[i]] = value
+	}
+	return nw.encoder.Encode(object)
+}
+
+func (nw *ndjsonExportWriter) Flush() error {
+	nw.w.Flush()
+	return nil
+}
+
+func (nw *ndjsonExportWriter) Close() error {
+	return nil
+}
+
+// xlsxExportWriter writes rows through excelize's stream writer, which keeps
+// them out of memory. The workbook is a zip archive, so it can only be sent
+// once the last row is in.
+type xlsxExportWriter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+const xlsxExportSheet = "Employees"
+
+func newXLSXExportWriter(w gin.ResponseWriter, columns []string) (exportWriter, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName(file.GetSheetName(0), xlsxExportSheet); err != nil {
+		return nil, err
+	}
+	stream, err := file.NewStreamWriter(xlsxExportSheet)
+	if err != nil {
+		return nil, err
+	}
+	xw := &xlsxExportWriter{w: w, file: file, stream: stream}
+	header := make([]interface{}, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+	return xw, xw.WriteRow(header)
+}
+
+func (xw *xlsxExportWriter) WriteRow(values []interface{}) error {
+	xw.row++
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+	return xw.stream.SetRow(cell, values)
+}
+
+func (xw *xlsxExportWriter) Flush() error {
+	return nil
+}
+
+func (xw *xlsxExportWriter) Close() error {
+	defer xw.file.Close()
+	if err := xw.stream.Flush(); err != nil {
+		return err
+	}
+	_, err := xw.file.WriteTo(xw.w)
+	return err
+}
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var exportedEmployees = []models.Employee{
+	{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000, Currency: "USD", Status: models.StatusActive},
+	{ID: 2, Name: "Bob", Email: "bob@example.com", Position: "QA", Salary: 40000.5, Currency: "EUR", Status: models.StatusOnLeave},
+}
+
+func (suite *EmployeeControllerTestSuite) expectExport(filter models.EmployeeFilter) {
+	suite.svc.EXPECT().ExportEmployees(gomock.Any(), filter, gomock.Any()).DoAndReturn(func(_ context.Context, _ models.EmployeeFilter, fn func([]models.Employee) error) error {
+		return fn(exportedEmployees)
+	})
+}
+
+// exportAs serves the request with the given principal on the context
+func (suite *EmployeeControllerTestSuite) exportAs(principal auth.Principal, url string) *httptest.ResponseRecorder {
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, principal)
+	})
+	controller := &employeeControllerImpl{employeeService: suite.svc}
+	controller.RegisterRoutes(r.Group("/api/v1"))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", url, nil)
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *EmployeeControllerTestSuite) TestExportEmployeesHandlerCSV() {
+	departmentID := uint(2)
+	suite.expectExport(models.EmployeeFilter{DepartmentID: &departmentID})
+
+	hr := auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}
+	w := suite.exportAs(hr, "/api/v1/employees/export?department_id=2")
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	suite.Len(lines, 3)
+	suite.Equal("id,name,email,position,position_id,department_id,manager_id,status,join_date,termination_date,termination_reason,salary,currency,salary_out_of_band", lines[0])
+	suite.Equal("2,Bob,bob@example.com,QA,,,,on_leave,,,,40000.5,EUR,false", lines[2])
+}
+
+func (suite *EmployeeControllerTestSuite) TestExportEmployeesHandlerCustomFieldFilters() {
+	suite.expectExport(models.EmployeeFilter{CustomFields: map[string]interface{}{"shirt_size": "M"}})
+
+	w := suite.exportAs(auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}, "/api/v1/employees/export?custom_fields[shirt_size]=M")
+	suite.Equal(http.StatusOK, w.Code)
+
+	suite.svc.EXPECT().ExportEmployees(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("%w: unknown custom field colour", models.ErrValidation))
+	w = suite.exportAs(auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}, "/api/v1/employees/export?custom_fields[colour]=red")
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *EmployeeControllerTestSuite) TestExportEmployeesHandlerCSVNeutralisesFormulas() {
+	suite.svc.EXPECT().ExportEmployees(gomock.Any(), models.EmployeeFilter{}, gomock.Any()).DoAndReturn(func(_ context.Context, _ models.EmployeeFilter, fn func([]models.Employee) error) error {
+		return fn([]models.Employee{
+			{ID: 1, Name: `=HYPERLINK("http://evil.example","x")`, Email: "@eve", Position: "+1", TerminationReason: "-2+3", Salary: -5},
+		})
+	})
+
+	w := suite.exportAs(auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}, "/api/v1/employees/export")
+
+	suite.Equal(http.StatusOK, w.Code)
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	// Text starting like a formula is quoted, numbers are left alone
+	suite.Equal(`1,"'=HYPERLINK(""http://evil.example"",""x"")",'@eve,'+1,,,,,,,'-2+3,-5,,false`, lines[1])
+}
+
+func (suite *EmployeeControllerTestSuite) TestExportEmployeesHandlerMasksSalary() {
+	suite.expectExport(models.EmployeeFilter{})
+
+	employee := auth.Principal{Subject: "alice", Roles: []string{auth.RoleEmployee}}
+	w := suite.exportAs(employee, "/api/v1/employees/export?format=ndjson")
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("application/x-ndjson", w.Header().Get("Content-Type"))
+	suite.Contains(w.Body.String(), `"name":"Alice"`)
+	suite.NotContains(w.Body.String(), "salary")
+	suite.NotContains(w.Body.String(), "currency")
+}
+
+func (suite *EmployeeControllerTestSuite) TestExportEmployeesHandlerXLSX() {
+	suite.expectExport(models.EmployeeFilter{})
+
+	w := suite.exportAs(auth.Principal{Subject: "admin", Roles: []string{auth.RoleAdmin}}, "/api/v1/employees/export?format=xlsx")
+	suite.Equal(http.StatusOK, w.Code)
+
+	file, err := excelize.OpenReader(bytes.NewReader(w.Body.Bytes()))
+	suite.Require().NoError(err)
+	defer file.Close()
+	rows, err := file.GetRows("Employees")
+	suite.NoError(err)
+	suite.Len(rows, 3)
+	suite.Equal("salary", rows[0][11])
+	suite.Equal("Alice", rows[1][1])
+	suite.Equal("50000", rows[1][11])
+}
+
+func (suite *EmployeeControllerTestSuite) TestExportEmployeesHandlerErrors() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/export?format=pdf", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+
+	suite.svc.EXPECT().ExportEmployees(gomock.Any(), models.EmployeeFilter{}, gomock.Any()).Return(errors.New("database is down"))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/employees/export", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusInternalServerError, w.Code)
+	suite.Equal(gin.MIMEJSON, w.Header().Get("Content-Type"))
+	suite.Empty(w.Header().Get("Content-Disposition"))
+}
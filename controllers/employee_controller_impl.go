@@ -1,12 +1,14 @@
 package controllers
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/chinmay-sawant/gin-example/auth"
 	"github.com/chinmay-sawant/gin-example/models"
-	"github.com/chinmay-sawant/gin-example/repo"
 	"github.com/chinmay-sawant/gin-example/service"
 	"github.com/gin-gonic/gin"
 )
@@ -18,9 +20,9 @@ type employeeControllerImpl struct {
 }
 
 // NewEmployeeController creates a new instance of EmployeeController
-func NewEmployeeController(repo repo.EmployeeRepository) EmployeeController {
+func NewEmployeeController(employeeService service.EmployeeService) EmployeeController {
 	return &employeeControllerImpl{
-		employeeService: service.NewEmployeeService(repo),
+		employeeService: employeeService,
 	}
 }
 
@@ -28,150 +30,444 @@ func NewEmployeeController(repo repo.EmployeeRepository) EmployeeController {
 func (ec *employeeControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
 	employees := router.Group("/employees")
 	{
-		employees.GET("/", ec.GetEmployees)
-		employees.GET("/:id", ec.GetEmployee)
-		employees.POST("/", ec.CreateEmployee)
-		employees.PUT("/:id", ec.UpdateEmployee)
-		employees.DELETE("/:id", ec.DeleteEmployee)
+		// These choose their format from the query instead of the Accept header
+		employees.GET("/export", ec.ExportEmployees)
+		employees.GET("/org-chart", ec.GetOrgChart)
+		// Always text/event-stream
+		employees.GET("/events", ec.StreamEvents)
+		employees.GET("/ws", ec.SubscribeEvents)
+	}
+	NewCRUDController(ec.employeeService, ec.resource()).RegisterRoutes(router)
+	negotiated := employees.Group("", requireAcceptable)
+	{
+		negotiated.GET("/search", ec.SearchEmployees)
+		negotiated.GET("/:id/reports", ec.GetDirectReports)
+		negotiated.GET("/:id/chain", ec.GetReportingChain)
+		negotiated.GET("/:id/subordinates", ec.GetSubordinates)
+		negotiated.GET("/:id/salary-overrides", ec.GetSalaryOverrides)
+		negotiated.POST("/:id/activate", ec.ActivateEmployee)
+		negotiated.POST("/:id/leave", ec.PutEmployeeOnLeave)
+		negotiated.POST("/:id/terminate", ec.TerminateEmployee)
+		negotiated.GET("/:id/status-history", ec.GetStatusHistory)
+		negotiated.POST("/bulk", ec.BulkCreateEmployees)
+		negotiated.PATCH("/bulk", ec.BulkUpdateEmployees)
+		negotiated.DELETE("/bulk", ec.BulkDeleteEmployees)
+		negotiated.POST("/import", ec.ImportEmployees)
+	}
+}
+
+// resource describes employees to the CRUD controller builder, which serves
+// their create, update and delete routes. Reads take sparse fieldsets and
+// expansions, so they keep their own handlers.
+func (ec *employeeControllerImpl) resource() CRUDResource[models.Employee, uint] {
+	return CRUDResource[models.Employee, uint]{
+		Path:    "/employees",
+		Name:    "employee",
+		Tag:     "employees",
+		ParseID: parseUintID,
+		BeforeCreate: func(c *gin.Context, employee *models.Employee) error {
+			if err := authorizeSalaryOverride(c, employee.SalaryOverride); err != nil {
+				return err
+			}
+			// Set the join date to current time if not provided
+			if employee.JoinDate.IsZero() {
+				employee.JoinDate = time.Now()
+			}
+			return nil
+		},
+		BeforeUpdate: func(c *gin.Context, employee *models.Employee) error {
+			return authorizeSalaryOverride(c, employee.SalaryOverride)
+		},
+		List: ec.GetEmployees,
+		Get:  ec.GetEmployee,
+		Descriptions: map[string]string{
+			"create": "When position_id is set the salary is checked against the position's band; salary_override accepts an out-of-band salary and requires the salary:override permission (403 otherwise). custom_fields must hold every required custom field of the tenant and no unknown ones.",
+			"update": "Salary band checks and overrides work as for create. Without custom_fields the stored custom field values are kept.",
+		},
 	}
 }
 
-// GetEmployees handles GET request to fetch all employees
+// GetEmployees handles GET request to fetch a page of employees
 // @Summary Get all employees
-// @Description Retrieves all employees from the database
+// @Description Retrieves a page of employees, optionally filtered. The total match count is returned in the X-Total-Count header.
 // @Tags employees
-// @Accept json
-// @Produce json
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Param name query string false "Filter by name substring"
+// @Param email query string false "Filter by exact email"
+// @Param position query string false "Filter by exact position"
+// @Param department_id query int false "Filter by department ID"
+// @Param manager_id query int false "Filter by manager ID"
+// @Param status query string false "Filter by lifecycle status" Enums(onboarding, active, on_leave, terminated)
+// @Param custom_fields[name] query string false "Filter by the value of a custom field, e.g. custom_fields[shirt_size]=M; repeat for several fields"
+// @Param tags query string false "Comma separated tags employees must all carry"
+// @Param tags_any query string false "Comma separated tags employees must carry at least one of"
+// @Param tags_not query string false "Comma separated tags employees must not carry"
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,position"
+// @Param expand query string false "Comma separated relations to embed: department, manager, tags"
 // @Success 200 {array} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid query parameters, unknown custom field or invalid tag"
 // @Failure 500 {object} map[string]interface{} "Error response"
 // @Router /employees [get]
 func (ec *employeeControllerImpl) GetEmployees(c *gin.Context) {
-	employees, err := ec.employeeService.GetAllEmployees()
+	var filter models.EmployeeFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.Normalize()
+	view, err := bindEmployeeView(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, employees)
+	filter.View = view
+	if filter.Tags, err = bindTagQuery(c); err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.CustomFields = bindCustomFieldQuery(c)
+
+	employees, total, err := ec.employeeService.GetAllEmployees(c.Request.Context(), filter)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	setPaginationHeaders(c, filter.Pagination, total)
+	respond(c, http.StatusOK, projectEmployees(employees, view))
 }
 
 // GetEmployee handles GET request to fetch a specific employee by ID
 // @Summary Get employee by ID
 // @Description Retrieves a specific employee by their ID
 // @Tags employees
-// @Accept json
-// @Produce json
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
 // @Param id path int true "Employee ID"
+// @Param fields query string false "Comma separated fields to return, e.g. id,name,position"
+// @Param expand query string false "Comma separated relations to embed: department, manager, tags"
 // @Success 200 {object} models.Employee
-// @Failure 400 {object} map[string]interface{} "Invalid employee ID"
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID or view"
 // @Failure 404 {object} map[string]interface{} "Employee not found"
 // @Router /employees/{id} [get]
 func (ec *employeeControllerImpl) GetEmployee(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
 		return
 	}
 
-	employee, err := ec.employeeService.GetEmployeeByID(uint(id))
+	view, err := bindEmployeeView(c)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, employee)
+
+	employee, err := ec.employeeService.GetEmployeeByID(c.Request.Context(), uint(id), view)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusNotFound), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, projectEmployee(employee, view))
 }
 
-// CreateEmployee handles POST request to create a new employee
-// @Summary Create employee
-// @Description Creates a new employee record
+// GetDirectReports handles GET request to fetch the employees reporting directly to a manager
+// @Summary Get direct reports
+// @Description Retrieves the employees whose manager is the given employee
 // @Tags employees
-// @Accept json
-// @Produce json
-// @Param employee body models.Employee true "Employee object"
-// @Success 201 {object} models.Employee
-// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Success 200 {array} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
 // @Failure 500 {object} map[string]interface{} "Error response"
-// @Router /employees [post]
-func (ec *employeeControllerImpl) CreateEmployee(c *gin.Context) {
-	var employee models.Employee
-	if err := c.ShouldBindJSON(&employee); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+// @Router /employees/{id}/reports [get]
+func (ec *employeeControllerImpl) GetDirectReports(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
 		return
 	}
 
-	// Set the join date to current time if not provided
-	if employee.JoinDate.IsZero() {
-		employee.JoinDate = time.Now()
+	reports, err := ec.employeeService.GetDirectReports(c.Request.Context(), uint(id))
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
 	}
+	respond(c, http.StatusOK, reports)
+}
 
-	createdEmployee, err := ec.employeeService.CreateEmployee(employee)
+// GetReportingChain handles GET request to fetch an employee's chain of managers
+// @Summary Get reporting chain
+// @Description Retrieves the employee's managers, from their direct manager up to the top of the organisation
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Success 200 {array} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/chain [get]
+func (ec *employeeControllerImpl) GetReportingChain(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
 		return
 	}
 
-	c.JSON(http.StatusCreated, createdEmployee)
+	chain, err := ec.employeeService.GetReportingChain(c.Request.Context(), uint(id))
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, chain)
 }
 
-// UpdateEmployee handles PUT request to update an existing employee
-// @Summary Update employee
-// @Description Updates an existing employee record
+// GetSubordinates handles GET request to fetch everyone below a manager
+// @Summary Get subordinates
+// @Description Retrieves every employee below the given manager at any depth, ordered level by level
 // @Tags employees
-// @Accept json
-// @Produce json
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
 // @Param id path int true "Employee ID"
-// @Param employee body models.Employee true "Updated employee object"
-// @Success 200 {object} models.Employee
-// @Failure 400 {object} map[string]interface{} "Invalid employee ID or request data"
+// @Success 200 {array} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
 // @Failure 500 {object} map[string]interface{} "Error response"
-// @Router /employees/{id} [put]
-func (ec *employeeControllerImpl) UpdateEmployee(c *gin.Context) {
+// @Router /employees/{id}/subordinates [get]
+func (ec *employeeControllerImpl) GetSubordinates(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		return
+	}
+
+	subordinates, err := ec.employeeService.GetSubordinates(c.Request.Context(), uint(id))
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, subordinates)
+}
+
+// GetOrgChart handles GET request to export the org chart
+// @Summary Export org chart
+// @Description Exports the reporting tree as nested JSON or as a Graphviz DOT graph. Without root the whole organisation is exported.
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Produce text/vnd.graphviz
+// @Param root query int false "Employee ID to use as the root of the chart"
+// @Param format query string false "Export format: json (default) or dot"
+// @Success 200 {array} models.OrgChartNode
+// @Failure 400 {object} map[string]interface{} "Invalid root or format"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/org-chart [get]
+func (ec *employeeControllerImpl) GetOrgChart(c *gin.Context) {
+	var rootID *uint
+	if rootParam := c.Query("root"); rootParam != "" {
+		id, err := strconv.ParseUint(rootParam, 10, 32)
+		if err != nil {
+			respond(c, http.StatusBadRequest, gin.H{"error": "Invalid root employee ID"})
+			return
+		}
+		root := uint(id)
+		rootID = &root
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "dot" {
+		respond(c, http.StatusBadRequest, gin.H{"error": "Unsupported format, use json or dot"})
+		return
+	}
+
+	chart, err := ec.employeeService.GetOrgChart(c.Request.Context(), rootID)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	if format == "dot" {
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(renderOrgChartDOT(chart)))
 		return
 	}
+	respond(c, http.StatusOK, chart)
+}
 
-	var employee models.Employee
-	if err := c.ShouldBindJSON(&employee); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+// GetSalaryOverrides handles GET request to fetch an employee's out-of-band salary approvals
+// @Summary Get salary overrides
+// @Description Retrieves the recorded justifications for salaries accepted outside the position's band
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Success 200 {array} models.SalaryBandOverride
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/salary-overrides [get]
+func (ec *employeeControllerImpl) GetSalaryOverrides(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
 		return
 	}
 
-	updatedEmployee, err := ec.employeeService.UpdateEmployee(uint(id), employee)
+	overrides, err := ec.employeeService.GetSalaryOverrides(c.Request.Context(), uint(id))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 		return
 	}
+	respond(c, http.StatusOK, overrides)
+}
+
+// ActivateEmployee handles POST request to make an onboarding or on-leave employee active
+// @Summary Activate employee
+// @Description Moves an employee from onboarding or on leave to active
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Param transition body models.StatusTransitionRequest false "Optional effective date and reason"
+// @Success 200 {object} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID or request data"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 409 {object} map[string]interface{} "Transition not allowed from the current status"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/activate [post]
+func (ec *employeeControllerImpl) ActivateEmployee(c *gin.Context) {
+	ec.transitionEmployee(c, models.StatusActive)
+}
+
+// PutEmployeeOnLeave handles POST request to put an active employee on leave
+// @Summary Put employee on leave
+// @Description Moves an active employee to on leave. A reason is required.
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Param transition body models.StatusTransitionRequest true "Reason and optional effective date"
+// @Success 200 {object} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID, request data or missing reason"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 409 {object} map[string]interface{} "Transition not allowed from the current status"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/leave [post]
+func (ec *employeeControllerImpl) PutEmployeeOnLeave(c *gin.Context) {
+	ec.transitionEmployee(c, models.StatusOnLeave)
+}
 
-	c.JSON(http.StatusOK, updatedEmployee)
+// TerminateEmployee handles POST request to terminate an employee
+// @Summary Terminate employee
+// @Description Terminates an employee while retaining their record. An effective date and a reason are required.
+// @Tags employees
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Param transition body models.StatusTransitionRequest true "Termination date and reason"
+// @Success 200 {object} models.Employee
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID, request data or missing date or reason"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 409 {object} map[string]interface{} "Employee is already terminated"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/terminate [post]
+func (ec *employeeControllerImpl) TerminateEmployee(c *gin.Context) {
+	ec.transitionEmployee(c, models.StatusTerminated)
 }
 
-// DeleteEmployee handles DELETE request to remove an employee
-// @Summary Delete employee
-// @Description Removes an employee from the database
+// GetStatusHistory handles GET request to fetch an employee's lifecycle history
+// @Summary Get status history
+// @Description Retrieves every lifecycle transition of an employee, oldest first
 // @Tags employees
-// @Accept json
-// @Produce json
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
 // @Param id path int true "Employee ID"
-// @Success 200 {object} map[string]interface{} "Success message"
+// @Success 200 {array} models.EmployeeStatusTransition
 // @Failure 400 {object} map[string]interface{} "Invalid employee ID"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
 // @Failure 500 {object} map[string]interface{} "Error response"
-// @Router /employees/{id} [delete]
-func (ec *employeeControllerImpl) DeleteEmployee(c *gin.Context) {
+// @Router /employees/{id}/status-history [get]
+func (ec *employeeControllerImpl) GetStatusHistory(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
 		return
 	}
 
-	err = ec.employeeService.DeleteEmployee(uint(id))
+	history, err := ec.employeeService.GetStatusHistory(c.Request.Context(), uint(id))
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, history)
+}
+
+// transitionEmployee binds the optional transition body and applies the status change
+func (ec *employeeControllerImpl) transitionEmployee(c *gin.Context, status models.EmployeeStatus) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Employee deleted successfully"})
+	var request models.StatusTransitionRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindBody(c, &request); err != nil {
+			respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+			return
+		}
+	}
+	request.ChangedBy = auth.FromContext(c).Subject
+
+	employee, err := ec.employeeService.TransitionEmployee(c.Request.Context(), uint(id), status, request)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, employee)
+}
+
+// authorizeSalaryOverride rejects salary overrides from callers without the
+// salary:override permission and stamps the approver on permitted ones
+func authorizeSalaryOverride(c *gin.Context, override *models.SalaryOverride) error {
+	return auth.FromContext(c).AuthorizeSalaryOverride(override)
+}
+
+// dotEscaper escapes text for a quoted Graphviz string, which only knows
+// escaped quotes and backslashes and is UTF-8 otherwise
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// renderOrgChartDOT writes the org chart as a Graphviz digraph with one edge per reporting line
+func renderOrgChartDOT(chart []models.OrgChartNode) string {
+	var b strings.Builder
+	b.WriteString("digraph orgchart {\n")
+	b.WriteString("  node [shape=box];\n")
+
+	var walk func(node models.OrgChartNode)
+	walk = func(node models.OrgChartNode) {
+		// \n is a line break in Graphviz labels
+		fmt.Fprintf(&b, "  e%d [label=\"%s\\n%s\"];\n", node.ID, dotEscaper.Replace(node.Name), dotEscaper.Replace(node.Position))
+		for _, report := range node.Reports {
+			fmt.Fprintf(&b, "  e%d -> e%d;\n", node.ID, report.ID)
+			walk(report)
+		}
+	}
+	for _, root := range chart {
+		walk(root)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
 }
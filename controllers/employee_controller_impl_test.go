@@ -1,12 +1,14 @@
 package controllers
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/chinmay-sawant/gin-example/auth"
 	"github.com/chinmay-sawant/gin-example/models"
 	"github.com/chinmay-sawant/gin-example/service/mocks"
 	"github.com/gin-gonic/gin"
@@ -44,7 +46,8 @@ func (suite *EmployeeControllerTestSuite) TestGetEmployeesHandler() {
 		{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000},
 		{ID: 2, Name: "Bob", Email: "bob@example.com", Position: "QA", Salary: 40000},
 	}
-	suite.svc.EXPECT().GetAllEmployees().Return(employees, nil)
+	expectedFilter := models.EmployeeFilter{Pagination: models.Pagination{Page: 1, PageSize: models.DefaultPageSize}}
+	suite.svc.EXPECT().GetAllEmployees(gomock.Any(), expectedFilter).Return(employees, int64(2), nil)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/employees/", nil)
@@ -53,11 +56,54 @@ func (suite *EmployeeControllerTestSuite) TestGetEmployeesHandler() {
 	suite.Equal(http.StatusOK, w.Code)
 	suite.Contains(w.Body.String(), "Alice")
 	suite.Contains(w.Body.String(), "Bob")
+	suite.Equal("2", w.Header().Get("X-Total-Count"))
+}
+
+func (suite *EmployeeControllerTestSuite) TestGetEmployeesHandlerFilters() {
+	departmentID := uint(4)
+	expectedFilter := models.EmployeeFilter{
+		Pagination:   models.Pagination{Page: 2, PageSize: 10},
+		Position:     "Dev",
+		DepartmentID: &departmentID,
+	}
+	suite.svc.EXPECT().GetAllEmployees(gomock.Any(), expectedFilter).Return([]models.Employee{}, int64(11), nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/?page=2&page_size=10&position=Dev&department_id=4", nil)
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("11", w.Header().Get("X-Total-Count"))
+	suite.Equal("2", w.Header().Get("X-Page"))
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/employees/?page_size=1000", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *EmployeeControllerTestSuite) TestGetEmployeesHandlerTagFilters() {
+	expectedFilter := models.EmployeeFilter{
+		Pagination: models.Pagination{Page: 1, PageSize: models.DefaultPageSize},
+		Tags:       models.TagQuery{All: []string{"oncall", "remote"}, Any: []string{"mentor"}, None: []string{"contractor"}},
+	}
+	suite.svc.EXPECT().GetAllEmployees(gomock.Any(), expectedFilter).Return([]models.Employee{}, int64(0), nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/?tags=OnCall,remote,oncall&tags_any=mentor&tags_not=contractor", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/employees/?tags_not=on%20call", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), "invalid tag")
 }
 
 func (suite *EmployeeControllerTestSuite) TestGetEmployeeHandler() {
 	employee := models.Employee{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000}
-	suite.svc.EXPECT().GetEmployeeByID(uint(1)).Return(employee, nil)
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).Return(employee, nil)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/api/v1/employees/1", nil)
@@ -66,17 +112,43 @@ func (suite *EmployeeControllerTestSuite) TestGetEmployeeHandler() {
 	suite.Equal(http.StatusOK, w.Code)
 	suite.Contains(w.Body.String(), "Alice")
 
-	suite.svc.EXPECT().GetEmployeeByID(uint(2)).Return(models.Employee{}, errors.New("not found"))
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(2), models.EmployeeView{}).Return(models.Employee{}, errors.New("not found"))
 	w = httptest.NewRecorder()
 	req, _ = http.NewRequest("GET", "/api/v1/employees/2", nil)
 	suite.r.ServeHTTP(w, req)
 	suite.Equal(http.StatusNotFound, w.Code)
 }
 
+func (suite *EmployeeControllerTestSuite) TestGetEmployeeHandlerSparseFields() {
+	departmentID := uint(2)
+	view := models.EmployeeView{Fields: []string{"id", "name"}, Expand: []string{models.ExpandDepartment}}
+	employee := models.Employee{ID: 1, Name: "Alice", DepartmentID: &departmentID, Department: &models.Department{ID: 2, Name: "Design"}}
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), view).Return(employee, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/1?fields=id,name&expand=department", nil)
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"name":"Alice"`)
+	suite.Contains(w.Body.String(), `"department":{"id":2,"name":"Design"`)
+	suite.NotContains(w.Body.String(), "email")
+	suite.NotContains(w.Body.String(), "department_id")
+}
+
+func (suite *EmployeeControllerTestSuite) TestGetEmployeesHandlerUnknownField() {
+	for _, query := range []string{"fields=id,password", "expand=position", "expand=labels"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/employees/?"+query, nil)
+		suite.r.ServeHTTP(w, req)
+		suite.Equal(http.StatusBadRequest, w.Code, query)
+	}
+}
+
 func (suite *EmployeeControllerTestSuite) TestCreateEmployeeHandler() {
 	input := `{"name":"John","email":"john@example.com","position":"Dev","salary":60000}`
 	created := models.Employee{ID: 1, Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000}
-	suite.svc.EXPECT().CreateEmployee(gomock.Any()).Return(created, nil)
+	suite.svc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(created, nil)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("POST", "/api/v1/employees/", strings.NewReader(input))
@@ -85,12 +157,19 @@ func (suite *EmployeeControllerTestSuite) TestCreateEmployeeHandler() {
 
 	suite.Equal(http.StatusCreated, w.Code)
 	suite.Contains(w.Body.String(), "John")
+
+	suite.svc.EXPECT().Create(gomock.Any(), gomock.Any()).Return(models.Employee{}, models.ErrValidation)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/employees/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
 }
 
 func (suite *EmployeeControllerTestSuite) TestUpdateEmployeeHandler() {
 	input := `{"name":"Updated","email":"updated@example.com","position":"Lead","salary":80000}`
 	updated := models.Employee{ID: 1, Name: "Updated", Email: "updated@example.com", Position: "Lead", Salary: 80000}
-	suite.svc.EXPECT().UpdateEmployee(uint(1), gomock.Any()).Return(updated, nil)
+	suite.svc.EXPECT().Update(gomock.Any(), uint(1), gomock.Any()).Return(updated, nil)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("PUT", "/api/v1/employees/1", strings.NewReader(input))
@@ -102,7 +181,7 @@ func (suite *EmployeeControllerTestSuite) TestUpdateEmployeeHandler() {
 }
 
 func (suite *EmployeeControllerTestSuite) TestDeleteEmployeeHandler() {
-	suite.svc.EXPECT().DeleteEmployee(uint(1)).Return(nil)
+	suite.svc.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil)
 
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("DELETE", "/api/v1/employees/1", nil)
@@ -111,3 +190,117 @@ func (suite *EmployeeControllerTestSuite) TestDeleteEmployeeHandler() {
 	suite.Equal(http.StatusOK, w.Code)
 	suite.Contains(w.Body.String(), "Employee deleted successfully")
 }
+
+func (suite *EmployeeControllerTestSuite) TestGetReportingChainHandler() {
+	chain := []models.Employee{{ID: 1, Name: "Alice"}, {ID: 3, Name: "Charlie"}}
+	suite.svc.EXPECT().GetReportingChain(gomock.Any(), uint(4)).Return(chain, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/4/chain", nil)
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "Charlie")
+
+	suite.svc.EXPECT().GetDirectReports(gomock.Any(), uint(9)).Return(nil, models.ErrEmployeeNotFound)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/employees/9/reports", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *EmployeeControllerTestSuite) TestGetOrgChartHandler() {
+	root := uint(3)
+	chart := []models.OrgChartNode{{ID: 3, Name: "Chloé \"Chuck\" O\\Brien", Position: "Manager", Reports: []models.OrgChartNode{
+		{ID: 1, Name: "Alice", Position: "Dev", Reports: []models.OrgChartNode{}},
+	}}}
+	suite.svc.EXPECT().GetOrgChart(gomock.Any(), &root).Return(chart, nil).Times(2)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/org-chart?root=3", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"reports":[{"id":1`)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/employees/org-chart?root=3&format=dot", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Header().Get("Content-Type"), "text/vnd.graphviz")
+	suite.Contains(w.Body.String(), "digraph orgchart {")
+	suite.Contains(w.Body.String(), "e3 -> e1;")
+	// Graphviz only decodes escaped quotes and backslashes, so UTF-8 is kept as is
+	suite.Contains(w.Body.String(), `e3 [label="Chloé \"Chuck\" O\\Brien\nManager"];`)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/employees/org-chart?format=svg", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *EmployeeControllerTestSuite) TestCreateEmployeeSalaryOverrideHandler() {
+	input := `{"name":"John","email":"john@example.com","position_id":3,"salary":200000,"salary_override":{"justification":"Competing offer"}}`
+
+	// Anonymous callers may not override salary bands
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/employees/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusForbidden, w.Code)
+
+	// HR may, and is recorded as the approver
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}})
+	})
+	controller := &employeeControllerImpl{employeeService: suite.svc}
+	controller.RegisterRoutes(r.Group("/api/v1"))
+	suite.svc.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.Equal("hr-lead", e.SalaryOverride.ApprovedBy)
+		e.ID = 1
+		e.SalaryOutOfBand = true
+		return e, nil
+	})
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/employees/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Contains(w.Body.String(), `"salary_out_of_band":true`)
+}
+
+func (suite *EmployeeControllerTestSuite) TestTerminateEmployeeHandler() {
+	input := `{"effective_date":"2026-03-31T00:00:00Z","reason":"Resigned"}`
+	suite.svc.EXPECT().TransitionEmployee(gomock.Any(), uint(1), models.StatusTerminated, gomock.Any()).DoAndReturn(
+		func(_ context.Context, id uint, status models.EmployeeStatus, request models.StatusTransitionRequest) (models.Employee, error) {
+			suite.Equal("Resigned", request.Reason)
+			suite.Equal(2026, request.EffectiveDate.Year())
+			return models.Employee{ID: id, Status: status, TerminationReason: request.Reason}, nil
+		})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/employees/1/terminate", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"status":"terminated"`)
+
+	suite.svc.EXPECT().TransitionEmployee(gomock.Any(), uint(1), models.StatusActive, models.StatusTransitionRequest{ChangedBy: "anonymous"}).
+		Return(models.Employee{}, models.ErrInvalidTransition)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/employees/1/activate", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusConflict, w.Code)
+}
+
+func (suite *EmployeeControllerTestSuite) TestGetStatusHistoryHandler() {
+	history := []models.EmployeeStatusTransition{{ID: 1, EmployeeID: 1, FromStatus: models.StatusActive, ToStatus: models.StatusOnLeave}}
+	suite.svc.EXPECT().GetStatusHistory(gomock.Any(), uint(1)).Return(history, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/1/status-history", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"to_status":"on_leave"`)
+}
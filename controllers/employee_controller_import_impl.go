@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+)
+
+// importColumns are the CSV columns an employee import understands. Headers
+// are matched case-insensitively, with spaces and dashes read as underscores.
+var importColumns = map[string]func(row *models.ImportRow, value string) error{
+	"name":     func(row *models.ImportRow, value string) error { row.Patch.Name = &value; return nil },
+	"email":    func(row *models.ImportRow, value string) error { row.Patch.Email = &value; return nil },
+	"position": func(row *models.ImportRow, value string) error { row.Patch.Position = &value; return nil },
+	"currency": func(row *models.ImportRow, value string) error { row.Patch.Currency = &value; return nil },
+	"status": func(row *models.ImportRow, value string) error {
+		row.Status = models.EmployeeStatus(value)
+		return nil
+	},
+	"position_id":   func(row *models.ImportRow, value string) error { return parseImportID(value, &row.Patch.PositionID) },
+	"department_id": func(row *models.ImportRow, value string) error { return parseImportID(value, &row.Patch.DepartmentID) },
+	"manager_id":    func(row *models.ImportRow, value string) error { return parseImportID(value, &row.Patch.ManagerID) },
+	"salary": func(row *models.ImportRow, value string) error {
+		salary, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid salary %q", value)
+		}
+		row.Patch.Salary = &salary
+		return nil
+	},
+	"join_date": func(row *models.ImportRow, value string) error {
+		joinDate, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			if joinDate, err = time.Parse(time.RFC3339, value); err != nil {
+				return fmt.Errorf("invalid join_date %q, use YYYY-MM-DD", value)
+			}
+		}
+		row.Patch.JoinDate = &joinDate
+		return nil
+	},
+}
+
+// ImportEmployees handles POST request to import employees from CSV
+// @Summary Import employees from CSV
+// @Description Imports employees from a CSV file, sent as the "file" field of a multipart form or as the raw request body. The header row names the columns: name, email, position, position_id, salary, currency, department_id, manager_id, join_date and status. Rows are matched by email; existing employees are updated with the non-empty cells, the rest are created. With dry_run=true nothing is written and the report shows what would happen. Otherwise all rows are saved in one transaction, or none are if any row fails.
+// @Tags employees
+// @Accept text/csv
+// @Accept multipart/form-data
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param dry_run query bool false "Validate only, without saving"
+// @Param file formData file false "CSV file"
+// @Success 200 {object} models.ImportReport "Import report"
+// @Failure 400 {object} models.ImportReport "Some rows failed, nothing was imported"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/import [post]
+func (ec *employeeControllerImpl) ImportEmployees(c *gin.Context) {
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": "dry_run must be true or false"})
+		return
+	}
+
+	body := io.Reader(c.Request.Body)
+	if c.ContentType() == gin.MIMEMultipartPOSTForm {
+		header, err := c.FormFile("file")
+		if err != nil {
+			respond(c, http.StatusBadRequest, gin.H{"error": "multipart imports need the CSV in the file field"})
+			return
+		}
+		file, err := header.Open()
+		if err != nil {
+			respond(c, http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	rows, err := parseEmployeeCSV(body)
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := ec.employeeService.ImportEmployees(c.Request.Context(), rows, dryRun)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	if !dryRun && report.Failed > 0 {
+		respond(c, http.StatusBadRequest, report)
+		return
+	}
+	respond(c, http.StatusOK, report)
+}
+
+// parseEmployeeCSV maps each record onto the columns named in the header row.
+// Empty cells are left unset. A bad header fails the whole file; a bad cell
+// only fails its row.
+func parseEmployeeCSV(r io.Reader) ([]models.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("the CSV is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	setters := make([]func(*models.ImportRow, string) error, len(header))
+	hasEmail := false
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+		setter, ok := importColumns[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown CSV column %q", header[i])
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate CSV column %q", header[i])
+		}
+		seen[name] = true
+		hasEmail = hasEmail || name == "email"
+		setters[i] = setter
+	}
+	if !hasEmail {
+		return nil, errors.New("the CSV needs an email column to match employees")
+	}
+
+	var rows []models.ImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			rows = append(rows, models.ImportRow{
+				Line: parseErr.StartLine,
+				Err:  fmt.Errorf("%w: %s", models.ErrValidation, parseErr.Err),
+			})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		row := models.ImportRow{Line: line}
+		for i, value := range record {
+			if value = strings.TrimSpace(value); value == "" {
+				continue
+			}
+			if err := setters[i](&row, value); err != nil {
+				row.Err = fmt.Errorf("%w: %s", models.ErrValidation, err.Error())
+				break
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// parseImportID parses an ID cell into dest
+func parseImportID(value string, dest **uint) error {
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		return fmt.Errorf("invalid ID %q", value)
+	}
+	idValue := uint(id)
+	*dest = &idValue
+	return nil
+}
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *EmployeeControllerTestSuite) TestImportEmployeesHandlerDryRun() {
+	suite.svc.EXPECT().ImportEmployees(gomock.Any(), gomock.Any(), true).DoAndReturn(func(_ context.Context, rows []models.ImportRow, dryRun bool) (models.ImportReport, error) {
+		suite.Len(rows, 2)
+		suite.Equal(2, rows[0].Line)
+		suite.Equal("John", *rows[0].Patch.Name)
+		suite.Equal(40000.0, *rows[0].Patch.Salary)
+		suite.Equal(uint(2), *rows[0].Patch.DepartmentID)
+		suite.Equal(models.StatusOnboarding, rows[0].Status)
+		suite.Nil(rows[1].Patch.Salary)
+		suite.ErrorIs(rows[1].Err, models.ErrValidation)
+		return models.ImportReport{DryRun: true, Created: 1, Failed: 1}, nil
+	})
+
+	csv := "Name,Email,Salary,Department ID,Status\nJohn,john@example.com,40000,2,onboarding\nJane,jane@example.com,,abc,\n"
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/employees/import?dry_run=true", strings.NewReader(csv))
+	req.Header.Set("Content-Type", "text/csv")
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"dry_run":true`)
+}
+
+func (suite *EmployeeControllerTestSuite) TestImportEmployeesHandlerMultipartFailedRows() {
+	suite.svc.EXPECT().ImportEmployees(gomock.Any(), gomock.Len(1), false).Return(models.ImportReport{Failed: 1}, nil)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	file, _ := form.CreateFormFile("file", "employees.csv")
+	file.Write([]byte("email,name\njohn@example.com,John\n"))
+	form.Close()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/employees/import", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), `"failed":1`)
+}
+
+func (suite *EmployeeControllerTestSuite) TestImportEmployeesHandlerBadHeader() {
+	for _, csv := range []string{"name,salary\nJohn,1\n", "email,nickname\njohn@example.com,J\n", ""} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/employees/import", strings.NewReader(csv))
+		req.Header.Set("Content-Type", "text/csv")
+		suite.r.ServeHTTP(w, req)
+
+		suite.Equal(http.StatusBadRequest, w.Code, csv)
+	}
+}
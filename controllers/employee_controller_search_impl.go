@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// searchQuery holds the query parameters of an employee search
+type searchQuery struct {
+	Q     string `form:"q" binding:"required"`
+	Limit int    `form:"limit" binding:"omitempty,min=1"`
+}
+
+// SearchEmployees handles GET request to search employees by name, email and position
+// @Summary Search employees
+// @Description Full-text search over employee names, emails and positions, most relevant first. Every query word must match; words match as prefixes and tolerate small typos. Name matches rank above position matches, which rank above email matches. Matched words are wrapped in <mark></mark> in the highlights.
+// @Tags employees
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param q query string true "Search query"
+// @Param limit query int false "Maximum number of hits (default 20, max 100)"
+// @Success 200 {array} models.SearchHit
+// @Failure 400 {object} map[string]interface{} "Missing query or invalid limit"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/search [get]
+func (ec *employeeControllerImpl) SearchEmployees(c *gin.Context) {
+	var query searchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	hits, err := ec.employeeService.SearchEmployees(c.Request.Context(), query.Q, query.Limit)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, hits)
+}
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *EmployeeControllerTestSuite) TestSearchEmployeesHandler() {
+	hits := []models.SearchHit{{
+		Employee:   models.Employee{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev"},
+		Score:      2.5,
+		Highlights: models.SearchHighlights{Name: "<mark>Alice</mark>"},
+	}}
+	suite.svc.EXPECT().SearchEmployees(gomock.Any(), "ali", 5).Return(hits, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/search?q=ali&limit=5", nil)
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"score":2.5`)
+	suite.Contains(w.Body.String(), `"highlights":{"name":"\u003cmark\u003eAlice\u003c/mark\u003e"}`)
+}
+
+func (suite *EmployeeControllerTestSuite) TestSearchEmployeesHandlerXML() {
+	suite.svc.EXPECT().SearchEmployees(gomock.Any(), "ali", 0).Return([]models.SearchHit{{
+		Employee:   models.Employee{ID: 1, Name: "Alice"},
+		Highlights: models.SearchHighlights{Name: "<mark>Alice</mark>"},
+	}}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/search?q=ali", nil)
+	req.Header.Set("Accept", "application/xml")
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), "<items><SearchHit>")
+	suite.Contains(w.Body.String(), "&lt;mark&gt;Alice&lt;/mark&gt;")
+}
+
+func (suite *EmployeeControllerTestSuite) TestSearchEmployeesHandlerBadRequest() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/search", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+
+	suite.svc.EXPECT().SearchEmployees(gomock.Any(), "ali", 500).Return(nil, models.ErrValidation)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/employees/search?q=ali&limit=500", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *EmployeeControllerTestSuite) TestSearchEmployeesHandlerError() {
+	suite.svc.EXPECT().SearchEmployees(gomock.Any(), "ali", 0).Return(nil, errors.New("index down"))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/employees/search?q=ali", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,250 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// wsSendBuffer is how many messages may wait for a slow client before it is disconnected
+	wsSendBuffer = 256
+	// wsMaxSubscriptions is how many subscriptions one connection may hold
+	wsMaxSubscriptions = 20
+	// wsMaxMessageSize is the largest message a client may send
+	wsMaxMessageSize = 4096
+	wsWriteTimeout   = 10 * time.Second
+	// wsPongTimeout is how long a client may stay silent; pings go out well within it
+	wsPongTimeout  = 60 * time.Second
+	wsPingInterval = wsPongTimeout * 9 / 10
+)
+
+var wsUpgrader = websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 1024}
+
+// SubscribeEvents handles the WebSocket endpoint for live employee changes
+// @Summary Subscribe to employee changes over WebSocket
+// @Description Upgrades to a WebSocket on which the client manages its own subscriptions with JSON messages. Send {"type":"subscribe","id":"mine","filter":{"department_id":1,"employee_ids":[4]},"last_event_id":41} to receive {"type":"event","id":"mine","event":{...}} for every matching change, {"type":"unsubscribe","id":"mine"} to stop, and {"type":"ping"} to get a pong. The server answers subscribed, unsubscribed, reset (events after last_event_id are no longer retained) and error messages. Requires a token, which browsers pass as the access_token query parameter. Clients that fall 256 messages behind are disconnected with close code 1013 and should resubscribe with the last event ID they handled.
+// @Tags employees
+// @Param access_token query string false "Bearer token, for clients that cannot set the Authorization header"
+// @Success 101 {object} models.EventServerMessage "Switching to the WebSocket protocol"
+// @Failure 400 {object} map[string]interface{} "Not a WebSocket request"
+// @Failure 401 {object} map[string]interface{} "Missing token"
+// @Router /employees/ws [get]
+func (ec *employeeControllerImpl) SubscribeEvents(c *gin.Context) {
+	if !auth.FromContext(c).Authenticated() {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Live updates require a token"})
+		return
+	}
+	// The upgrader answers failed upgrades itself
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	newWSClient(c.Request.Context(), conn, ec.employeeService).run()
+}
+
+// wsClient serves one WebSocket connection. Reads happen on the handler's
+// goroutine and writes on their own, fed through a bounded queue so that a
+// slow client never blocks event delivery to anyone else.
+type wsClient struct {
+	// ctx is the upgraded request's, which carries the caller's tenant
+	ctx     context.Context
+	conn    *websocket.Conn
+	service service.EmployeeService
+	send    chan models.EventServerMessage
+	done    chan struct{}
+	once    sync.Once
+
+	mu            sync.Mutex
+	subscriptions map[string]*events.Subscription
+}
+
+func newWSClient(ctx context.Context, conn *websocket.Conn, employeeService service.EmployeeService) *wsClient {
+	return &wsClient{
+		ctx:           ctx,
+		conn:          conn,
+		service:       employeeService,
+		send:          make(chan models.EventServerMessage, wsSendBuffer),
+		done:          make(chan struct{}),
+		subscriptions: make(map[string]*events.Subscription),
+	}
+}
+
+// run serves the connection until it fails or the client goes away
+func (w *wsClient) run() {
+	go w.writeLoop()
+	defer w.close(websocket.CloseNormalClosure, "")
+
+	w.conn.SetReadLimit(wsMaxMessageSize)
+	w.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	w.conn.SetPongHandler(func(string) error {
+		return w.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+	for {
+		_, data, err := w.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		w.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+
+		var message models.EventClientMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			w.enqueue(models.EventServerMessage{Type: "error", Error: "Invalid message: " + err.Error()})
+			continue
+		}
+		w.handle(message)
+	}
+}
+
+func (w *wsClient) handle(message models.EventClientMessage) {
+	switch message.Type {
+	case "subscribe":
+		w.subscribe(message)
+	case "unsubscribe":
+		w.mu.Lock()
+		subscription, ok := w.subscriptions[message.ID]
+		delete(w.subscriptions, message.ID)
+		w.mu.Unlock()
+		if !ok {
+			w.enqueue(models.EventServerMessage{Type: "error", ID: message.ID, Error: "Unknown subscription"})
+			return
+		}
+		subscription.Close()
+		w.enqueue(models.EventServerMessage{Type: "unsubscribed", ID: message.ID})
+	case "ping":
+		w.enqueue(models.EventServerMessage{Type: "pong"})
+	default:
+		w.enqueue(models.EventServerMessage{Type: "error", ID: message.ID, Error: "Unknown message type " + message.Type})
+	}
+}
+
+func (w *wsClient) subscribe(message models.EventClientMessage) {
+	if message.ID == "" {
+		w.enqueue(models.EventServerMessage{Type: "error", Error: "A subscription needs an id"})
+		return
+	}
+	w.mu.Lock()
+	if _, ok := w.subscriptions[message.ID]; ok {
+		w.mu.Unlock()
+		w.enqueue(models.EventServerMessage{Type: "error", ID: message.ID, Error: "Already subscribed"})
+		return
+	}
+	if len(w.subscriptions) >= wsMaxSubscriptions {
+		w.mu.Unlock()
+		w.enqueue(models.EventServerMessage{Type: "error", ID: message.ID, Error: "Too many subscriptions"})
+		return
+	}
+	subscription, err := w.service.SubscribeEvents(w.ctx, message.Filter, message.LastEventID)
+	if err != nil {
+		w.mu.Unlock()
+		w.enqueue(models.EventServerMessage{Type: "error", ID: message.ID, Error: err.Error()})
+		return
+	}
+	w.subscriptions[message.ID] = subscription
+	w.mu.Unlock()
+
+	w.enqueue(models.EventServerMessage{Type: "subscribed", ID: message.ID})
+	go w.forward(message.ID, subscription)
+}
+
+// forward sends a subscription's replay and then queues its live events
+// until it is closed. The replay can be longer than the send queue, so it
+// waits for the client to keep up instead of disconnecting it; live events
+// arriving meanwhile wait in the subscription.
+func (w *wsClient) forward(id string, subscription *events.Subscription) {
+	if subscription.Missed && !w.deliver(models.EventServerMessage{Type: "reset", ID: id}) {
+		return
+	}
+	for i := range subscription.Replay {
+		if !w.deliver(models.EventServerMessage{Type: "event", ID: id, Event: &subscription.Replay[i]}) {
+			return
+		}
+	}
+	for event := range subscription.C {
+		event := event
+		w.enqueue(models.EventServerMessage{Type: "event", ID: id, Event: &event})
+	}
+
+	// The broker only closes a subscription it had to drop; an unsubscribe
+	// has already removed it
+	w.mu.Lock()
+	dropped := w.subscriptions[id] == subscription
+	if dropped {
+		delete(w.subscriptions, id)
+	}
+	w.mu.Unlock()
+	if dropped {
+		w.enqueue(models.EventServerMessage{Type: "error", ID: id, Error: "Subscription dropped, subscribe again with last_event_id"})
+	}
+}
+
+// deliver queues a message for the client, waiting for room in its queue.
+// It reports false once the connection is closed.
+func (w *wsClient) deliver(message models.EventServerMessage) bool {
+	select {
+	case w.send <- message:
+		return true
+	case <-w.done:
+		return false
+	}
+}
+
+// enqueue queues a message for the client, disconnecting it when its queue is full
+func (w *wsClient) enqueue(message models.EventServerMessage) {
+	select {
+	case w.send <- message:
+	case <-w.done:
+	default:
+		w.close(websocket.CloseTryAgainLater, "client too slow")
+	}
+}
+
+func (w *wsClient) writeLoop() {
+	ping := time.NewTicker(wsPingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case message := <-w.send:
+			w.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := w.conn.WriteJSON(message); err != nil {
+				w.close(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-ping.C:
+			if err := w.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				w.close(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		}
+	}
+}
+
+// close releases the subscriptions and closes the connection with the given
+// code. Only the first call has any effect.
+func (w *wsClient) close(code int, reason string) {
+	w.once.Do(func() {
+		close(w.done)
+		w.mu.Lock()
+		for id, subscription := range w.subscriptions {
+			subscription.Close()
+			delete(w.subscriptions, id)
+		}
+		w.mu.Unlock()
+		if code != websocket.CloseAbnormalClosure {
+			message := websocket.FormatCloseMessage(code, reason)
+			w.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(wsWriteTimeout))
+		}
+		w.conn.Close()
+	})
+}
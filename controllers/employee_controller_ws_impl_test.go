@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/mock/gomock"
+)
+
+// dialEvents opens the WebSocket endpoint as the given principal
+func (suite *EmployeeControllerTestSuite) dialEvents(principal auth.Principal) (*websocket.Conn, *http.Response, error) {
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, principal)
+	})
+	controller := &employeeControllerImpl{employeeService: suite.svc}
+	controller.RegisterRoutes(r.Group("/api/v1"))
+	server := httptest.NewServer(r)
+	suite.T().Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/employees/ws"
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	if err == nil {
+		suite.T().Cleanup(func() { conn.Close() })
+	}
+	return conn, resp, err
+}
+
+func (suite *EmployeeControllerTestSuite) readMessage(conn *websocket.Conn) models.EventServerMessage {
+	var message models.EventServerMessage
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	suite.Require().NoError(conn.ReadJSON(&message))
+	return message
+}
+
+var wsUser = auth.Principal{Subject: "admin-spa", Roles: []string{auth.RoleAdmin}}
+
+func (suite *EmployeeControllerTestSuite) TestSubscribeEventsHandler() {
+	broker := events.NewBroker(10)
+	departmentID := uint(3)
+	filter := models.EventFilter{DepartmentID: &departmentID}
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), filter, nil).DoAndReturn(subscribeTo(broker))
+
+	conn, _, err := suite.dialEvents(wsUser)
+	suite.Require().NoError(err)
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "subscribe", ID: "team", Filter: filter}))
+	suite.Equal(models.EventServerMessage{Type: "subscribed", ID: "team"}, suite.readMessage(conn))
+
+	otherDepartment := uint(4)
+	broker.Publish(
+		models.EmployeeEvent{Type: models.EventEmployeeUpdated, EmployeeID: 1, Employee: models.Employee{ID: 1, DepartmentID: &otherDepartment}},
+		models.EmployeeEvent{Type: models.EventEmployeeUpdated, EmployeeID: 2, Employee: models.Employee{ID: 2, DepartmentID: &departmentID}},
+	)
+	message := suite.readMessage(conn)
+	suite.Equal("event", message.Type)
+	suite.Equal("team", message.ID)
+	suite.Equal(uint(2), message.Event.EmployeeID)
+
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "unsubscribe", ID: "team"}))
+	suite.Equal(models.EventServerMessage{Type: "unsubscribed", ID: "team"}, suite.readMessage(conn))
+	broker.Publish(models.EmployeeEvent{Type: models.EventEmployeeDeleted, EmployeeID: 2, Employee: models.Employee{ID: 2, DepartmentID: &departmentID}})
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "ping"}))
+	suite.Equal(models.EventServerMessage{Type: "pong"}, suite.readMessage(conn))
+}
+
+func (suite *EmployeeControllerTestSuite) TestSubscribeEventsHandlerResume() {
+	broker := events.NewBroker(10)
+	for id := uint(1); id <= 3; id++ {
+		broker.Publish(models.EmployeeEvent{Type: models.EventEmployeeCreated, EmployeeID: id})
+	}
+	after := uint64(2)
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), models.EventFilter{}, &after).DoAndReturn(subscribeTo(broker))
+
+	conn, _, err := suite.dialEvents(wsUser)
+	suite.Require().NoError(err)
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "subscribe", ID: "all", LastEventID: &after}))
+	suite.Equal("subscribed", suite.readMessage(conn).Type)
+	message := suite.readMessage(conn)
+	suite.Equal("event", message.Type)
+	suite.Equal(uint64(3), message.Event.ID)
+}
+
+func (suite *EmployeeControllerTestSuite) TestSubscribeEventsHandlerProtocolErrors() {
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), models.EventFilter{}, nil).DoAndReturn(subscribeTo(events.NewBroker(1)))
+
+	conn, _, err := suite.dialEvents(wsUser)
+	suite.Require().NoError(err)
+
+	suite.NoError(conn.WriteMessage(websocket.TextMessage, []byte("{not json")))
+	suite.Equal("error", suite.readMessage(conn).Type)
+
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "subscribe"}))
+	suite.Equal("A subscription needs an id", suite.readMessage(conn).Error)
+
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "subscribe", ID: "a"}))
+	suite.Equal("subscribed", suite.readMessage(conn).Type)
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "subscribe", ID: "a"}))
+	suite.Equal(models.EventServerMessage{Type: "error", ID: "a", Error: "Already subscribed"}, suite.readMessage(conn))
+
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "unsubscribe", ID: "b"}))
+	suite.Equal(models.EventServerMessage{Type: "error", ID: "b", Error: "Unknown subscription"}, suite.readMessage(conn))
+
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "shout"}))
+	suite.Equal("Unknown message type shout", suite.readMessage(conn).Error)
+}
+
+func (suite *EmployeeControllerTestSuite) TestSubscribeEventsHandlerRequiresToken() {
+	_, resp, err := suite.dialEvents(auth.Anonymous)
+	suite.Error(err)
+	suite.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+func (suite *EmployeeControllerTestSuite) TestSlowWebSocketClientIsDisconnected() {
+	clients := make(chan *wsClient, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := wsUpgrader.Upgrade(w, r, nil)
+		suite.Require().NoError(err)
+		// No write loop runs, so the queue fills up
+		clients <- newWSClient(r.Context(), conn, suite.svc)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	suite.Require().NoError(err)
+	defer conn.Close()
+	client := <-clients
+	for i := 0; i <= wsSendBuffer; i++ {
+		client.enqueue(models.EventServerMessage{Type: "pong"})
+	}
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err = conn.ReadMessage()
+	suite.True(websocket.IsCloseError(err, websocket.CloseTryAgainLater), "got %v", err)
+}
+
+func (suite *EmployeeControllerTestSuite) TestSubscribeEventsHandlerReplaysMoreThanTheSendQueue() {
+	broker := events.NewBroker(2 * wsSendBuffer)
+	for id := uint(1); id <= 2*wsSendBuffer; id++ {
+		broker.Publish(models.EmployeeEvent{Type: models.EventEmployeeCreated, EmployeeID: id})
+	}
+	after := uint64(1)
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), models.EventFilter{}, &after).DoAndReturn(subscribeTo(broker))
+
+	conn, _, err := suite.dialEvents(wsUser)
+	suite.Require().NoError(err)
+	suite.NoError(conn.WriteJSON(models.EventClientMessage{Type: "subscribe", ID: "all", LastEventID: &after}))
+	suite.Equal("subscribed", suite.readMessage(conn).Type)
+	// The client is not disconnected however far behind it resumes
+	for id := after + 1; id <= 2*wsSendBuffer; id++ {
+		message := suite.readMessage(conn)
+		suite.Require().Equal("event", message.Type)
+		suite.Require().Equal(id, message.Event.ID)
+	}
+}
@@ -0,0 +1,11 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// GraphQLController defines the interface for the GraphQL endpoint
+type GraphQLController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	Query(c *gin.Context)
+}
@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/graph"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+)
+
+// graphQLControllerImpl is the concrete implementation of GraphQLController
+// (see graphql_controller.go for the interface definition)
+type graphQLControllerImpl struct {
+	executor graph.Executor
+}
+
+// NewGraphQLController creates a new instance of GraphQLController
+func NewGraphQLController(executor graph.Executor) GraphQLController {
+	return &graphQLControllerImpl{
+		executor: executor,
+	}
+}
+
+// RegisterRoutes registers the GraphQL route with the given router group.
+func (gc *graphQLControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/graphql", gc.Query)
+}
+
+// Query handles POST request to run a GraphQL query or mutation
+// @Summary Run a GraphQL request
+// @Description Runs a query or mutation against the employee schema. Employees can be fetched with their manager, department, reports and salary history in one request; relations are loaded in batches. Queries deeper or more complex than the configured limits are rejected. Errors are returned in the errors array with a code in their extensions, with status 200 as long as the request body could be read.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body models.GraphQLRequest true "GraphQL request"
+// @Success 200 {object} map[string]interface{} "GraphQL response with data and errors"
+// @Failure 400 {object} map[string]interface{} "Unreadable request body"
+// @Router /graphql [post]
+func (gc *graphQLControllerImpl) Query(c *gin.Context) {
+	var request models.GraphQLRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": []gin.H{{"message": err.Error()}}})
+		return
+	}
+
+	c.JSON(http.StatusOK, gc.executor.Execute(c.Request.Context(), request))
+}
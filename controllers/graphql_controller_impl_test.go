@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/graph"
+	"github.com/chinmay-sawant/gin-example/models"
+	repomocks "github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type GraphQLControllerTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	svc       *mocks.MockEmployeeService
+	r         *gin.Engine
+	principal auth.Principal
+}
+
+func (suite *GraphQLControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockEmployeeService(suite.ctrl)
+	suite.principal = auth.Principal{Subject: "dev1", Roles: []string{auth.RoleEmployee}}
+	executor, err := graph.NewExecutor(suite.svc, repomocks.NewMockEmployeeRepository(suite.ctrl),
+		repomocks.NewMockDepartmentRepository(suite.ctrl))
+	suite.Require().NoError(err)
+
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	controller := &graphQLControllerImpl{executor: executor}
+	v1 := suite.r.Group("/api/v1")
+	controller.RegisterRoutes(v1)
+}
+
+func (suite *GraphQLControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestGraphQLControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(GraphQLControllerTestSuite))
+}
+
+func (suite *GraphQLControllerTestSuite) post(body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *GraphQLControllerTestSuite) TestQueryHandler() {
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).Return(models.Employee{ID: 1, Name: "Alice"}, nil)
+
+	w := suite.post(`{"query":"query One($id: Int!) { employee(id: $id) { id name } }","operationName":"One","variables":{"id":1}}`)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"data":{"employee":{"id":1,"name":"Alice"}}}`, w.Body.String())
+}
+
+func (suite *GraphQLControllerTestSuite) TestQueryHandlerUsesCaller() {
+	// The caller holds no salary:override permission
+	w := suite.post(`{"query":"mutation { createEmployee(input: {name: \"C\", email: \"c@example.com\", position: \"Dev\", salary: 1}, salaryOverride: {justification: \"x\"}) { id } }"}`)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"code":"FORBIDDEN"`)
+}
+
+func (suite *GraphQLControllerTestSuite) TestQueryHandlerInvalidBody() {
+	w := suite.post(`{"variables":{}}`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), `"errors"`)
+}
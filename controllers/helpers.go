@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+)
+
+// errorStatus maps a domain error to its HTTP status code, falling back to
+// the given status for errors the domain does not know about.
+func errorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, models.ErrEmployeeNotFound), errors.Is(err, models.ErrDepartmentNotFound),
+		errors.Is(err, models.ErrPositionNotFound), errors.Is(err, models.ErrWebhookNotFound),
+		errors.Is(err, models.ErrDeliveryNotFound), errors.Is(err, models.ErrTenantNotFound),
+		errors.Is(err, models.ErrCustomFieldNotFound), errors.Is(err, models.ErrTagNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, models.ErrValidation):
+		return http.StatusBadRequest
+	case errors.Is(err, models.ErrConflict), errors.Is(err, models.ErrInvalidTransition):
+		return http.StatusConflict
+	case errors.Is(err, models.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, models.ErrRolledBack):
+		return http.StatusFailedDependency
+	case errors.Is(err, errUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.As(err, new(*http.MaxBytesError)):
+		return http.StatusRequestEntityTooLarge
+	default:
+		return fallback
+	}
+}
+
+// setPaginationHeaders exposes list metadata without changing the array response body
+func setPaginationHeaders(c *gin.Context, p models.Pagination, total int64) {
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+	c.Header("X-Page", strconv.Itoa(p.Page))
+	c.Header("X-Page-Size", strconv.Itoa(p.PageSize))
+}
+
+// parseUintID reads a numeric ID from a path parameter
+func parseUintID(param string) (uint, error) {
+	id, err := strconv.ParseUint(param, 10, 32)
+	return uint(id), err
+}
+
+// bindEmployeeView reads the fields and expand query parameters of an employee response
+func bindEmployeeView(c *gin.Context) (models.EmployeeView, error) {
+	return models.ParseEmployeeView(c.Query("fields"), c.Query("expand"))
+}
+
+// bindTagQuery reads the tags, tags_any and tags_not query parameters of an employee list
+func bindTagQuery(c *gin.Context) (models.TagQuery, error) {
+	return models.ParseTagQuery(c.Query("tags"), c.Query("tags_any"), c.Query("tags_not"))
+}
+
+// bindCustomFieldQuery reads the custom_fields[name] query parameters of an
+// employee list. The service parses each value as the type of its field.
+func bindCustomFieldQuery(c *gin.Context) map[string]interface{} {
+	values := c.QueryMap("custom_fields")
+	if len(values) == 0 {
+		return nil
+	}
+	filters := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		filters[name] = value
+	}
+	return filters
+}
+
+// projectEmployee returns the employee as the view shows it: the full record
+// unless the view selects fields
+func projectEmployee(employee models.Employee, view models.EmployeeView) interface{} {
+	if !view.Sparse() {
+		return employee
+	}
+	return gin.H(view.Project(employee))
+}
+
+// projectEmployees applies projectEmployee to a list
+func projectEmployees(employees []models.Employee, view models.EmployeeView) interface{} {
+	if !view.Sparse() {
+		return employees
+	}
+	projected := make([]gin.H, len(employees))
+	for i := range employees {
+		projected[i] = view.Project(employees[i])
+	}
+	return projected
+}
+
+// requirePermission aborts with 403 unless the caller holds the permission
+func requirePermission(permission auth.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !auth.FromContext(c).Can(permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "This operation requires the " + string(permission) + " permission"})
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\analytics_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\analytics_controller.go -destination=controllers\mocks\mock_analytics_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAnalyticsController is a mock of AnalyticsController interface.
+type MockAnalyticsController struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockAnalyticsControllerMockRecorder is the mock recorder for MockAnalyticsController.
+type MockAnalyticsControllerMockRecorder struct {
+	mock *MockAnalyticsController
+}
+
+// NewMockAnalyticsController creates a new mock instance.
+func NewMockAnalyticsController(ctrl *gomock.Controller) *MockAnalyticsController {
+	mock := &MockAnalyticsController{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsController) EXPECT() *MockAnalyticsControllerMockRecorder {
+	return m.recorder
+}
+
+// GetHeadcount mocks base method.
+func (m *MockAnalyticsController) GetHeadcount(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetHeadcount", c)
+}
+
+// GetHeadcount indicates an expected call of GetHeadcount.
+func (mr *MockAnalyticsControllerMockRecorder) GetHeadcount(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeadcount", reflect.TypeOf((*MockAnalyticsController)(nil).GetHeadcount), c)
+}
+
+// GetMovements mocks base method.
+func (m *MockAnalyticsController) GetMovements(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetMovements", c)
+}
+
+// GetMovements indicates an expected call of GetMovements.
+func (mr *MockAnalyticsControllerMockRecorder) GetMovements(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovements", reflect.TypeOf((*MockAnalyticsController)(nil).GetMovements), c)
+}
+
+// GetSalaryStats mocks base method.
+func (m *MockAnalyticsController) GetSalaryStats(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSalaryStats", c)
+}
+
+// GetSalaryStats indicates an expected call of GetSalaryStats.
+func (mr *MockAnalyticsControllerMockRecorder) GetSalaryStats(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalaryStats", reflect.TypeOf((*MockAnalyticsController)(nil).GetSalaryStats), c)
+}
+
+// GetTenureDistribution mocks base method.
+func (m *MockAnalyticsController) GetTenureDistribution(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetTenureDistribution", c)
+}
+
+// GetTenureDistribution indicates an expected call of GetTenureDistribution.
+func (mr *MockAnalyticsControllerMockRecorder) GetTenureDistribution(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenureDistribution", reflect.TypeOf((*MockAnalyticsController)(nil).GetTenureDistribution), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockAnalyticsController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockAnalyticsControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockAnalyticsController)(nil).RegisterRoutes), router)
+}
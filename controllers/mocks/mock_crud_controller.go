@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\crud_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\crud_controller.go -destination=controllers\mocks\mock_crud_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCRUDController is a mock of CRUDController interface.
+type MockCRUDController struct {
+	ctrl     *gomock.Controller
+	recorder *MockCRUDControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockCRUDControllerMockRecorder is the mock recorder for MockCRUDController.
+type MockCRUDControllerMockRecorder struct {
+	mock *MockCRUDController
+}
+
+// NewMockCRUDController creates a new mock instance.
+func NewMockCRUDController(ctrl *gomock.Controller) *MockCRUDController {
+	mock := &MockCRUDController{ctrl: ctrl}
+	mock.recorder = &MockCRUDControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCRUDController) EXPECT() *MockCRUDControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCRUDController) Create(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Create", c)
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCRUDControllerMockRecorder) Create(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCRUDController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockCRUDController) Delete(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", c)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCRUDControllerMockRecorder) Delete(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCRUDController)(nil).Delete), c)
+}
+
+// Get mocks base method.
+func (m *MockCRUDController) Get(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", c)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCRUDControllerMockRecorder) Get(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCRUDController)(nil).Get), c)
+}
+
+// List mocks base method.
+func (m *MockCRUDController) List(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", c)
+}
+
+// List indicates an expected call of List.
+func (mr *MockCRUDControllerMockRecorder) List(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCRUDController)(nil).List), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockCRUDController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockCRUDControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockCRUDController)(nil).RegisterRoutes), router)
+}
+
+// Update mocks base method.
+func (m *MockCRUDController) Update(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Update", c)
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCRUDControllerMockRecorder) Update(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCRUDController)(nil).Update), c)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\custom_field_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\custom_field_controller.go -destination=controllers\mocks\mock_custom_field_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldController is a mock of CustomFieldController interface.
+type MockCustomFieldController struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldControllerMockRecorder is the mock recorder for MockCustomFieldController.
+type MockCustomFieldControllerMockRecorder struct {
+	mock *MockCustomFieldController
+}
+
+// NewMockCustomFieldController creates a new mock instance.
+func NewMockCustomFieldController(ctrl *gomock.Controller) *MockCustomFieldController {
+	mock := &MockCustomFieldController{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldController) EXPECT() *MockCustomFieldControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCustomFieldController) Create(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Create", c)
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomFieldControllerMockRecorder) Create(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomFieldController)(nil).Create), c)
+}
+
+// Delete mocks base method.
+func (m *MockCustomFieldController) Delete(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", c)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCustomFieldControllerMockRecorder) Delete(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCustomFieldController)(nil).Delete), c)
+}
+
+// Get mocks base method.
+func (m *MockCustomFieldController) Get(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Get", c)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCustomFieldControllerMockRecorder) Get(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCustomFieldController)(nil).Get), c)
+}
+
+// List mocks base method.
+func (m *MockCustomFieldController) List(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "List", c)
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomFieldControllerMockRecorder) List(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomFieldController)(nil).List), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockCustomFieldController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockCustomFieldControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockCustomFieldController)(nil).RegisterRoutes), router)
+}
+
+// Update mocks base method.
+func (m *MockCustomFieldController) Update(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Update", c)
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomFieldControllerMockRecorder) Update(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomFieldController)(nil).Update), c)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\department_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\department_controller.go -destination=controllers\mocks\mock_department_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDepartmentController is a mock of DepartmentController interface.
+type MockDepartmentController struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentControllerMockRecorder is the mock recorder for MockDepartmentController.
+type MockDepartmentControllerMockRecorder struct {
+	mock *MockDepartmentController
+}
+
+// NewMockDepartmentController creates a new mock instance.
+func NewMockDepartmentController(ctrl *gomock.Controller) *MockDepartmentController {
+	mock := &MockDepartmentController{ctrl: ctrl}
+	mock.recorder = &MockDepartmentControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentController) EXPECT() *MockDepartmentControllerMockRecorder {
+	return m.recorder
+}
+
+// CreateDepartment mocks base method.
+func (m *MockDepartmentController) CreateDepartment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateDepartment", c)
+}
+
+// CreateDepartment indicates an expected call of CreateDepartment.
+func (mr *MockDepartmentControllerMockRecorder) CreateDepartment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDepartment", reflect.TypeOf((*MockDepartmentController)(nil).CreateDepartment), c)
+}
+
+// DeleteDepartment mocks base method.
+func (m *MockDepartmentController) DeleteDepartment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteDepartment", c)
+}
+
+// DeleteDepartment indicates an expected call of DeleteDepartment.
+func (mr *MockDepartmentControllerMockRecorder) DeleteDepartment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDepartment", reflect.TypeOf((*MockDepartmentController)(nil).DeleteDepartment), c)
+}
+
+// GetDepartment mocks base method.
+func (m *MockDepartmentController) GetDepartment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetDepartment", c)
+}
+
+// GetDepartment indicates an expected call of GetDepartment.
+func (mr *MockDepartmentControllerMockRecorder) GetDepartment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepartment", reflect.TypeOf((*MockDepartmentController)(nil).GetDepartment), c)
+}
+
+// GetDepartmentEmployees mocks base method.
+func (m *MockDepartmentController) GetDepartmentEmployees(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetDepartmentEmployees", c)
+}
+
+// GetDepartmentEmployees indicates an expected call of GetDepartmentEmployees.
+func (mr *MockDepartmentControllerMockRecorder) GetDepartmentEmployees(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepartmentEmployees", reflect.TypeOf((*MockDepartmentController)(nil).GetDepartmentEmployees), c)
+}
+
+// GetDepartments mocks base method.
+func (m *MockDepartmentController) GetDepartments(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetDepartments", c)
+}
+
+// GetDepartments indicates an expected call of GetDepartments.
+func (mr *MockDepartmentControllerMockRecorder) GetDepartments(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepartments", reflect.TypeOf((*MockDepartmentController)(nil).GetDepartments), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockDepartmentController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockDepartmentControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockDepartmentController)(nil).RegisterRoutes), router)
+}
+
+// UpdateDepartment mocks base method.
+func (m *MockDepartmentController) UpdateDepartment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDepartment", c)
+}
+
+// UpdateDepartment indicates an expected call of UpdateDepartment.
+func (mr *MockDepartmentControllerMockRecorder) UpdateDepartment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDepartment", reflect.TypeOf((*MockDepartmentController)(nil).UpdateDepartment), c)
+}
@@ -40,28 +40,76 @@ func (m *MockEmployeeController) EXPECT() *MockEmployeeControllerMockRecorder {
 	return m.recorder
 }
 
-// CreateEmployee mocks base method.
-func (m *MockEmployeeController) CreateEmployee(c *gin.Context) {
+// ActivateEmployee mocks base method.
+func (m *MockEmployeeController) ActivateEmployee(c *gin.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "CreateEmployee", c)
+	m.ctrl.Call(m, "ActivateEmployee", c)
 }
 
-// CreateEmployee indicates an expected call of CreateEmployee.
-func (mr *MockEmployeeControllerMockRecorder) CreateEmployee(c any) *gomock.Call {
+// ActivateEmployee indicates an expected call of ActivateEmployee.
+func (mr *MockEmployeeControllerMockRecorder) ActivateEmployee(c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployee", reflect.TypeOf((*MockEmployeeController)(nil).CreateEmployee), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateEmployee", reflect.TypeOf((*MockEmployeeController)(nil).ActivateEmployee), c)
 }
 
-// DeleteEmployee mocks base method.
-func (m *MockEmployeeController) DeleteEmployee(c *gin.Context) {
+// BulkCreateEmployees mocks base method.
+func (m *MockEmployeeController) BulkCreateEmployees(c *gin.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "DeleteEmployee", c)
+	m.ctrl.Call(m, "BulkCreateEmployees", c)
 }
 
-// DeleteEmployee indicates an expected call of DeleteEmployee.
-func (mr *MockEmployeeControllerMockRecorder) DeleteEmployee(c any) *gomock.Call {
+// BulkCreateEmployees indicates an expected call of BulkCreateEmployees.
+func (mr *MockEmployeeControllerMockRecorder) BulkCreateEmployees(c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmployee", reflect.TypeOf((*MockEmployeeController)(nil).DeleteEmployee), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateEmployees", reflect.TypeOf((*MockEmployeeController)(nil).BulkCreateEmployees), c)
+}
+
+// BulkDeleteEmployees mocks base method.
+func (m *MockEmployeeController) BulkDeleteEmployees(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BulkDeleteEmployees", c)
+}
+
+// BulkDeleteEmployees indicates an expected call of BulkDeleteEmployees.
+func (mr *MockEmployeeControllerMockRecorder) BulkDeleteEmployees(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDeleteEmployees", reflect.TypeOf((*MockEmployeeController)(nil).BulkDeleteEmployees), c)
+}
+
+// BulkUpdateEmployees mocks base method.
+func (m *MockEmployeeController) BulkUpdateEmployees(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BulkUpdateEmployees", c)
+}
+
+// BulkUpdateEmployees indicates an expected call of BulkUpdateEmployees.
+func (mr *MockEmployeeControllerMockRecorder) BulkUpdateEmployees(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateEmployees", reflect.TypeOf((*MockEmployeeController)(nil).BulkUpdateEmployees), c)
+}
+
+// ExportEmployees mocks base method.
+func (m *MockEmployeeController) ExportEmployees(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExportEmployees", c)
+}
+
+// ExportEmployees indicates an expected call of ExportEmployees.
+func (mr *MockEmployeeControllerMockRecorder) ExportEmployees(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEmployees", reflect.TypeOf((*MockEmployeeController)(nil).ExportEmployees), c)
+}
+
+// GetDirectReports mocks base method.
+func (m *MockEmployeeController) GetDirectReports(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetDirectReports", c)
+}
+
+// GetDirectReports indicates an expected call of GetDirectReports.
+func (mr *MockEmployeeControllerMockRecorder) GetDirectReports(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectReports", reflect.TypeOf((*MockEmployeeController)(nil).GetDirectReports), c)
 }
 
 // GetEmployee mocks base method.
@@ -88,6 +136,90 @@ func (mr *MockEmployeeControllerMockRecorder) GetEmployees(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployees", reflect.TypeOf((*MockEmployeeController)(nil).GetEmployees), c)
 }
 
+// GetOrgChart mocks base method.
+func (m *MockEmployeeController) GetOrgChart(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetOrgChart", c)
+}
+
+// GetOrgChart indicates an expected call of GetOrgChart.
+func (mr *MockEmployeeControllerMockRecorder) GetOrgChart(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgChart", reflect.TypeOf((*MockEmployeeController)(nil).GetOrgChart), c)
+}
+
+// GetReportingChain mocks base method.
+func (m *MockEmployeeController) GetReportingChain(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetReportingChain", c)
+}
+
+// GetReportingChain indicates an expected call of GetReportingChain.
+func (mr *MockEmployeeControllerMockRecorder) GetReportingChain(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportingChain", reflect.TypeOf((*MockEmployeeController)(nil).GetReportingChain), c)
+}
+
+// GetSalaryOverrides mocks base method.
+func (m *MockEmployeeController) GetSalaryOverrides(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSalaryOverrides", c)
+}
+
+// GetSalaryOverrides indicates an expected call of GetSalaryOverrides.
+func (mr *MockEmployeeControllerMockRecorder) GetSalaryOverrides(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalaryOverrides", reflect.TypeOf((*MockEmployeeController)(nil).GetSalaryOverrides), c)
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockEmployeeController) GetStatusHistory(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetStatusHistory", c)
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockEmployeeControllerMockRecorder) GetStatusHistory(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockEmployeeController)(nil).GetStatusHistory), c)
+}
+
+// GetSubordinates mocks base method.
+func (m *MockEmployeeController) GetSubordinates(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSubordinates", c)
+}
+
+// GetSubordinates indicates an expected call of GetSubordinates.
+func (mr *MockEmployeeControllerMockRecorder) GetSubordinates(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubordinates", reflect.TypeOf((*MockEmployeeController)(nil).GetSubordinates), c)
+}
+
+// ImportEmployees mocks base method.
+func (m *MockEmployeeController) ImportEmployees(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ImportEmployees", c)
+}
+
+// ImportEmployees indicates an expected call of ImportEmployees.
+func (mr *MockEmployeeControllerMockRecorder) ImportEmployees(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEmployees", reflect.TypeOf((*MockEmployeeController)(nil).ImportEmployees), c)
+}
+
+// PutEmployeeOnLeave mocks base method.
+func (m *MockEmployeeController) PutEmployeeOnLeave(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PutEmployeeOnLeave", c)
+}
+
+// PutEmployeeOnLeave indicates an expected call of PutEmployeeOnLeave.
+func (mr *MockEmployeeControllerMockRecorder) PutEmployeeOnLeave(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEmployeeOnLeave", reflect.TypeOf((*MockEmployeeController)(nil).PutEmployeeOnLeave), c)
+}
+
 // RegisterRoutes mocks base method.
 func (m *MockEmployeeController) RegisterRoutes(router *gin.RouterGroup) {
 	m.ctrl.T.Helper()
@@ -100,14 +232,50 @@ func (mr *MockEmployeeControllerMockRecorder) RegisterRoutes(router any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockEmployeeController)(nil).RegisterRoutes), router)
 }
 
-// UpdateEmployee mocks base method.
-func (m *MockEmployeeController) UpdateEmployee(c *gin.Context) {
+// SearchEmployees mocks base method.
+func (m *MockEmployeeController) SearchEmployees(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SearchEmployees", c)
+}
+
+// SearchEmployees indicates an expected call of SearchEmployees.
+func (mr *MockEmployeeControllerMockRecorder) SearchEmployees(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEmployees", reflect.TypeOf((*MockEmployeeController)(nil).SearchEmployees), c)
+}
+
+// StreamEvents mocks base method.
+func (m *MockEmployeeController) StreamEvents(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StreamEvents", c)
+}
+
+// StreamEvents indicates an expected call of StreamEvents.
+func (mr *MockEmployeeControllerMockRecorder) StreamEvents(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEvents", reflect.TypeOf((*MockEmployeeController)(nil).StreamEvents), c)
+}
+
+// SubscribeEvents mocks base method.
+func (m *MockEmployeeController) SubscribeEvents(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubscribeEvents", c)
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockEmployeeControllerMockRecorder) SubscribeEvents(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockEmployeeController)(nil).SubscribeEvents), c)
+}
+
+// TerminateEmployee mocks base method.
+func (m *MockEmployeeController) TerminateEmployee(c *gin.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "UpdateEmployee", c)
+	m.ctrl.Call(m, "TerminateEmployee", c)
 }
 
-// UpdateEmployee indicates an expected call of UpdateEmployee.
-func (mr *MockEmployeeControllerMockRecorder) UpdateEmployee(c any) *gomock.Call {
+// TerminateEmployee indicates an expected call of TerminateEmployee.
+func (mr *MockEmployeeControllerMockRecorder) TerminateEmployee(c any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployee", reflect.TypeOf((*MockEmployeeController)(nil).UpdateEmployee), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateEmployee", reflect.TypeOf((*MockEmployeeController)(nil).TerminateEmployee), c)
 }
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\graphql_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\graphql_controller.go -destination=controllers\mocks\mock_graphql_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGraphQLController is a mock of GraphQLController interface.
+type MockGraphQLController struct {
+	ctrl     *gomock.Controller
+	recorder *MockGraphQLControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockGraphQLControllerMockRecorder is the mock recorder for MockGraphQLController.
+type MockGraphQLControllerMockRecorder struct {
+	mock *MockGraphQLController
+}
+
+// NewMockGraphQLController creates a new mock instance.
+func NewMockGraphQLController(ctrl *gomock.Controller) *MockGraphQLController {
+	mock := &MockGraphQLController{ctrl: ctrl}
+	mock.recorder = &MockGraphQLControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGraphQLController) EXPECT() *MockGraphQLControllerMockRecorder {
+	return m.recorder
+}
+
+// Query mocks base method.
+func (m *MockGraphQLController) Query(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Query", c)
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockGraphQLControllerMockRecorder) Query(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockGraphQLController)(nil).Query), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockGraphQLController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockGraphQLControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockGraphQLController)(nil).RegisterRoutes), router)
+}
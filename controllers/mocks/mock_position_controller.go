@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\position_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\position_controller.go -destination=controllers\mocks\mock_position_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPositionController is a mock of PositionController interface.
+type MockPositionController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositionControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockPositionControllerMockRecorder is the mock recorder for MockPositionController.
+type MockPositionControllerMockRecorder struct {
+	mock *MockPositionController
+}
+
+// NewMockPositionController creates a new mock instance.
+func NewMockPositionController(ctrl *gomock.Controller) *MockPositionController {
+	mock := &MockPositionController{ctrl: ctrl}
+	mock.recorder = &MockPositionControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPositionController) EXPECT() *MockPositionControllerMockRecorder {
+	return m.recorder
+}
+
+// CreatePosition mocks base method.
+func (m *MockPositionController) CreatePosition(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreatePosition", c)
+}
+
+// CreatePosition indicates an expected call of CreatePosition.
+func (mr *MockPositionControllerMockRecorder) CreatePosition(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePosition", reflect.TypeOf((*MockPositionController)(nil).CreatePosition), c)
+}
+
+// DeletePosition mocks base method.
+func (m *MockPositionController) DeletePosition(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeletePosition", c)
+}
+
+// DeletePosition indicates an expected call of DeletePosition.
+func (mr *MockPositionControllerMockRecorder) DeletePosition(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePosition", reflect.TypeOf((*MockPositionController)(nil).DeletePosition), c)
+}
+
+// GetPosition mocks base method.
+func (m *MockPositionController) GetPosition(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPosition", c)
+}
+
+// GetPosition indicates an expected call of GetPosition.
+func (mr *MockPositionControllerMockRecorder) GetPosition(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockPositionController)(nil).GetPosition), c)
+}
+
+// GetPositions mocks base method.
+func (m *MockPositionController) GetPositions(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetPositions", c)
+}
+
+// GetPositions indicates an expected call of GetPositions.
+func (mr *MockPositionControllerMockRecorder) GetPositions(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositions", reflect.TypeOf((*MockPositionController)(nil).GetPositions), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockPositionController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockPositionControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockPositionController)(nil).RegisterRoutes), router)
+}
+
+// UpdatePosition mocks base method.
+func (m *MockPositionController) UpdatePosition(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePosition", c)
+}
+
+// UpdatePosition indicates an expected call of UpdatePosition.
+func (mr *MockPositionControllerMockRecorder) UpdatePosition(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePosition", reflect.TypeOf((*MockPositionController)(nil).UpdatePosition), c)
+}
@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\scim_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\scim_controller.go -destination=controllers\mocks\mock_scim_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSCIMController is a mock of SCIMController interface.
+type MockSCIMController struct {
+	ctrl     *gomock.Controller
+	recorder *MockSCIMControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockSCIMControllerMockRecorder is the mock recorder for MockSCIMController.
+type MockSCIMControllerMockRecorder struct {
+	mock *MockSCIMController
+}
+
+// NewMockSCIMController creates a new mock instance.
+func NewMockSCIMController(ctrl *gomock.Controller) *MockSCIMController {
+	mock := &MockSCIMController{ctrl: ctrl}
+	mock.recorder = &MockSCIMControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSCIMController) EXPECT() *MockSCIMControllerMockRecorder {
+	return m.recorder
+}
+
+// CreateUser mocks base method.
+func (m *MockSCIMController) CreateUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateUser", c)
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockSCIMControllerMockRecorder) CreateUser(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockSCIMController)(nil).CreateUser), c)
+}
+
+// DeleteUser mocks base method.
+func (m *MockSCIMController) DeleteUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteUser", c)
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockSCIMControllerMockRecorder) DeleteUser(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockSCIMController)(nil).DeleteUser), c)
+}
+
+// GetResourceType mocks base method.
+func (m *MockSCIMController) GetResourceType(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetResourceType", c)
+}
+
+// GetResourceType indicates an expected call of GetResourceType.
+func (mr *MockSCIMControllerMockRecorder) GetResourceType(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceType", reflect.TypeOf((*MockSCIMController)(nil).GetResourceType), c)
+}
+
+// GetResourceTypes mocks base method.
+func (m *MockSCIMController) GetResourceTypes(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetResourceTypes", c)
+}
+
+// GetResourceTypes indicates an expected call of GetResourceTypes.
+func (mr *MockSCIMControllerMockRecorder) GetResourceTypes(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceTypes", reflect.TypeOf((*MockSCIMController)(nil).GetResourceTypes), c)
+}
+
+// GetSchema mocks base method.
+func (m *MockSCIMController) GetSchema(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSchema", c)
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockSCIMControllerMockRecorder) GetSchema(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockSCIMController)(nil).GetSchema), c)
+}
+
+// GetSchemas mocks base method.
+func (m *MockSCIMController) GetSchemas(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSchemas", c)
+}
+
+// GetSchemas indicates an expected call of GetSchemas.
+func (mr *MockSCIMControllerMockRecorder) GetSchemas(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchemas", reflect.TypeOf((*MockSCIMController)(nil).GetSchemas), c)
+}
+
+// GetServiceProviderConfig mocks base method.
+func (m *MockSCIMController) GetServiceProviderConfig(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetServiceProviderConfig", c)
+}
+
+// GetServiceProviderConfig indicates an expected call of GetServiceProviderConfig.
+func (mr *MockSCIMControllerMockRecorder) GetServiceProviderConfig(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceProviderConfig", reflect.TypeOf((*MockSCIMController)(nil).GetServiceProviderConfig), c)
+}
+
+// GetUser mocks base method.
+func (m *MockSCIMController) GetUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUser", c)
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockSCIMControllerMockRecorder) GetUser(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockSCIMController)(nil).GetUser), c)
+}
+
+// GetUsers mocks base method.
+func (m *MockSCIMController) GetUsers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUsers", c)
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockSCIMControllerMockRecorder) GetUsers(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockSCIMController)(nil).GetUsers), c)
+}
+
+// PatchUser mocks base method.
+func (m *MockSCIMController) PatchUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PatchUser", c)
+}
+
+// PatchUser indicates an expected call of PatchUser.
+func (mr *MockSCIMControllerMockRecorder) PatchUser(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUser", reflect.TypeOf((*MockSCIMController)(nil).PatchUser), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockSCIMController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockSCIMControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockSCIMController)(nil).RegisterRoutes), router)
+}
+
+// ReplaceUser mocks base method.
+func (m *MockSCIMController) ReplaceUser(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReplaceUser", c)
+}
+
+// ReplaceUser indicates an expected call of ReplaceUser.
+func (mr *MockSCIMControllerMockRecorder) ReplaceUser(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUser", reflect.TypeOf((*MockSCIMController)(nil).ReplaceUser), c)
+}
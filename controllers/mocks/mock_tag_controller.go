@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\tag_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\tag_controller.go -destination=controllers\mocks\mock_tag_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagController is a mock of TagController interface.
+type MockTagController struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockTagControllerMockRecorder is the mock recorder for MockTagController.
+type MockTagControllerMockRecorder struct {
+	mock *MockTagController
+}
+
+// NewMockTagController creates a new mock instance.
+func NewMockTagController(ctrl *gomock.Controller) *MockTagController {
+	mock := &MockTagController{ctrl: ctrl}
+	mock.recorder = &MockTagControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagController) EXPECT() *MockTagControllerMockRecorder {
+	return m.recorder
+}
+
+// AddEmployeeTags mocks base method.
+func (m *MockTagController) AddEmployeeTags(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddEmployeeTags", c)
+}
+
+// AddEmployeeTags indicates an expected call of AddEmployeeTags.
+func (mr *MockTagControllerMockRecorder) AddEmployeeTags(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmployeeTags", reflect.TypeOf((*MockTagController)(nil).AddEmployeeTags), c)
+}
+
+// GetEmployeeTags mocks base method.
+func (m *MockTagController) GetEmployeeTags(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetEmployeeTags", c)
+}
+
+// GetEmployeeTags indicates an expected call of GetEmployeeTags.
+func (mr *MockTagControllerMockRecorder) GetEmployeeTags(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeTags", reflect.TypeOf((*MockTagController)(nil).GetEmployeeTags), c)
+}
+
+// GetTags mocks base method.
+func (m *MockTagController) GetTags(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetTags", c)
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockTagControllerMockRecorder) GetTags(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockTagController)(nil).GetTags), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockTagController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockTagControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockTagController)(nil).RegisterRoutes), router)
+}
+
+// RemoveEmployeeTag mocks base method.
+func (m *MockTagController) RemoveEmployeeTag(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveEmployeeTag", c)
+}
+
+// RemoveEmployeeTag indicates an expected call of RemoveEmployeeTag.
+func (mr *MockTagControllerMockRecorder) RemoveEmployeeTag(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEmployeeTag", reflect.TypeOf((*MockTagController)(nil).RemoveEmployeeTag), c)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\tenant_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\tenant_controller.go -destination=controllers\mocks\mock_tenant_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantController is a mock of TenantController interface.
+type MockTenantController struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantControllerMockRecorder is the mock recorder for MockTenantController.
+type MockTenantControllerMockRecorder struct {
+	mock *MockTenantController
+}
+
+// NewMockTenantController creates a new mock instance.
+func NewMockTenantController(ctrl *gomock.Controller) *MockTenantController {
+	mock := &MockTenantController{ctrl: ctrl}
+	mock.recorder = &MockTenantControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantController) EXPECT() *MockTenantControllerMockRecorder {
+	return m.recorder
+}
+
+// CreateTenant mocks base method.
+func (m *MockTenantController) CreateTenant(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateTenant", c)
+}
+
+// CreateTenant indicates an expected call of CreateTenant.
+func (mr *MockTenantControllerMockRecorder) CreateTenant(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTenantController)(nil).CreateTenant), c)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockTenantController) DeleteTenant(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteTenant", c)
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockTenantControllerMockRecorder) DeleteTenant(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockTenantController)(nil).DeleteTenant), c)
+}
+
+// GetTenant mocks base method.
+func (m *MockTenantController) GetTenant(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetTenant", c)
+}
+
+// GetTenant indicates an expected call of GetTenant.
+func (mr *MockTenantControllerMockRecorder) GetTenant(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenant", reflect.TypeOf((*MockTenantController)(nil).GetTenant), c)
+}
+
+// GetTenants mocks base method.
+func (m *MockTenantController) GetTenants(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetTenants", c)
+}
+
+// GetTenants indicates an expected call of GetTenants.
+func (mr *MockTenantControllerMockRecorder) GetTenants(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenants", reflect.TypeOf((*MockTenantController)(nil).GetTenants), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockTenantController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockTenantControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockTenantController)(nil).RegisterRoutes), router)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockTenantController) UpdateTenant(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateTenant", c)
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockTenantControllerMockRecorder) UpdateTenant(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockTenantController)(nil).UpdateTenant), c)
+}
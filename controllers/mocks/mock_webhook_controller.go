@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controllers\webhook_controller.go
+//
+// Generated by this command:
+//
+//	mockgen -source=controllers\webhook_controller.go -destination=controllers\mocks\mock_webhook_controller.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gin "github.com/gin-gonic/gin"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookController is a mock of WebhookController interface.
+type MockWebhookController struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookControllerMockRecorder is the mock recorder for MockWebhookController.
+type MockWebhookControllerMockRecorder struct {
+	mock *MockWebhookController
+}
+
+// NewMockWebhookController creates a new mock instance.
+func NewMockWebhookController(ctrl *gomock.Controller) *MockWebhookController {
+	mock := &MockWebhookController{ctrl: ctrl}
+	mock.recorder = &MockWebhookControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookController) EXPECT() *MockWebhookControllerMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookController) CreateWebhook(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateWebhook", c)
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookControllerMockRecorder) CreateWebhook(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookController)(nil).CreateWebhook), c)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookController) DeleteWebhook(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteWebhook", c)
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookControllerMockRecorder) DeleteWebhook(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookController)(nil).DeleteWebhook), c)
+}
+
+// GetDeadLetters mocks base method.
+func (m *MockWebhookController) GetDeadLetters(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetDeadLetters", c)
+}
+
+// GetDeadLetters indicates an expected call of GetDeadLetters.
+func (mr *MockWebhookControllerMockRecorder) GetDeadLetters(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetters", reflect.TypeOf((*MockWebhookController)(nil).GetDeadLetters), c)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookController) GetDeliveries(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetDeliveries", c)
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookControllerMockRecorder) GetDeliveries(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookController)(nil).GetDeliveries), c)
+}
+
+// GetWebhook mocks base method.
+func (m *MockWebhookController) GetWebhook(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetWebhook", c)
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockWebhookControllerMockRecorder) GetWebhook(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockWebhookController)(nil).GetWebhook), c)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWebhookController) GetWebhooks(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetWebhooks", c)
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWebhookControllerMockRecorder) GetWebhooks(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWebhookController)(nil).GetWebhooks), c)
+}
+
+// RegisterRoutes mocks base method.
+func (m *MockWebhookController) RegisterRoutes(router *gin.RouterGroup) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRoutes", router)
+}
+
+// RegisterRoutes indicates an expected call of RegisterRoutes.
+func (mr *MockWebhookControllerMockRecorder) RegisterRoutes(router any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRoutes", reflect.TypeOf((*MockWebhookController)(nil).RegisterRoutes), router)
+}
+
+// RetryDelivery mocks base method.
+func (m *MockWebhookController) RetryDelivery(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RetryDelivery", c)
+}
+
+// RetryDelivery indicates an expected call of RetryDelivery.
+func (mr *MockWebhookControllerMockRecorder) RetryDelivery(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryDelivery", reflect.TypeOf((*MockWebhookController)(nil).RetryDelivery), c)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockWebhookController) UpdateWebhook(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateWebhook", c)
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockWebhookControllerMockRecorder) UpdateWebhook(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhookController)(nil).UpdateWebhook), c)
+}
@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/gin-gonic/gin/render"
+	"github.com/ugorji/go/codec"
+	"sigs.k8s.io/yaml"
+)
+
+// errUnsupportedMediaType is returned when a request body is in a format the API does not read
+var errUnsupportedMediaType = errors.New("unsupported media type")
+
+// offeredFormats are the response formats in order of preference. A request
+// without an Accept header, or accepting anything, gets the first.
+var offeredFormats = []string{
+	binding.MIMEJSON,
+	binding.MIMEXML, binding.MIMEXML2,
+	binding.MIMEYAML, binding.MIMEYAML2,
+	binding.MIMEMSGPACK, binding.MIMEMSGPACK2,
+}
+
+// respond writes obj with the given status in the format the client accepts,
+// or answers 406 when it accepts none of them
+func respond(c *gin.Context, status int, obj interface{}) {
+	switch format := c.NegotiateFormat(offeredFormats...); format {
+	case binding.MIMEJSON:
+		c.JSON(status, obj)
+	case binding.MIMEXML, binding.MIMEXML2:
+		c.Render(status, xmlRender{Data: obj, ContentType: format})
+	case binding.MIMEYAML, binding.MIMEYAML2:
+		c.Render(status, yamlRender{Data: obj, ContentType: format})
+	case binding.MIMEMSGPACK, binding.MIMEMSGPACK2:
+		c.Render(status, render.MsgPack{Data: obj})
+	default:
+		notAcceptable(c)
+	}
+}
+
+// requireAcceptable answers 406 before the handler runs when the client
+// accepts none of the response formats, so nothing is changed for a client
+// that could not read the result
+func requireAcceptable(c *gin.Context) {
+	if c.NegotiateFormat(offeredFormats...) == "" {
+		notAcceptable(c)
+	}
+}
+
+func notAcceptable(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusNotAcceptable, gin.H{
+		"error": fmt.Sprintf("Cannot produce %q, use one of %v", c.GetHeader("Accept"), offeredFormats),
+	})
+}
+
+// bindBody decodes the request body according to its Content-Type and
+// validates the result against its binding tags
+func bindBody(c *gin.Context, obj interface{}) error {
+	if err := decodeBody(c, obj); err != nil {
+		return err
+	}
+	return binding.Validator.ValidateStruct(obj)
+}
+
+// decodeBody decodes the request body according to its Content-Type without
+// validating it. A body without a Content-Type is read as JSON. YAML uses
+// the same field names as JSON; XML uses the element names it is rendered
+// with, and lists are read from the children of the root element.
+func decodeBody(c *gin.Context, obj interface{}) error {
+	if c.Request.Body == nil {
+		return errors.New("missing request body")
+	}
+	switch contentType := c.ContentType(); contentType {
+	case "", binding.MIMEJSON:
+		return json.NewDecoder(c.Request.Body).Decode(obj)
+	case binding.MIMEXML, binding.MIMEXML2:
+		return decodeXML(c.Request.Body, obj)
+	case binding.MIMEYAML, binding.MIMEYAML2:
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return err
+		}
+		return yaml.Unmarshal(body, obj)
+	case binding.MIMEMSGPACK, binding.MIMEMSGPACK2:
+		return codec.NewDecoder(c.Request.Body, new(codec.MsgpackHandle)).Decode(obj)
+	default:
+		return fmt.Errorf("%w %q, use JSON, XML, YAML or MessagePack", errUnsupportedMediaType, contentType)
+	}
+}
+
+// decodeXML decodes a document into obj, reading each child of the root
+// element as one item when obj points to a slice
+func decodeXML(r io.Reader, obj interface{}) error {
+	list := reflect.ValueOf(obj).Elem()
+	if list.Kind() != reflect.Slice {
+		return xml.NewDecoder(r).Decode(obj)
+	}
+
+	decoder := xml.NewDecoder(r)
+	inRoot := false
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			if !inRoot {
+				inRoot = true
+				continue
+			}
+			item := reflect.New(list.Type().Elem())
+			if err := decoder.DecodeElement(item.Interface(), &element); err != nil {
+				return err
+			}
+			list.Set(reflect.Append(list, item.Elem()))
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// xmlRender renders XML like gin's renderer, wrapping slices in an items
+// element so that the document has a single root
+type xmlRender struct {
+	Data        interface{}
+	ContentType string
+}
+
+func (r xmlRender) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	encoder := xml.NewEncoder(w)
+	list := reflect.ValueOf(r.Data)
+	if list.Kind() != reflect.Slice {
+		return encoder.Encode(r.Data)
+	}
+
+	root := xml.StartElement{Name: xml.Name{Local: "items"}}
+	if err := encoder.EncodeToken(root); err != nil {
+		return err
+	}
+	for i := 0; i < list.Len(); i++ {
+		if err := encoder.Encode(list.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	if err := encoder.EncodeToken(root.End()); err != nil {
+		return err
+	}
+	return encoder.Flush()
+}
+
+func (r xmlRender) WriteContentType(w http.ResponseWriter) {
+	writeContentType(w, r.ContentType+"; charset=utf-8")
+}
+
+// yamlRender renders YAML with the same field names as the JSON responses
+type yamlRender struct {
+	Data        interface{}
+	ContentType string
+}
+
+func (r yamlRender) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	body, err := yaml.Marshal(r.Data)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(body)
+	return err
+}
+
+func (r yamlRender) WriteContentType(w http.ResponseWriter) {
+	writeContentType(w, r.ContentType+"; charset=utf-8")
+}
+
+func writeContentType(w http.ResponseWriter, contentType string) {
+	header := w.Header()
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", contentType)
+	}
+}
@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/ugorji/go/codec"
+)
+
+type NegotiationTestSuite struct {
+	suite.Suite
+	r *gin.Engine
+}
+
+func (suite *NegotiationTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.New()
+	suite.r.POST("/employee", requireAcceptable, func(c *gin.Context) {
+		var employee models.Employee
+		if err := bindBody(c, &employee); err != nil {
+			respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+			return
+		}
+		respond(c, http.StatusOK, employee)
+	})
+	suite.r.POST("/ids", func(c *gin.Context) {
+		var ids []uint
+		if err := decodeBody(c, &ids); err != nil {
+			respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+			return
+		}
+		respond(c, http.StatusOK, ids)
+	})
+	suite.r.GET("/employee", func(c *gin.Context) {
+		respond(c, http.StatusOK, models.Employee{ID: 1, TenantID: "acme", Name: "John"})
+	})
+}
+
+func TestNegotiationTestSuite(t *testing.T) {
+	suite.Run(t, new(NegotiationTestSuite))
+}
+
+func (suite *NegotiationTestSuite) post(path, contentType, accept string, body []byte) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *NegotiationTestSuite) TestJSONByDefault() {
+	w := suite.post("/employee", "application/json", "", []byte(`{"name":"John","email":"john@example.com","position":"Dev","salary":1}`))
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Header().Get("Content-Type"), "application/json")
+	suite.Contains(w.Body.String(), `"name":"John"`)
+}
+
+func (suite *NegotiationTestSuite) TestYAMLUsesJSONFieldNames() {
+	body := "name: John\nemail: john@example.com\nposition: Dev\nsalary: 1\ndepartment_id: 2\n"
+	w := suite.post("/employee", "application/x-yaml", "application/yaml", []byte(body))
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Header().Get("Content-Type"), "application/yaml")
+	suite.Contains(w.Body.String(), "department_id: 2")
+}
+
+func (suite *NegotiationTestSuite) TestXMLLists() {
+	w := suite.post("/ids", "application/xml", "application/xml", []byte(`<items><id>1</id><id>2</id></items>`))
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("<items><uint>1</uint><uint>2</uint></items>", w.Body.String())
+}
+
+func (suite *NegotiationTestSuite) TestMessagePackRoundTrip() {
+	var body []byte
+	input := map[string]interface{}{"name": "John", "email": "john@example.com", "position": "Dev", "salary": 1.5}
+	suite.Require().NoError(codec.NewEncoderBytes(&body, new(codec.MsgpackHandle)).Encode(input))
+
+	w := suite.post("/employee", "application/msgpack", "application/x-msgpack", body)
+	suite.Equal(http.StatusOK, w.Code)
+
+	var employee models.Employee
+	suite.Require().NoError(codec.NewDecoderBytes(w.Body.Bytes(), new(codec.MsgpackHandle)).Decode(&employee))
+	suite.Equal("John", employee.Name)
+	suite.Equal(1.5, employee.Salary)
+}
+
+func (suite *NegotiationTestSuite) TestUnsupportedFormats() {
+	w := suite.post("/employee", "application/json", "text/html", []byte(`{}`))
+	suite.Equal(http.StatusNotAcceptable, w.Code)
+
+	w = suite.post("/employee", "text/plain", "", []byte(`name=John`))
+	suite.Equal(http.StatusUnsupportedMediaType, w.Code)
+
+	w = suite.post("/employee", "application/xml", "", []byte(`<Employee><Name>John</Name></Employee>`))
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), "Email")
+}
+
+func (suite *NegotiationTestSuite) TestTenantIsNotRendered() {
+	for _, accept := range []string{"application/json", "application/xml", "application/yaml", "application/msgpack"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/employee", nil)
+		req.Header.Set("Accept", accept)
+		suite.r.ServeHTTP(w, req)
+
+		suite.Equal(http.StatusOK, w.Code, accept)
+		suite.Contains(w.Body.String(), "John", accept)
+		suite.NotContains(w.Body.String(), "acme", accept)
+		suite.NotContains(w.Body.String(), "TenantID", accept)
+	}
+}
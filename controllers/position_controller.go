@@ -0,0 +1,15 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// PositionController defines the interface for position controller
+type PositionController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetPositions(c *gin.Context)
+	GetPosition(c *gin.Context)
+	CreatePosition(c *gin.Context)
+	UpdatePosition(c *gin.Context)
+	DeletePosition(c *gin.Context)
+}
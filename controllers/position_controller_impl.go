@@ -0,0 +1,175 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// positionControllerImpl is the concrete implementation of PositionController
+// (see position_controller.go for the interface definition)
+type positionControllerImpl struct {
+	positionService service.PositionService
+}
+
+// NewPositionController creates a new instance of PositionController
+func NewPositionController(positionService service.PositionService) PositionController {
+	return &positionControllerImpl{
+		positionService: positionService,
+	}
+}
+
+// RegisterRoutes registers the position catalog routes with the given router group.
+func (pc *positionControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	positions := router.Group("/positions")
+	{
+		positions.GET("/", pc.GetPositions)
+		positions.GET("/:id", pc.GetPosition)
+		positions.POST("/", pc.CreatePosition)
+		positions.PUT("/:id", pc.UpdatePosition)
+		positions.DELETE("/:id", pc.DeletePosition)
+	}
+}
+
+// GetPositions handles GET request to fetch the job catalog
+// @Summary Get all positions
+// @Description Retrieves every position in the job catalog with its salary bands
+// @Tags positions
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.Position
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /positions [get]
+func (pc *positionControllerImpl) GetPositions(c *gin.Context) {
+	positions, err := pc.positionService.GetAllPositions(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, positions)
+}
+
+// GetPosition handles GET request to fetch a specific position by ID
+// @Summary Get position by ID
+// @Description Retrieves a specific catalog position with its salary bands
+// @Tags positions
+// @Accept json
+// @Produce json
+// @Param id path int true "Position ID"
+// @Success 200 {object} models.Position
+// @Failure 400 {object} map[string]interface{} "Invalid position ID"
+// @Failure 404 {object} map[string]interface{} "Position not found"
+// @Router /positions/{id} [get]
+func (pc *positionControllerImpl) GetPosition(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	position, err := pc.positionService.GetPositionByID(c.Request.Context(), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, position)
+}
+
+// CreatePosition handles POST request to add a position to the catalog
+// @Summary Create position
+// @Description Adds a position to the job catalog, with at most one salary band per currency
+// @Tags positions
+// @Accept json
+// @Produce json
+// @Param position body models.Position true "Position object"
+// @Success 201 {object} models.Position
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 409 {object} map[string]interface{} "Position or currency band already exists"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /positions [post]
+func (pc *positionControllerImpl) CreatePosition(c *gin.Context) {
+	var position models.Position
+	if err := c.ShouldBindJSON(&position); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	createdPosition, err := pc.positionService.CreatePosition(c.Request.Context(), position)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, createdPosition)
+}
+
+// UpdatePosition handles PUT request to update a catalog position
+// @Summary Update position
+// @Description Updates a catalog position and replaces its salary bands
+// @Tags positions
+// @Accept json
+// @Produce json
+// @Param id path int true "Position ID"
+// @Param position body models.Position true "Updated position object"
+// @Success 200 {object} models.Position
+// @Failure 400 {object} map[string]interface{} "Invalid position ID or request data"
+// @Failure 404 {object} map[string]interface{} "Position not found"
+// @Failure 409 {object} map[string]interface{} "Position or currency band already exists"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /positions/{id} [put]
+func (pc *positionControllerImpl) UpdatePosition(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	var position models.Position
+	if err := c.ShouldBindJSON(&position); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updatedPosition, err := pc.positionService.UpdatePosition(c.Request.Context(), uint(id), position)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedPosition)
+}
+
+// DeletePosition handles DELETE request to remove a catalog position
+// @Summary Delete position
+// @Description Removes a position from the catalog. Positions still held by employees cannot be deleted.
+// @Tags positions
+// @Accept json
+// @Produce json
+// @Param id path int true "Position ID"
+// @Success 200 {object} map[string]interface{} "Success message"
+// @Failure 400 {object} map[string]interface{} "Invalid position ID"
+// @Failure 404 {object} map[string]interface{} "Position not found"
+// @Failure 409 {object} map[string]interface{} "Position still held by employees"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /positions/{id} [delete]
+func (pc *positionControllerImpl) DeletePosition(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid position ID"})
+		return
+	}
+
+	err = pc.positionService.DeletePosition(c.Request.Context(), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Position deleted successfully"})
+}
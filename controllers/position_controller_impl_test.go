@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type PositionControllerTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	svc  *mocks.MockPositionService
+	r    *gin.Engine
+}
+
+func (suite *PositionControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockPositionService(suite.ctrl)
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	controller := &positionControllerImpl{positionService: suite.svc}
+	v1 := suite.r.Group("/api/v1")
+	controller.RegisterRoutes(v1)
+}
+
+func (suite *PositionControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestPositionControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(PositionControllerTestSuite))
+}
+
+func (suite *PositionControllerTestSuite) TestGetPositionsHandler() {
+	positions := []models.Position{{ID: 1, Title: "Developer", SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 1, MaxSalary: 2}}}}
+	suite.svc.EXPECT().GetAllPositions(gomock.Any()).Return(positions, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/positions/", nil)
+	suite.r.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"min_salary":1`)
+}
+
+func (suite *PositionControllerTestSuite) TestCreatePositionHandler() {
+	input := `{"title":"Developer","level":"L2","salary_bands":[{"currency":"USD","min_salary":60000,"max_salary":90000}]}`
+	suite.svc.EXPECT().CreatePosition(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, p models.Position) (models.Position, error) {
+		p.ID = 1
+		return p, nil
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/positions/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusCreated, w.Code)
+
+	// Bands must have min <= max
+	input = `{"title":"Developer","salary_bands":[{"currency":"USD","min_salary":90000,"max_salary":60000}]}`
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/positions/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *PositionControllerTestSuite) TestDeletePositionHandler() {
+	suite.svc.EXPECT().DeletePosition(gomock.Any(), uint(1)).Return(models.ErrConflict)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/api/v1/positions/1", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusConflict, w.Code)
+
+	suite.svc.EXPECT().DeletePosition(gomock.Any(), uint(2)).Return(models.ErrPositionNotFound)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("DELETE", "/api/v1/positions/2", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// SCIMController defines the interface for the SCIM 2.0 provisioning controller
+type SCIMController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetServiceProviderConfig(c *gin.Context)
+	GetResourceTypes(c *gin.Context)
+	GetResourceType(c *gin.Context)
+	GetSchemas(c *gin.Context)
+	GetSchema(c *gin.Context)
+	GetUsers(c *gin.Context)
+	GetUser(c *gin.Context)
+	CreateUser(c *gin.Context)
+	ReplaceUser(c *gin.Context)
+	PatchUser(c *gin.Context)
+	DeleteUser(c *gin.Context)
+}
@@ -0,0 +1,302 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/scim"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// scimControllerImpl is the concrete implementation of SCIMController
+// (see scim_controller.go for the interface definition)
+type scimControllerImpl struct {
+	scimService service.SCIMService
+}
+
+// NewSCIMController creates a new instance of SCIMController
+func NewSCIMController(scimService service.SCIMService) SCIMController {
+	return &scimControllerImpl{
+		scimService: scimService,
+	}
+}
+
+// RegisterRoutes registers the SCIM routes with the given router group,
+// which is mounted at /scim/v2 rather than under /api/v1 because identity
+// providers expect the SCIM base URL to end there. The discovery endpoints
+// are public; /Users requires the users:provision permission. The endpoints
+// describe themselves through the discovery endpoints instead of Swagger.
+func (sc *scimControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/ServiceProviderConfig", sc.GetServiceProviderConfig)
+	router.GET("/ResourceTypes", sc.GetResourceTypes)
+	router.GET("/ResourceTypes/:id", sc.GetResourceType)
+	router.GET("/Schemas", sc.GetSchemas)
+	router.GET("/Schemas/:id", sc.GetSchema)
+
+	users := router.Group("/Users", requireSCIMPermission(auth.PermUserProvision))
+	{
+		users.GET("", sc.GetUsers)
+		users.GET("/:id", sc.GetUser)
+		users.POST("", sc.CreateUser)
+		users.PUT("/:id", sc.ReplaceUser)
+		users.PATCH("/:id", sc.PatchUser)
+		users.DELETE("/:id", sc.DeleteUser)
+	}
+}
+
+// GetServiceProviderConfig handles GET request for the supported SCIM features
+func (sc *scimControllerImpl) GetServiceProviderConfig(c *gin.Context) {
+	writeSCIM(c, http.StatusOK, scim.NewServiceProviderConfig(scimBaseURL(c)))
+}
+
+// GetResourceTypes handles GET request to list the resource types
+func (sc *scimControllerImpl) GetResourceTypes(c *gin.Context) {
+	resourceTypes := scim.NewResourceTypes(scimBaseURL(c))
+	writeSCIM(c, http.StatusOK, scim.NewListResponse(resourceTypes, len(resourceTypes), len(resourceTypes), 1))
+}
+
+// GetResourceType handles GET request to fetch a resource type by ID
+func (sc *scimControllerImpl) GetResourceType(c *gin.Context) {
+	for _, resourceType := range scim.NewResourceTypes(scimBaseURL(c)) {
+		if resourceType.ID == c.Param("id") {
+			writeSCIM(c, http.StatusOK, resourceType)
+			return
+		}
+	}
+	writeSCIMError(c, scim.NewError(http.StatusNotFound, "", "Resource type "+c.Param("id")+" not found"))
+}
+
+// GetSchemas handles GET request to list the schemas
+func (sc *scimControllerImpl) GetSchemas(c *gin.Context) {
+	schemas := scim.NewSchemas(scimBaseURL(c))
+	writeSCIM(c, http.StatusOK, scim.NewListResponse(schemas, len(schemas), len(schemas), 1))
+}
+
+// GetSchema handles GET request to fetch a schema by its URN
+func (sc *scimControllerImpl) GetSchema(c *gin.Context) {
+	for _, schema := range scim.NewSchemas(scimBaseURL(c)) {
+		if schema.ID == c.Param("id") {
+			writeSCIM(c, http.StatusOK, schema)
+			return
+		}
+	}
+	writeSCIMError(c, scim.NewError(http.StatusNotFound, "", "Schema "+c.Param("id")+" not found"))
+}
+
+// GetUsers handles GET request to list users, with an optional filter
+// expression and startIndex and count paging
+func (sc *scimControllerImpl) GetUsers(c *gin.Context) {
+	var query scim.ListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		writeSCIMError(c, scim.BadRequest(scim.ErrTypeInvalidValue, err.Error()))
+		return
+	}
+
+	users, total, err := sc.scimService.ListUsers(c.Request.Context(), query)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+	query.Normalize()
+	for i := range users {
+		setUserLocations(c, &users[i])
+	}
+	writeSCIM(c, http.StatusOK, scim.NewListResponse(users, len(users), total, query.StartIndex))
+}
+
+// GetUser handles GET request to fetch a user by ID
+func (sc *scimControllerImpl) GetUser(c *gin.Context) {
+	id, ok := scimUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := sc.scimService.GetUser(c.Request.Context(), id)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+	writeUser(c, http.StatusOK, user)
+}
+
+// CreateUser handles POST request to provision a user
+func (sc *scimControllerImpl) CreateUser(c *gin.Context) {
+	user, ok := bindSCIMUser(c)
+	if !ok {
+		return
+	}
+
+	created, err := sc.scimService.CreateUser(c.Request.Context(), user)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+	setUserLocations(c, &created)
+	c.Header("Location", created.Meta.Location)
+	writeSCIM(c, http.StatusCreated, created)
+}
+
+// ReplaceUser handles PUT request to replace a user
+func (sc *scimControllerImpl) ReplaceUser(c *gin.Context) {
+	id, ok := scimUserID(c)
+	if !ok {
+		return
+	}
+	user, ok := bindSCIMUser(c)
+	if !ok {
+		return
+	}
+
+	replaced, err := sc.scimService.ReplaceUser(c.Request.Context(), id, user, auth.FromContext(c).Subject)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+	writeUser(c, http.StatusOK, replaced)
+}
+
+// PatchUser handles PATCH request to modify a user
+func (sc *scimControllerImpl) PatchUser(c *gin.Context) {
+	id, ok := scimUserID(c)
+	if !ok {
+		return
+	}
+	var patch scim.PatchRequest
+	if err := json.NewDecoder(c.Request.Body).Decode(&patch); err != nil {
+		writeSCIMError(c, scim.BadRequest(scim.ErrTypeInvalidSyntax, err.Error()))
+		return
+	}
+
+	patched, err := sc.scimService.PatchUser(c.Request.Context(), id, patch, auth.FromContext(c).Subject)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+	writeUser(c, http.StatusOK, patched)
+}
+
+// DeleteUser handles DELETE request to deprovision a user by deleting the employee
+func (sc *scimControllerImpl) DeleteUser(c *gin.Context) {
+	id, ok := scimUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := sc.scimService.DeleteUser(c.Request.Context(), id); err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// requireSCIMPermission is requirePermission with SCIM error responses
+func requireSCIMPermission(permission auth.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal := auth.FromContext(c)
+		switch {
+		case !principal.Authenticated():
+			writeSCIMError(c, scim.NewError(http.StatusUnauthorized, "", "Authentication required"))
+			c.Abort()
+		case !principal.Can(permission):
+			writeSCIMError(c, scim.NewError(http.StatusForbidden, "", "This operation requires the "+string(permission)+" permission"))
+			c.Abort()
+		}
+	}
+}
+
+// scimBaseURL returns the absolute URL of the SCIM endpoint the request was sent to
+func scimBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := c.GetHeader("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + c.Request.Host + "/scim/v2"
+}
+
+// setUserLocations fills in the URLs of a user and their manager
+func setUserLocations(c *gin.Context, user *scim.User) {
+	base := scimBaseURL(c) + "/Users/"
+	if user.Meta != nil {
+		user.Meta.Location = base + user.ID
+	}
+	if user.Enterprise != nil && user.Enterprise.Manager != nil {
+		user.Enterprise.Manager.Ref = base + user.Enterprise.Manager.Value
+	}
+}
+
+// scimUserID reads the user ID from the path. IDs are opaque to clients, so
+// one that is not a number is a user that does not exist.
+func scimUserID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		writeSCIMError(c, scim.NewError(http.StatusNotFound, "", "User "+c.Param("id")+" not found"))
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// bindSCIMUser reads a user from the request body. The body may be sent as
+// application/scim+json or application/json.
+func bindSCIMUser(c *gin.Context) (scim.User, bool) {
+	var user scim.User
+	if err := json.NewDecoder(c.Request.Body).Decode(&user); err != nil {
+		writeSCIMError(c, scim.BadRequest(scim.ErrTypeInvalidSyntax, err.Error()))
+		return user, false
+	}
+	if !containsSchema(user.Schemas, scim.UserSchema) {
+		writeSCIMError(c, scim.BadRequest(scim.ErrTypeInvalidValue, "schemas must include "+scim.UserSchema))
+		return user, false
+	}
+	return user, true
+}
+
+func containsSchema(schemas []string, want string) bool {
+	for _, schema := range schemas {
+		if schema == want {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUser sends a user with its locations filled in
+func writeUser(c *gin.Context, status int, user scim.User) {
+	setUserLocations(c, &user)
+	writeSCIM(c, status, user)
+}
+
+// writeSCIM sends a SCIM response body
+func writeSCIM(c *gin.Context, status int, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		status, data = http.StatusInternalServerError, []byte(`{"schemas":["`+scim.ErrorSchema+`"],"status":"500"}`)
+	}
+	c.Data(status, scim.ContentType, data)
+}
+
+// writeSCIMError sends an error as a SCIM error response. Domain errors are
+// mapped to the status the REST API uses, with SCIM's error types where one
+// applies; invalid lifecycle changes are 400 mutability errors.
+func writeSCIMError(c *gin.Context, err error) {
+	var scimErr *scim.Error
+	if !errors.As(err, &scimErr) {
+		var scimType string
+		status := errorStatus(err, http.StatusInternalServerError)
+		switch {
+		case errors.Is(err, models.ErrValidation):
+			scimType = scim.ErrTypeInvalidValue
+		case errors.Is(err, models.ErrInvalidTransition):
+			status, scimType = http.StatusBadRequest, scim.ErrTypeMutability
+		}
+		scimErr = scim.NewError(status, scimType, err.Error())
+	}
+	writeSCIM(c, scimErr.StatusCode(), scimErr)
+}
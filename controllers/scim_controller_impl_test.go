@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/scim"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type SCIMControllerTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	svc       *mocks.MockSCIMService
+	r         *gin.Engine
+	principal auth.Principal
+}
+
+func (suite *SCIMControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockSCIMService(suite.ctrl)
+	suite.principal = auth.Principal{Subject: "okta", Roles: []string{auth.RoleProvisioner}}
+
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	controller := &scimControllerImpl{scimService: suite.svc}
+	controller.RegisterRoutes(suite.r.Group("/scim/v2"))
+}
+
+func (suite *SCIMControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestSCIMControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(SCIMControllerTestSuite))
+}
+
+func (suite *SCIMControllerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Host = "hr.example.com"
+	req.Header.Set("Content-Type", scim.ContentType)
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *SCIMControllerTestSuite) user(id string) scim.User {
+	active := true
+	return scim.User{
+		Schemas:    []string{scim.UserSchema, scim.EnterpriseUserSchema},
+		ID:         id,
+		UserName:   "alice@example.com",
+		Active:     &active,
+		Enterprise: &scim.EnterpriseUser{EmployeeNumber: id, Manager: &scim.Manager{Value: "3"}},
+		Meta:       &scim.Meta{ResourceType: "User"},
+	}
+}
+
+func (suite *SCIMControllerTestSuite) TestGetUsersHandler() {
+	count := 1
+	suite.svc.EXPECT().ListUsers(gomock.Any(), scim.ListQuery{Filter: `userName eq "alice@example.com"`, StartIndex: 1, Count: &count}).
+		Return([]scim.User{suite.user("1")}, 4, nil)
+
+	w := suite.request("GET", `/scim/v2/Users?filter=userName+eq+%22alice%40example.com%22&startIndex=1&count=1`, "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal(scim.ContentType, w.Header().Get("Content-Type"))
+	suite.JSONEq(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:ListResponse"],"totalResults":4,"startIndex":1,"itemsPerPage":1,
+		"Resources":[{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User","urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"],
+			"id":"1","userName":"alice@example.com","active":true,
+			"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User":{"employeeNumber":"1","manager":{"value":"3","$ref":"http://hr.example.com/scim/v2/Users/3"}},
+			"meta":{"resourceType":"User","location":"http://hr.example.com/scim/v2/Users/1"}}]}`, w.Body.String())
+}
+
+func (suite *SCIMControllerTestSuite) TestGetUsersHandlerInvalidFilter() {
+	suite.svc.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(nil, 0, scim.BadRequest(scim.ErrTypeInvalidFilter, "bad"))
+
+	w := suite.request("GET", "/scim/v2/Users?filter=bad", "")
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.JSONEq(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"400","scimType":"invalidFilter","detail":"bad"}`, w.Body.String())
+}
+
+func (suite *SCIMControllerTestSuite) TestGetUserHandlerNotFound() {
+	suite.svc.EXPECT().GetUser(gomock.Any(), uint(9)).Return(scim.User{}, models.ErrEmployeeNotFound)
+	w := suite.request("GET", "/scim/v2/Users/9", "")
+	suite.Equal(http.StatusNotFound, w.Code)
+	suite.Contains(w.Body.String(), `"status":"404"`)
+
+	// IDs are opaque, so a malformed one is just an unknown user
+	w = suite.request("GET", "/scim/v2/Users/abc", "")
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *SCIMControllerTestSuite) TestCreateUserHandler() {
+	suite.svc.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user scim.User) (scim.User, error) {
+		suite.Equal("alice@example.com", user.UserName)
+		return suite.user("7"), nil
+	})
+
+	w := suite.request("POST", "/scim/v2/Users", `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com","displayName":"Alice"}`)
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Equal("http://hr.example.com/scim/v2/Users/7", w.Header().Get("Location"))
+}
+
+func (suite *SCIMControllerTestSuite) TestCreateUserHandlerInvalidBody() {
+	w := suite.request("POST", "/scim/v2/Users", `{"userName":`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), `"scimType":"invalidSyntax"`)
+
+	w = suite.request("POST", "/scim/v2/Users", `{"userName":"alice@example.com"}`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), `"scimType":"invalidValue"`)
+}
+
+func (suite *SCIMControllerTestSuite) TestReplaceAndPatchUserHandlersPassCaller() {
+	suite.svc.EXPECT().ReplaceUser(gomock.Any(), uint(7), gomock.Any(), "okta").Return(scim.User{}, models.ErrInvalidTransition)
+	w := suite.request("PUT", "/scim/v2/Users/7", `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com"}`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), `"scimType":"mutability"`)
+
+	suite.svc.EXPECT().PatchUser(gomock.Any(), uint(7), scim.PatchRequest{
+		Schemas:    []string{scim.PatchOpSchema},
+		Operations: []scim.PatchOperation{{Op: "replace", Path: "active", Value: false}},
+	}, "okta").Return(suite.user("7"), nil)
+	w = suite.request("PATCH", "/scim/v2/Users/7", `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"active","value":false}]}`)
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *SCIMControllerTestSuite) TestDeleteUserHandler() {
+	suite.svc.EXPECT().DeleteUser(gomock.Any(), uint(7)).Return(nil)
+	w := suite.request("DELETE", "/scim/v2/Users/7", "")
+	suite.Equal(http.StatusNoContent, w.Code)
+}
+
+func (suite *SCIMControllerTestSuite) TestUsersRequireProvisionPermission() {
+	suite.principal = auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}
+	w := suite.request("GET", "/scim/v2/Users", "")
+	suite.Equal(http.StatusForbidden, w.Code)
+	suite.Contains(w.Body.String(), "users:provision")
+
+	suite.principal = auth.Anonymous
+	w = suite.request("GET", "/scim/v2/Users", "")
+	suite.Equal(http.StatusUnauthorized, w.Code)
+
+	// Discovery is public
+	w = suite.request("GET", "/scim/v2/ServiceProviderConfig", "")
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *SCIMControllerTestSuite) TestDiscoveryHandlers() {
+	var config scim.ServiceProviderConfig
+	w := suite.request("GET", "/scim/v2/ServiceProviderConfig", "")
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &config))
+	suite.True(config.Patch.Supported)
+	suite.True(config.Filter.Supported)
+	suite.False(config.Bulk.Supported)
+
+	w = suite.request("GET", "/scim/v2/ResourceTypes/User", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"endpoint":"/Users"`)
+	suite.Equal(http.StatusNotFound, suite.request("GET", "/scim/v2/ResourceTypes/Group", "").Code)
+
+	w = suite.request("GET", "/scim/v2/Schemas", "")
+	suite.Contains(w.Body.String(), `"totalResults":2`)
+	w = suite.request("GET", "/scim/v2/Schemas/"+scim.EnterpriseUserSchema, "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"name":"EnterpriseUser"`)
+}
@@ -0,0 +1,14 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// TagController defines the interface for tag controller
+type TagController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetTags(c *gin.Context)
+	GetEmployeeTags(c *gin.Context)
+	AddEmployeeTags(c *gin.Context)
+	RemoveEmployeeTag(c *gin.Context)
+}
@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// tagControllerImpl is the concrete implementation of TagController
+// (see tag_controller.go for the interface definition)
+type tagControllerImpl struct {
+	tagService service.TagService
+}
+
+// NewTagController creates a new instance of TagController
+func NewTagController(tagService service.TagService) TagController {
+	return &tagControllerImpl{
+		tagService: tagService,
+	}
+}
+
+// RegisterRoutes registers the tag routes with the given router group,
+// including the tag routes of each employee
+func (tc *tagControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/tags", requireAcceptable, tc.GetTags)
+	employeeTags := router.Group("/employees/:id/tags", requireAcceptable)
+	{
+		employeeTags.GET("", tc.GetEmployeeTags)
+		employeeTags.POST("", tc.AddEmployeeTags)
+		employeeTags.DELETE("/:tag", tc.RemoveEmployeeTag)
+	}
+}
+
+// GetTags handles GET request to fetch the tags in use
+// @Summary Get all tags
+// @Description Retrieves the tags employees carry with the number of employees carrying each, ordered by name
+// @Tags tags
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Success 200 {array} models.TagCount
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /tags [get]
+func (tc *tagControllerImpl) GetTags(c *gin.Context) {
+	tags, err := tc.tagService.GetAllTags(c.Request.Context())
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, tags)
+}
+
+// GetEmployeeTags handles GET request to fetch an employee's tags
+// @Summary Get employee tags
+// @Description Retrieves the tags of an employee, ordered by name
+// @Tags tags
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Success 200 {array} string
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/tags [get]
+func (tc *tagControllerImpl) GetEmployeeTags(c *gin.Context) {
+	id, err := parseUintID(c.Param("id"))
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		return
+	}
+
+	tags, err := tc.tagService.GetEmployeeTags(c.Request.Context(), id)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, tags)
+}
+
+// AddEmployeeTags handles POST request to tag an employee
+// @Summary Add employee tags
+// @Description Gives an employee the tags, creating tags that are new. Names are stored in lowercase and start with a letter or digit followed by up to 62 letters, digits, hyphens or underscores. Tags the employee already carries are kept. Returns all the employee's tags.
+// @Tags tags
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Param tags body models.TagsRequest true "Tags to add"
+// @Success 200 {array} string
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID, request data or tag name"
+// @Failure 404 {object} map[string]interface{} "Employee not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/tags [post]
+func (tc *tagControllerImpl) AddEmployeeTags(c *gin.Context) {
+	id, err := parseUintID(c.Param("id"))
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		return
+	}
+	var request models.TagsRequest
+	if err := bindBody(c, &request); err != nil {
+		respond(c, errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	tags, err := tc.tagService.AddEmployeeTags(c.Request.Context(), id, request.Tags)
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, tags)
+}
+
+// RemoveEmployeeTag handles DELETE request to take a tag away from an employee
+// @Summary Remove employee tag
+// @Description Takes a tag away from an employee and returns their remaining tags
+// @Tags tags
+// @Accept json,xml,application/x-yaml,application/x-msgpack
+// @Produce json,xml,application/x-yaml,application/x-msgpack
+// @Param id path int true "Employee ID"
+// @Param tag path string true "Tag name"
+// @Success 200 {array} string
+// @Failure 400 {object} map[string]interface{} "Invalid employee ID or tag name"
+// @Failure 404 {object} map[string]interface{} "Employee not found or not carrying the tag"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /employees/{id}/tags/{tag} [delete]
+func (tc *tagControllerImpl) RemoveEmployeeTag(c *gin.Context) {
+	id, err := parseUintID(c.Param("id"))
+	if err != nil {
+		respond(c, http.StatusBadRequest, gin.H{"error": "Invalid employee ID"})
+		return
+	}
+
+	tags, err := tc.tagService.RemoveEmployeeTag(c.Request.Context(), id, c.Param("tag"))
+	if err != nil {
+		respond(c, errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	respond(c, http.StatusOK, tags)
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type TagControllerTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	svc  *mocks.MockTagService
+	r    *gin.Engine
+}
+
+func (suite *TagControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockTagService(suite.ctrl)
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	NewTagController(suite.svc).RegisterRoutes(suite.r.Group("/api/v1"))
+}
+
+func (suite *TagControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestTagControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(TagControllerTestSuite))
+}
+
+func (suite *TagControllerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *TagControllerTestSuite) TestGetTagsHandler() {
+	suite.svc.EXPECT().GetAllTags(gomock.Any()).Return([]models.TagCount{{Name: "oncall", Count: 3}}, nil)
+	w := suite.request("GET", "/api/v1/tags", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`[{"name":"oncall","count":3}]`, w.Body.String())
+}
+
+func (suite *TagControllerTestSuite) TestAddEmployeeTagsHandler() {
+	suite.svc.EXPECT().AddEmployeeTags(gomock.Any(), uint(4), []string{"oncall", "Remote"}).Return([]string{"oncall", "remote"}, nil)
+	w := suite.request("POST", "/api/v1/employees/4/tags", `{"tags":["oncall","Remote"]}`)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`["oncall","remote"]`, w.Body.String())
+
+	w = suite.request("POST", "/api/v1/employees/4/tags", `{"tags":[]}`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+	w = suite.request("POST", "/api/v1/employees/abc/tags", `{"tags":["oncall"]}`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+
+	suite.svc.EXPECT().AddEmployeeTags(gomock.Any(), uint(9), []string{"oncall"}).Return(nil, models.ErrEmployeeNotFound)
+	w = suite.request("POST", "/api/v1/employees/9/tags", `{"tags":["oncall"]}`)
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *TagControllerTestSuite) TestGetAndRemoveEmployeeTagsHandlers() {
+	suite.svc.EXPECT().GetEmployeeTags(gomock.Any(), uint(4)).Return([]string{}, nil)
+	w := suite.request("GET", "/api/v1/employees/4/tags", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`[]`, w.Body.String())
+
+	suite.svc.EXPECT().RemoveEmployeeTag(gomock.Any(), uint(4), "oncall").Return([]string{"remote"}, nil)
+	w = suite.request("DELETE", "/api/v1/employees/4/tags/oncall", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`["remote"]`, w.Body.String())
+
+	suite.svc.EXPECT().RemoveEmployeeTag(gomock.Any(), uint(4), "mentor").Return(nil, models.ErrTagNotFound)
+	w = suite.request("DELETE", "/api/v1/employees/4/tags/mentor", "")
+	suite.Equal(http.StatusNotFound, w.Code)
+}
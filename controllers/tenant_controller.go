@@ -0,0 +1,15 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// TenantController defines the interface for tenant controller
+type TenantController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetTenants(c *gin.Context)
+	GetTenant(c *gin.Context)
+	CreateTenant(c *gin.Context)
+	UpdateTenant(c *gin.Context)
+	DeleteTenant(c *gin.Context)
+}
@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// tenantControllerImpl is the concrete implementation of TenantController
+// (see tenant_controller.go for the interface definition)
+type tenantControllerImpl struct {
+	tenantService service.TenantService
+}
+
+// NewTenantController creates a new instance of TenantController
+func NewTenantController(tenantService service.TenantService) TenantController {
+	return &tenantControllerImpl{
+		tenantService: tenantService,
+	}
+}
+
+// RegisterRoutes registers the tenant routes with the given router group.
+// Every route requires the tenants:manage permission and a token that is
+// not bound to a tenant.
+func (tc *tenantControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	tenants := router.Group("/tenants", requirePermission(auth.PermTenantManage), requireUnboundToken)
+	{
+		tenants.GET("/", tc.GetTenants)
+		tenants.GET("/:id", tc.GetTenant)
+		tenants.POST("/", tc.CreateTenant)
+		tenants.PUT("/:id", tc.UpdateTenant)
+		tenants.DELETE("/:id", tc.DeleteTenant)
+	}
+}
+
+// requireUnboundToken aborts with 403 when the caller's token is bound to a
+// tenant, since managing tenants reaches beyond any one of them
+func requireUnboundToken(c *gin.Context) {
+	if auth.FromContext(c).Tenant != "" {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Tokens bound to a tenant cannot manage tenants"})
+	}
+}
+
+// GetTenants handles GET request to list tenants
+// @Summary Get all tenants
+// @Description Retrieves every tenant sharing the deployment with its settings
+// @Tags tenants
+// @Produce json
+// @Success 200 {array} models.Tenant
+// @Failure 403 {object} map[string]interface{} "Missing the tenants:manage permission"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /tenants [get]
+func (tc *tenantControllerImpl) GetTenants(c *gin.Context) {
+	tenants, err := tc.tenantService.GetAllTenants(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, tenants)
+}
+
+// GetTenant handles GET request to fetch a tenant by ID
+// @Summary Get tenant by ID
+// @Description Retrieves a tenant and its settings
+// @Tags tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Success 200 {object} models.Tenant
+// @Failure 403 {object} map[string]interface{} "Missing the tenants:manage permission"
+// @Failure 404 {object} map[string]interface{} "Tenant not found"
+// @Router /tenants/{id} [get]
+func (tc *tenantControllerImpl) GetTenant(c *gin.Context) {
+	t, err := tc.tenantService.GetTenantByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, t)
+}
+
+// CreateTenant handles POST request to add a tenant
+// @Summary Create tenant
+// @Description Adds a tenant, which starts without any data. The ID must be usable as a subdomain label: lowercase letters, digits and hyphens.
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Param tenant body models.Tenant true "Tenant object"
+// @Success 201 {object} models.Tenant
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 403 {object} map[string]interface{} "Missing the tenants:manage permission"
+// @Failure 409 {object} map[string]interface{} "Tenant already exists"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /tenants [post]
+func (tc *tenantControllerImpl) CreateTenant(c *gin.Context) {
+	var t models.Tenant
+	if err := c.ShouldBindJSON(&t); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := tc.tenantService.CreateTenant(c.Request.Context(), t)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+// UpdateTenant handles PUT request to update a tenant
+// @Summary Update tenant
+// @Description Replaces a tenant's name and settings. The ID cannot change.
+// @Tags tenants
+// @Accept json
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Param tenant body models.Tenant true "Updated tenant object"
+// @Success 200 {object} models.Tenant
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 403 {object} map[string]interface{} "Missing the tenants:manage permission"
+// @Failure 404 {object} map[string]interface{} "Tenant not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /tenants/{id} [put]
+func (tc *tenantControllerImpl) UpdateTenant(c *gin.Context) {
+	id := c.Param("id")
+	var t models.Tenant
+	// The ID comes from the path, so the body may leave it out
+	t.ID = id
+	if err := c.ShouldBindJSON(&t); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updated, err := tc.tenantService.UpdateTenant(c.Request.Context(), id, t)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteTenant handles DELETE request to remove a tenant
+// @Summary Delete tenant
+// @Description Removes a tenant that has no employees left. The default tenant cannot be removed.
+// @Tags tenants
+// @Produce json
+// @Param id path string true "Tenant ID"
+// @Success 200 {object} map[string]interface{} "Success message"
+// @Failure 403 {object} map[string]interface{} "Missing the tenants:manage permission"
+// @Failure 404 {object} map[string]interface{} "Tenant not found"
+// @Failure 409 {object} map[string]interface{} "Default tenant or tenant with employees"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /tenants/{id} [delete]
+func (tc *tenantControllerImpl) DeleteTenant(c *gin.Context) {
+	if err := tc.tenantService.DeleteTenant(c.Request.Context(), c.Param("id")); err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Tenant deleted successfully"})
+}
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type TenantControllerTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	svc       *mocks.MockTenantService
+	r         *gin.Engine
+	principal auth.Principal
+}
+
+func (suite *TenantControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockTenantService(suite.ctrl)
+	suite.principal = auth.Principal{Subject: "root", Roles: []string{auth.RoleAdmin}}
+
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	controller := &tenantControllerImpl{tenantService: suite.svc}
+	controller.RegisterRoutes(suite.r.Group("/api/v1"))
+}
+
+func (suite *TenantControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestTenantControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(TenantControllerTestSuite))
+}
+
+func (suite *TenantControllerTestSuite) request(method, path, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *TenantControllerTestSuite) TestCreateTenantHandler() {
+	suite.svc.EXPECT().CreateTenant(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t models.Tenant) (models.Tenant, error) {
+		suite.Equal(models.Tenant{ID: "acme", Name: "Acme GmbH", Currency: "EUR", StrictSalaryBands: true}, t)
+		return t, nil
+	})
+	w := suite.request("POST", "/api/v1/tenants/", `{"id":"acme","name":"Acme GmbH","currency":"EUR","strict_salary_bands":true}`)
+	suite.Equal(http.StatusCreated, w.Code)
+
+	suite.svc.EXPECT().CreateTenant(gomock.Any(), gomock.Any()).Return(models.Tenant{}, models.ErrConflict)
+	w = suite.request("POST", "/api/v1/tenants/", `{"id":"acme","name":"Acme GmbH"}`)
+	suite.Equal(http.StatusConflict, w.Code)
+}
+
+func (suite *TenantControllerTestSuite) TestUpdateTenantHandlerTakesIDFromPath() {
+	suite.svc.EXPECT().UpdateTenant(gomock.Any(), "acme", models.Tenant{ID: "acme", Name: "Acme AG"}).
+		Return(models.Tenant{ID: "acme", Name: "Acme AG"}, nil)
+	w := suite.request("PUT", "/api/v1/tenants/acme", `{"name":"Acme AG"}`)
+	suite.Equal(http.StatusOK, w.Code)
+
+	suite.svc.EXPECT().UpdateTenant(gomock.Any(), "globex", gomock.Any()).Return(models.Tenant{}, models.ErrTenantNotFound)
+	w = suite.request("PUT", "/api/v1/tenants/globex", `{"name":"Globex"}`)
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *TenantControllerTestSuite) TestTenantsRequireUnboundManager() {
+	suite.principal = auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}
+	w := suite.request("GET", "/api/v1/tenants/", "")
+	suite.Equal(http.StatusForbidden, w.Code)
+	suite.Contains(w.Body.String(), "tenants:manage")
+
+	// An admin of one tenant cannot manage the others
+	suite.principal = auth.Principal{Subject: "acme-root", Roles: []string{auth.RoleAdmin}, Tenant: "acme"}
+	w = suite.request("GET", "/api/v1/tenants/", "")
+	suite.Equal(http.StatusForbidden, w.Code)
+	suite.Contains(w.Body.String(), "bound to a tenant")
+}
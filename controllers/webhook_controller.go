@@ -0,0 +1,18 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookController defines the interface for webhook controller
+type WebhookController interface {
+	RegisterRoutes(router *gin.RouterGroup)
+	GetWebhooks(c *gin.Context)
+	GetWebhook(c *gin.Context)
+	CreateWebhook(c *gin.Context)
+	UpdateWebhook(c *gin.Context)
+	DeleteWebhook(c *gin.Context)
+	GetDeliveries(c *gin.Context)
+	GetDeadLetters(c *gin.Context)
+	RetryDelivery(c *gin.Context)
+}
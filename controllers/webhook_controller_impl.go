@@ -0,0 +1,266 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin"
+)
+
+// webhookControllerImpl is the concrete implementation of WebhookController
+// (see webhook_controller.go for the interface definition)
+type webhookControllerImpl struct {
+	webhookService service.WebhookService
+}
+
+// NewWebhookController creates a new instance of WebhookController
+func NewWebhookController(webhookService service.WebhookService) WebhookController {
+	return &webhookControllerImpl{
+		webhookService: webhookService,
+	}
+}
+
+// RegisterRoutes registers the webhook routes with the given router group.
+// Every route requires the webhooks:manage permission.
+func (wc *webhookControllerImpl) RegisterRoutes(router *gin.RouterGroup) {
+	webhooks := router.Group("/webhooks", requirePermission(auth.PermWebhookManage))
+	{
+		webhooks.GET("/", wc.GetWebhooks)
+		webhooks.GET("/dead-letters", wc.GetDeadLetters)
+		webhooks.POST("/deliveries/:id/retry", wc.RetryDelivery)
+		webhooks.GET("/:id", wc.GetWebhook)
+		webhooks.POST("/", wc.CreateWebhook)
+		webhooks.PUT("/:id", wc.UpdateWebhook)
+		webhooks.DELETE("/:id", wc.DeleteWebhook)
+		webhooks.GET("/:id/deliveries", wc.GetDeliveries)
+	}
+}
+
+// GetWebhooks handles GET request to list webhooks
+// @Summary Get all webhooks
+// @Description Retrieves every webhook subscription. Secrets are never returned.
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} models.Webhook
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /webhooks [get]
+func (wc *webhookControllerImpl) GetWebhooks(c *gin.Context) {
+	webhooks, err := wc.webhookService.GetAllWebhooks(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, webhooks)
+}
+
+// GetWebhook handles GET request to fetch a webhook by ID
+// @Summary Get webhook by ID
+// @Description Retrieves a webhook subscription without its secret
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} map[string]interface{} "Invalid webhook ID"
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 404 {object} map[string]interface{} "Webhook not found"
+// @Router /webhooks/{id} [get]
+func (wc *webhookControllerImpl) GetWebhook(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	webhook, err := wc.webhookService.GetWebhookByID(c.Request.Context(), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, webhook)
+}
+
+// CreateWebhook handles POST request to subscribe a URL to employee events
+// @Summary Create webhook
+// @Description Subscribes a URL to employee events. Deliveries are POSTed as JSON and signed with the webhook's secret in the X-Webhook-Signature header (sha256=<hex HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>">). A secret is generated when none is given; the response is the only time it is shown.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body models.Webhook true "Webhook object"
+// @Success 201 {object} models.Webhook
+// @Failure 400 {object} map[string]interface{} "Invalid request data"
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /webhooks [post]
+func (wc *webhookControllerImpl) CreateWebhook(c *gin.Context) {
+	var webhook models.Webhook
+	if err := c.ShouldBindJSON(&webhook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	createdWebhook, err := wc.webhookService.CreateWebhook(c.Request.Context(), webhook)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, createdWebhook)
+}
+
+// UpdateWebhook handles PUT request to update a webhook
+// @Summary Update webhook
+// @Description Replaces a webhook's URL, event types and disabled flag. The secret is rotated only when a new one is given.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param webhook body models.Webhook true "Updated webhook object"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} map[string]interface{} "Invalid webhook ID or request data"
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 404 {object} map[string]interface{} "Webhook not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /webhooks/{id} [put]
+func (wc *webhookControllerImpl) UpdateWebhook(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	var webhook models.Webhook
+	if err := c.ShouldBindJSON(&webhook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updatedWebhook, err := wc.webhookService.UpdateWebhook(c.Request.Context(), uint(id), webhook)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, updatedWebhook)
+}
+
+// DeleteWebhook handles DELETE request to remove a webhook
+// @Summary Delete webhook
+// @Description Removes a webhook together with its queued deliveries and delivery log
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} map[string]interface{} "Success message"
+// @Failure 400 {object} map[string]interface{} "Invalid webhook ID"
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 404 {object} map[string]interface{} "Webhook not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /webhooks/{id} [delete]
+func (wc *webhookControllerImpl) DeleteWebhook(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	if err := wc.webhookService.DeleteWebhook(c.Request.Context(), uint(id)); err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Webhook deleted successfully"})
+}
+
+// GetDeliveries handles GET request to page through a webhook's delivery log
+// @Summary Get webhook deliveries
+// @Description Retrieves the webhook's deliveries, newest first, with the status, attempt count and last response of each. The total is returned in the X-Total-Count header.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 400 {object} map[string]interface{} "Invalid webhook ID or query parameters"
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 404 {object} map[string]interface{} "Webhook not found"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /webhooks/{id}/deliveries [get]
+func (wc *webhookControllerImpl) GetDeliveries(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	var p models.Pagination
+	if err := c.ShouldBindQuery(&p); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	p.Normalize()
+
+	deliveries, total, err := wc.webhookService.GetDeliveries(c.Request.Context(), uint(id), p)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	setPaginationHeaders(c, p, total)
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// GetDeadLetters handles GET request to page through the dead-letter list
+// @Summary Get dead-lettered deliveries
+// @Description Retrieves the deliveries of every webhook that failed all their attempts, newest first. The total is returned in the X-Total-Count header.
+// @Tags webhooks
+// @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 400 {object} map[string]interface{} "Invalid query parameters"
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /webhooks/dead-letters [get]
+func (wc *webhookControllerImpl) GetDeadLetters(c *gin.Context) {
+	var p models.Pagination
+	if err := c.ShouldBindQuery(&p); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	p.Normalize()
+
+	deliveries, total, err := wc.webhookService.GetDeadLetters(c.Request.Context(), p)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	setPaginationHeaders(c, p, total)
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// RetryDelivery handles POST request to requeue a dead-lettered delivery
+// @Summary Retry dead-lettered delivery
+// @Description Puts a dead delivery back on the queue with a fresh set of attempts. It keeps its ID, so receivers can still recognise a duplicate.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Delivery ID"
+// @Success 202 {object} models.WebhookDelivery
+// @Failure 400 {object} map[string]interface{} "Invalid delivery ID"
+// @Failure 403 {object} map[string]interface{} "Missing the webhooks:manage permission"
+// @Failure 404 {object} map[string]interface{} "Delivery not found"
+// @Failure 409 {object} map[string]interface{} "Delivery is not dead"
+// @Failure 500 {object} map[string]interface{} "Error response"
+// @Router /webhooks/deliveries/{id}/retry [post]
+func (wc *webhookControllerImpl) RetryDelivery(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid delivery ID"})
+		return
+	}
+
+	delivery, err := wc.webhookService.RetryDelivery(c.Request.Context(), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusAccepted, delivery)
+}
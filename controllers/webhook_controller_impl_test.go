@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type WebhookControllerTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	svc       *mocks.MockWebhookService
+	r         *gin.Engine
+	principal auth.Principal
+}
+
+func (suite *WebhookControllerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockWebhookService(suite.ctrl)
+	suite.principal = auth.Principal{Subject: "admin", Roles: []string{auth.RoleAdmin}}
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.Default()
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	controller := &webhookControllerImpl{webhookService: suite.svc}
+	v1 := suite.r.Group("/api/v1")
+	controller.RegisterRoutes(v1)
+}
+
+func (suite *WebhookControllerTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestWebhookControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookControllerTestSuite))
+}
+
+func (suite *WebhookControllerTestSuite) TestCreateWebhookHandler() {
+	input := `{"url":"https://example.com/hook","event_types":["employee.terminated"]}`
+	suite.svc.EXPECT().CreateWebhook(gomock.Any(), models.Webhook{URL: "https://example.com/hook", EventTypes: []models.EmployeeEventType{models.EventEmployeeTerminated}}).
+		DoAndReturn(func(_ context.Context, w models.Webhook) (models.Webhook, error) {
+			w.ID = 1
+			w.Secret = "generated"
+			return w, nil
+		})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/webhooks/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Contains(w.Body.String(), `"secret":"generated"`)
+
+	// Unknown event types are rejected
+	input = `{"url":"https://example.com/hook","event_types":["employee.promoted"]}`
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/webhooks/", strings.NewReader(input))
+	req.Header.Set("Content-Type", "application/json")
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *WebhookControllerTestSuite) TestWebhooksRequirePermission() {
+	suite.principal = auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/webhooks/", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusForbidden, w.Code)
+	suite.Contains(w.Body.String(), "webhooks:manage")
+}
+
+func (suite *WebhookControllerTestSuite) TestGetDeliveriesHandler() {
+	deliveries := []models.WebhookDelivery{{ID: 3, WebhookID: 1, Status: models.DeliveryDead, Payload: []byte(`{"event_id":7}`)}}
+	suite.svc.EXPECT().GetDeliveries(gomock.Any(), uint(1), models.Pagination{Page: 2, PageSize: 1}).Return(deliveries, int64(5), nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/webhooks/1/deliveries?page=2&page_size=1", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("5", w.Header().Get("X-Total-Count"))
+	suite.Contains(w.Body.String(), `"payload":{"event_id":7}`)
+
+	suite.svc.EXPECT().GetDeliveries(gomock.Any(), uint(9), gomock.Any()).Return(nil, int64(0), models.ErrWebhookNotFound)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/webhooks/9/deliveries", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *WebhookControllerTestSuite) TestGetDeadLettersHandler() {
+	suite.svc.EXPECT().GetDeadLetters(gomock.Any(), models.Pagination{Page: 1, PageSize: models.DefaultPageSize}).Return([]models.WebhookDelivery{{ID: 3, Status: models.DeliveryDead}}, int64(1), nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/webhooks/dead-letters", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Contains(w.Body.String(), `"status":"dead"`)
+}
+
+func (suite *WebhookControllerTestSuite) TestRetryDeliveryHandler() {
+	suite.svc.EXPECT().RetryDelivery(gomock.Any(), uint(3)).Return(models.WebhookDelivery{ID: 3, Status: models.DeliveryPending}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/webhooks/deliveries/3/retry", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusAccepted, w.Code)
+
+	suite.svc.EXPECT().RetryDelivery(gomock.Any(), uint(4)).Return(models.WebhookDelivery{}, models.ErrConflict)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/api/v1/webhooks/deliveries/4/retry", nil)
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusConflict, w.Code)
+}
@@ -1,40 +1,84 @@
 package db
 
 import (
+	"context"
 	"log"
 
 	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
 	"github.com/glebarez/sqlite" // Pure Go SQLite driver, doesn't require CGO
 	"gorm.io/gorm"
 )
 
 var DB *gorm.DB
 
+// tenantScoped are the models whose rows belong to one tenant
+var tenantScoped = []interface{}{
+	&models.Department{}, &models.Position{}, &models.SalaryBand{}, &models.SalaryBandOverride{},
+	&models.Employee{}, &models.EmployeeStatusTransition{}, &models.SalaryChange{},
+	&models.Webhook{}, &models.WebhookDelivery{}, &models.OutboxEvent{}, &models.CustomField{},
+	&models.Tag{}, &models.EmployeeTag{},
+}
+
 // ConnectDatabase initializes the database connection and performs migrations
 func ConnectDatabase() {
 	// Using pure Go SQLite implementation - no CGO dependency
-	database, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	database, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal("Failed to set up in-memory database:", err)
 	}
 
 	// Auto migrate the models
-	err = database.AutoMigrate(&models.Employee{})
+	err = database.AutoMigrate(append([]interface{}{&models.Tenant{}, &models.OutboxCursor{}, &models.IdempotencyRecord{}},
+		tenantScoped...)...)
 	if err != nil {
 		log.Fatal("Failed to migrate database:", err)
 	}
+	// From here on every query on a tenant-scoped table is scoped to the
+	// tenant of its context
+	if err := database.Use(tenant.NewPlugin(tenantScoped...)); err != nil {
+		log.Fatal("Failed to set up tenant scoping:", err)
+	}
 
 	DB = database
 
+	// The seed data belongs to the default tenant
+	database.Create(&models.Tenant{ID: tenant.Default, Name: "Default", Currency: models.DefaultCurrency})
+	seed := database.WithContext(tenant.NewContext(context.Background(), tenant.Default))
+
+	// Insert default departments
+	departments := []models.Department{
+		{Name: "Engineering", Description: "Builds and runs the product"},
+		{Name: "Design", Description: "Product and visual design"},
+		{Name: "Management", Description: "People and project management"},
+	}
+	seed.CreateInBatches(&departments, 3)
+	engineering, design, management := &departments[0].ID, &departments[1].ID, &departments[2].ID
+
+	// Insert the default job catalog
+	positions := []models.Position{
+		{Title: "Developer", Level: "L2", Family: "Engineering", SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 60000, MaxSalary: 90000}}},
+		{Title: "Designer", Level: "L2", Family: "Design", SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 55000, MaxSalary: 80000}}},
+		{Title: "Manager", Level: "M1", Family: "Management", StrictBand: true, SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 80000, MaxSalary: 120000}}},
+		{Title: "QA Engineer", Level: "L2", Family: "Engineering", SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 50000, MaxSalary: 75000}}},
+		{Title: "DevOps", Level: "L2", Family: "Engineering", SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 65000, MaxSalary: 95000}}},
+	}
+	seed.Create(&positions)
+
 	// Insert default employees
 	employees := []models.Employee{
-		{Name: "Alice Smith", Email: "alice@example.com", Position: "Developer", Salary: 70000},
-		{Name: "Bob Johnson", Email: "bob@example.com", Position: "Designer", Salary: 65000},
-		{Name: "Charlie Lee", Email: "charlie@example.com", Position: "Manager", Salary: 90000},
-		{Name: "Diana King", Email: "diana@example.com", Position: "QA Engineer", Salary: 60000},
-		{Name: "Ethan Brown", Email: "ethan@example.com", Position: "DevOps", Salary: 75000},
+		{Name: "Alice Smith", Email: "alice@example.com", Position: "Developer", PositionID: &positions[0].ID, Salary: 70000, Currency: "USD", DepartmentID: engineering},
+		{Name: "Bob Johnson", Email: "bob@example.com", Position: "Designer", PositionID: &positions[1].ID, Salary: 65000, Currency: "USD", DepartmentID: design},
+		{Name: "Charlie Lee", Email: "charlie@example.com", Position: "Manager", PositionID: &positions[2].ID, Salary: 90000, Currency: "USD", DepartmentID: management},
+		{Name: "Diana King", Email: "diana@example.com", Position: "QA Engineer", PositionID: &positions[3].ID, Salary: 60000, Currency: "USD", DepartmentID: engineering},
+		{Name: "Ethan Brown", Email: "ethan@example.com", Position: "DevOps", PositionID: &positions[4].ID, Salary: 75000, Currency: "USD", DepartmentID: engineering},
 	}
-	DB.CreateInBatches(&employees, 5)
+	seed.CreateInBatches(&employees, 5)
+
+	// Charlie manages the team leads and Alice leads QA
+	charlie, alice := employees[2].ID, employees[0].ID
+	seed.Model(&models.Employee{}).Where("id IN ?", []uint{employees[0].ID, employees[1].ID, employees[4].ID}).Update("manager_id", charlie)
+	seed.Model(&models.Employee{}).Where("id = ?", employees[3].ID).Update("manager_id", alice)
 
 	log.Println("Database connected and migrated successfully!")
 }
@@ -15,9 +15,236 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/employees": {
+        "/analytics/headcount": {
+            "get": {
+                "description": "Counts employees per position, department or status, largest group first. Terminated employees are left out unless include_terminated is set.",
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Get headcount",
+                "parameters": [
+                    {
+                        "enum": [
+                            "position",
+                            "department",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Attribute to group by (default department)",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Count terminated employees too",
+                        "name": "include_terminated",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.HeadcountGroup"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/movements": {
+            "get": {
+                "description": "Counts hires by join date and terminations by termination date for every month in the range, including months without any. Without from or to, the range starts or ends at the first or last month with a movement.",
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Get hires and terminations per month",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First month, YYYY-MM",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last month, YYYY-MM",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MonthlyMovement"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month range",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/salaries": {
+            "get": {
+                "description": "Returns the minimum, maximum, mean, median and 25th, 75th and 90th percentile salary per position, department or status and currency. Requires the salary:read permission.",
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Get salary statistics",
+                "parameters": [
+                    {
+                        "enum": [
+                            "position",
+                            "department",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Attribute to group by (default department)",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include terminated employees",
+                        "name": "include_terminated",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SalaryStats"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the salary:read permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/tenure": {
+            "get": {
+                "description": "Counts employees by years since their join date, up to today or their termination date. Employees without a join date are left out, and terminated employees unless include_terminated is set.",
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Get tenure distribution",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include terminated employees",
+                        "name": "include_terminated",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TenureBucket"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/departments": {
             "get": {
-                "description": "Retrieves all employees from the database",
+                "description": "Retrieves all departments from the database",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,16 +252,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "departments"
                 ],
-                "summary": "Get all employees",
+                "summary": "Get all departments",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Employee"
+                                "$ref": "#/definitions/models.Department"
                             }
                         }
                     },
@@ -48,7 +275,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new employee record",
+                "description": "Creates a new department record",
                 "consumes": [
                     "application/json"
                 ],
@@ -56,17 +283,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "departments"
                 ],
-                "summary": "Create employee",
+                "summary": "Create department",
                 "parameters": [
                     {
-                        "description": "Employee object",
-                        "name": "employee",
+                        "description": "Department object",
+                        "name": "department",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Employee"
+                            "$ref": "#/definitions/models.Department"
                         }
                     }
                 ],
@@ -74,7 +301,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Employee"
+                            "$ref": "#/definitions/models.Department"
                         }
                     },
                     "400": {
@@ -94,9 +321,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/employees/{id}": {
+        "/departments/{id}": {
             "get": {
-                "description": "Retrieves a specific employee by their ID",
+                "description": "Retrieves a specific department by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -104,13 +331,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "departments"
                 ],
-                "summary": "Get employee by ID",
+                "summary": "Get department by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Employee ID",
+                        "description": "Department ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -120,18 +347,18 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Employee"
+                            "$ref": "#/definitions/models.Department"
                         }
                     },
                     "400": {
-                        "description": "Invalid employee ID",
+                        "description": "Invalid department ID",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "404": {
-                        "description": "Employee not found",
+                        "description": "Department not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -140,7 +367,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing employee record",
+                "description": "Updates an existing department record",
                 "consumes": [
                     "application/json"
                 ],
@@ -148,24 +375,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "departments"
                 ],
-                "summary": "Update employee",
+                "summary": "Update department",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Employee ID",
+                        "description": "Department ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated employee object",
-                        "name": "employee",
+                        "description": "Updated department object",
+                        "name": "department",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Employee"
+                            "$ref": "#/definitions/models.Department"
                         }
                     }
                 ],
@@ -173,11 +400,18 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Employee"
+                            "$ref": "#/definitions/models.Department"
                         }
                     },
                     "400": {
-                        "description": "Invalid employee ID or request data",
+                        "description": "Invalid department ID or request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Department not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -193,7 +427,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Removes an employee from the database",
+                "description": "Removes a department from the database. Departments with employees assigned cannot be deleted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -201,13 +435,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "employees"
+                    "departments"
                 ],
-                "summary": "Delete employee",
+                "summary": "Delete department",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Employee ID",
+                        "description": "Department ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -222,7 +456,21 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid employee ID",
+                        "description": "Invalid department ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Department not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Department still has employees",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -237,44 +485,3559 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.Employee": {
-            "type": "object",
-            "required": [
-                "email",
-                "name",
-                "position",
-                "salary"
-            ],
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "join_date": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "position": {
-                    "type": "string"
-                },
-                "salary": {
-                    "type": "number"
-                },
-                "updated_at": {
-                    "type": "string"
+        },
+        "/departments/{id}/employees": {
+            "get": {
+                "description": "Retrieves a page of the employees assigned to a department, with the same filters as the employee list. The total match count is returned in the X-Total-Count header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "departments"
+                ],
+                "summary": "Get department employees",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Department ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact position",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must all carry",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must carry at least one of",
+                        "name": "tags_any",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must not carry",
+                        "name": "tags_not",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return, e.g. id,name,position",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated relations to embed: department, manager, tags",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Employee"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid department ID or query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Department not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees": {
+            "get": {
+                "description": "Retrieves a page of employees, optionally filtered. The total match count is returned in the X-Total-Count header.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get all employees",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact position",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by department ID",
+                        "name": "department_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by manager ID",
+                        "name": "manager_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "onboarding",
+                            "active",
+                            "on_leave",
+                            "terminated"
+                        ],
+                        "type": "string",
+                        "description": "Filter by lifecycle status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the value of a custom field, e.g. custom_fields[shirt_size]=M; repeat for several fields",
+                        "name": "custom_fields[name]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must all carry",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must carry at least one of",
+                        "name": "tags_any",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must not carry",
+                        "name": "tags_not",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return, e.g. id,name,position",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated relations to embed: department, manager, tags",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Employee"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters, unknown custom field or invalid tag",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/bulk": {
+            "post": {
+                "description": "Creates every employee in the array. In atomic mode (default) either all are created in one transaction or none are; in best_effort mode every valid employee is created. Each item gets its own status and error.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Bulk create employees",
+                "parameters": [
+                    {
+                        "enum": [
+                            "atomic",
+                            "best_effort"
+                        ],
+                        "type": "string",
+                        "description": "atomic (default) or best_effort",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Employees to create",
+                        "name": "employees",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Employee"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "All items created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed in best_effort mode",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid body, mode or item count, or an atomic batch failed validation",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Body larger than 10 MiB",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes every employee whose ID is in the array. Modes work as for bulk create.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Bulk delete employees",
+                "parameters": [
+                    {
+                        "enum": [
+                            "atomic",
+                            "best_effort"
+                        ],
+                        "type": "string",
+                        "description": "atomic (default) or best_effort",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Employee IDs to delete",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "All items deleted",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed in best_effort mode",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid body, mode or item count",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "An atomic batch referenced a missing employee",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Body larger than 10 MiB",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies a partial update to every employee in the array; fields left out are unchanged. Modes work as for bulk create.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Bulk update employees",
+                "parameters": [
+                    {
+                        "enum": [
+                            "atomic",
+                            "best_effort"
+                        ],
+                        "type": "string",
+                        "description": "atomic (default) or best_effort",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Partial updates, each with the employee id",
+                        "name": "patches",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.EmployeePatch"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "All items updated",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some items failed in best_effort mode",
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid body, mode or item count, or an atomic batch failed validation",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "An atomic batch referenced a missing employee",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Body larger than 10 MiB",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/events": {
+            "get": {
+                "description": "Streams created, updated and deleted employee events as Server-Sent Events. Each event's id can be sent back as the Last-Event-ID header (or last_event_id query parameter) to resume after it from the retained event log. A reset event means events after that ID are no longer retained and the client should reload its data. Comment lines are sent as heartbeats while the stream is idle.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Stream employee changes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Only events for employees in this department",
+                        "name": "department_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only events for these employee IDs",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID when the Last-Event-ID header is not set",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Resume after this event ID",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "One event per message",
+                        "schema": {
+                            "$ref": "#/definitions/models.EmployeeEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter or event ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/export": {
+            "get": {
+                "description": "Streams every employee matching the filters as CSV, NDJSON or XLSX. Rows are read from the database in batches and sent with chunked encoding as they are read. Salary columns are only included for callers with the salary:read permission.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Export employees",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "Export format (default csv)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact position",
+                        "name": "position",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by department ID",
+                        "name": "department_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by manager ID",
+                        "name": "manager_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "onboarding",
+                            "active",
+                            "on_leave",
+                            "terminated"
+                        ],
+                        "type": "string",
+                        "description": "Filter by lifecycle status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by the value of a custom field, e.g. custom_fields[shirt_size]=M; repeat for several fields",
+                        "name": "custom_fields[name]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must all carry",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must carry at least one of",
+                        "name": "tags_any",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags employees must not carry",
+                        "name": "tags_not",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Employee export",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid format or query parameters, unknown custom field or invalid tag",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/import": {
+            "post": {
+                "description": "Imports employees from a CSV file, sent as the \"file\" field of a multipart form or as the raw request body. The header row names the columns: name, email, position, position_id, salary, currency, department_id, manager_id, join_date and status. Rows are matched by email; existing employees are updated with the non-empty cells, the rest are created. With dry_run=true nothing is written and the report shows what would happen. Otherwise all rows are saved in one transaction, or none are if any row fails.",
+                "consumes": [
+                    "text/csv",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Import employees from CSV",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Validate only, without saving",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import report",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Some rows failed, nothing was imported",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportReport"
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/org-chart": {
+            "get": {
+                "description": "Exports the reporting tree as nested JSON or as a Graphviz DOT graph. Without root the whole organisation is exported.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack",
+                    "text/vnd.graphviz"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Export org chart",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID to use as the root of the chart",
+                        "name": "root",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Export format: json (default) or dot",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.OrgChartNode"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid root or format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/search": {
+            "get": {
+                "description": "Full-text search over employee names, emails and positions, most relevant first. Every query word must match; words match as prefixes and tolerate small typos. Name matches rank above position matches, which rank above email matches. Matched words are wrapped in \u003cmark\u003e\u003c/mark\u003e in the highlights.",
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Search employees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of hits (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SearchHit"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Missing query or invalid limit",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/ws": {
+            "get": {
+                "description": "Upgrades to a WebSocket on which the client manages its own subscriptions with JSON messages. Send {\"type\":\"subscribe\",\"id\":\"mine\",\"filter\":{\"department_id\":1,\"employee_ids\":[4]},\"last_event_id\":41} to receive {\"type\":\"event\",\"id\":\"mine\",\"event\":{...}} for every matching change, {\"type\":\"unsubscribe\",\"id\":\"mine\"} to stop, and {\"type\":\"ping\"} to get a pong. The server answers subscribed, unsubscribed, reset (events after last_event_id are no longer retained) and error messages. Requires a token, which browsers pass as the access_token query parameter. Clients that fall 256 messages behind are disconnected with close code 1013 and should resubscribe with the last event ID they handled.",
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Subscribe to employee changes over WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token, for clients that cannot set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching to the WebSocket protocol",
+                        "schema": {
+                            "$ref": "#/definitions/models.EventServerMessage"
+                        }
+                    },
+                    "400": {
+                        "description": "Not a WebSocket request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Missing token",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}": {
+            "get": {
+                "description": "Retrieves a specific employee by their ID",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get employee by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return, e.g. id,name,position",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated relations to embed: department, manager, tags",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Employee"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID or view",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/activate": {
+            "post": {
+                "description": "Moves an employee from onboarding or on leave to active",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Activate employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional effective date and reason",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Employee"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID or request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed from the current status",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/chain": {
+            "get": {
+                "description": "Retrieves the employee's managers, from their direct manager up to the top of the organisation",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get reporting chain",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Employee"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/leave": {
+            "post": {
+                "description": "Moves an active employee to on leave. A reason is required.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Put employee on leave",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason and optional effective date",
+                        "name": "transition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Employee"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID, request data or missing reason",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed from the current status",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/reports": {
+            "get": {
+                "description": "Retrieves the employees whose manager is the given employee",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get direct reports",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Employee"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/salary-overrides": {
+            "get": {
+                "description": "Retrieves the recorded justifications for salaries accepted outside the position's band",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get salary overrides",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SalaryBandOverride"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/status-history": {
+            "get": {
+                "description": "Retrieves every lifecycle transition of an employee, oldest first",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get status history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.EmployeeStatusTransition"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/subordinates": {
+            "get": {
+                "description": "Retrieves every employee below the given manager at any depth, ordered level by level",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Get subordinates",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Employee"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/tags": {
+            "get": {
+                "description": "Retrieves the tags of an employee, ordered by name",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get employee tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Gives an employee the tags, creating tags that are new. Names are stored in lowercase and start with a letter or digit followed by up to 62 letters, digits, hyphens or underscores. Tags the employee already carries are kept. Returns all the employee's tags.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Add employee tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tags to add",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID, request data or tag name",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/tags/{tag}": {
+            "delete": {
+                "description": "Takes a tag away from an employee and returns their remaining tags",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Remove employee tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag name",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID or tag name",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found or not carrying the tag",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/employees/{id}/terminate": {
+            "post": {
+                "description": "Terminates an employee while retaining their record. An effective date and a reason are required.",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "employees"
+                ],
+                "summary": "Terminate employee",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Termination date and reason",
+                        "name": "transition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Employee"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid employee ID, request data or missing date or reason",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Employee not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Employee is already terminated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "description": "Runs a query or mutation against the employee schema. Employees can be fetched with their manager, department, reports and salary history in one request; relations are loaded in batches. Queries deeper or more complex than the configured limits are rejected. Errors are returned in the errors array with a code in their extensions, with status 200 as long as the request body could be read.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "Run a GraphQL request",
+                "parameters": [
+                    {
+                        "description": "GraphQL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GraphQL response with data and errors",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Unreadable request body",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/positions": {
+            "get": {
+                "description": "Retrieves every position in the job catalog with its salary bands",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "positions"
+                ],
+                "summary": "Get all positions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Position"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a position to the job catalog, with at most one salary band per currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "positions"
+                ],
+                "summary": "Create position",
+                "parameters": [
+                    {
+                        "description": "Position object",
+                        "name": "position",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Position"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Position"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Position or currency band already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/positions/{id}": {
+            "get": {
+                "description": "Retrieves a specific catalog position with its salary bands",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "positions"
+                ],
+                "summary": "Get position by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Position ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Position"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid position ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Position not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates a catalog position and replaces its salary bands",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "positions"
+                ],
+                "summary": "Update position",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Position ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated position object",
+                        "name": "position",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Position"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Position"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid position ID or request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Position not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Position or currency band already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a position from the catalog. Positions still held by employees cannot be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "positions"
+                ],
+                "summary": "Delete position",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Position ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid position ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Position not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Position still held by employees",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Retrieves the tags employees carry with the number of employees carrying each, ordered by name",
+                "consumes": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/xml",
+                    "application/x-yaml",
+                    "application/x-msgpack"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get all tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TagCount"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants": {
+            "get": {
+                "description": "Retrieves every tenant sharing the deployment with its settings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Get all tenants",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Tenant"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the tenants:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a tenant, which starts without any data. The ID must be usable as a subdomain label: lowercase letters, digits and hyphens.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Create tenant",
+                "parameters": [
+                    {
+                        "description": "Tenant object",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Tenant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Tenant"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the tenants:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Tenant already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}": {
+            "get": {
+                "description": "Retrieves a tenant and its settings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Get tenant by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Tenant"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the tenants:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a tenant's name and settings. The ID cannot change.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Update tenant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated tenant object",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Tenant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Tenant"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the tenants:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a tenant that has no employees left. The default tenant cannot be removed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Delete tenant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tenant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the tenants:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Tenant not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Default tenant or tenant with employees",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "description": "Retrieves every webhook subscription. Secrets are never returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get all webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Webhook"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribes a URL to employee events. Deliveries are POSTed as JSON and signed with the webhook's secret in the X-Webhook-Signature header (sha256=\u003chex HMAC-SHA256 of \"\u003cX-Webhook-Timestamp\u003e.\u003cbody\u003e\"\u003e). A secret is generated when none is given; the response is the only time it is shown.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook object",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/dead-letters": {
+            "get": {
+                "description": "Retrieves the deliveries of every webhook that failed all their attempts, newest first. The total is returned in the X-Total-Count header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get dead-lettered deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/{id}/retry": {
+            "post": {
+                "description": "Puts a dead delivery back on the queue with a fresh set of attempts. It keeps its ID, so receivers can still recognise a duplicate.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Retry dead-lettered delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid delivery ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Delivery is not dead",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "description": "Retrieves a webhook subscription without its secret",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces a webhook's URL, event types and disabled flag. The secret is rotated only when a new one is given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Update webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated webhook object",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID or request data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a webhook together with its queued deliveries and delivery log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Retrieves the webhook's deliveries, newest first, with the status, attempt count and last response of each. The total is returned in the X-Total-Count header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID or query parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Missing the webhooks:manage permission",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Error response",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.BulkItemResult": {
+            "type": "object",
+            "properties": {
+                "employee": {
+                    "$ref": "#/definitions/models.Employee"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BulkMode": {
+            "type": "string",
+            "enum": [
+                "atomic",
+                "best_effort"
+            ],
+            "x-enum-varnames": [
+                "BulkAtomic",
+                "BulkBestEffort"
+            ]
+        },
+        "models.BulkResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "$ref": "#/definitions/models.BulkMode"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BulkItemResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CustomFieldValues": {
+            "type": "object",
+            "additionalProperties": true
+        },
+        "models.DeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "dead"
+            ],
+            "x-enum-varnames": [
+                "DeliveryPending",
+                "DeliverySucceeded",
+                "DeliveryDead"
+            ]
+        },
+        "models.Department": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Employee": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "salary"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "description": "CustomFields holds the values of the tenant's custom fields by field name.\nUpdates without it keep the stored values.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CustomFieldValues"
+                        }
+                    ]
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "department": {
+                    "description": "Department, Manager and Tags are only filled in when a response expands them",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Department"
+                        }
+                    ]
+                },
+                "department_id": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "join_date": {
+                    "type": "string"
+                },
+                "manager": {
+                    "$ref": "#/definitions/models.Employee"
+                },
+                "manager_id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "position_id": {
+                    "type": "integer"
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "salary_out_of_band": {
+                    "type": "boolean"
+                },
+                "salary_override": {
+                    "description": "SalaryOverride is only read from requests; it is never stored on the employee",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SalaryOverride"
+                        }
+                    ]
+                },
+                "status": {
+                    "enum": [
+                        "onboarding",
+                        "active",
+                        "on_leave",
+                        "terminated"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.EmployeeStatus"
+                        }
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "termination_date": {
+                    "type": "string"
+                },
+                "termination_reason": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EmployeeEvent": {
+            "type": "object",
+            "properties": {
+                "employee": {
+                    "description": "Employee is the record after the change, or the last stored record for deletions",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Employee"
+                        }
+                    ]
+                },
+                "employee_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "previous": {
+                    "description": "Previous is the record before the change, for updates only",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Employee"
+                        }
+                    ]
+                },
+                "tenant_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.EmployeeEventType"
+                }
+            }
+        },
+        "models.EmployeeEventType": {
+            "type": "string",
+            "enum": [
+                "employee.terminated",
+                "employee.salary_changed",
+                "employee.created",
+                "employee.updated",
+                "employee.deleted"
+            ],
+            "x-enum-varnames": [
+                "EventEmployeeTerminated",
+                "EventEmployeeSalaryChanged",
+                "EventEmployeeCreated",
+                "EventEmployeeUpdated",
+                "EventEmployeeDeleted"
+            ]
+        },
+        "models.EmployeePatch": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "custom_fields": {
+                    "description": "CustomFields sets the custom field values given and removes those given as null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CustomFieldValues"
+                        }
+                    ]
+                },
+                "department_id": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "join_date": {
+                    "type": "string"
+                },
+                "manager_id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "position": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "position_id": {
+                    "type": "integer"
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "salary_override": {
+                    "$ref": "#/definitions/models.SalaryOverride"
+                }
+            }
+        },
+        "models.EmployeeStatus": {
+            "type": "string",
+            "enum": [
+                "onboarding",
+                "active",
+                "on_leave",
+                "terminated"
+            ],
+            "x-enum-varnames": [
+                "StatusOnboarding",
+                "StatusActive",
+                "StatusOnLeave",
+                "StatusTerminated"
+            ]
+        },
+        "models.EmployeeStatusTransition": {
+            "type": "object",
+            "properties": {
+                "changed_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "effective_date": {
+                    "type": "string"
+                },
+                "employee_id": {
+                    "type": "integer"
+                },
+                "from_status": {
+                    "$ref": "#/definitions/models.EmployeeStatus"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "$ref": "#/definitions/models.EmployeeStatus"
+                }
+            }
+        },
+        "models.EventServerMessage": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/models.EmployeeEvent"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GraphQLRequest": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "models.HeadcountGroup": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "group": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ImportAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update"
+            ],
+            "x-enum-varnames": [
+                "ImportCreate",
+                "ImportUpdate"
+            ]
+        },
+        "models.ImportReport": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportRowResult"
+                    }
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ImportRowResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/models.ImportAction"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "line": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MonthlyMovement": {
+            "type": "object",
+            "properties": {
+                "hires": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "terminations": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.OrgChartNode": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "reports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.OrgChartNode"
+                    }
+                }
+            }
+        },
+        "models.Position": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "family": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "salary_bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SalaryBand"
+                    }
+                },
+                "strict_band": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SalaryBand": {
+            "type": "object",
+            "required": [
+                "currency"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "max_salary": {
+                    "type": "number"
+                },
+                "min_salary": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "models.SalaryBandOverride": {
+            "type": "object",
+            "properties": {
+                "approved_by": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "employee_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "justification": {
+                    "type": "string"
+                },
+                "max_salary": {
+                    "type": "number"
+                },
+                "min_salary": {
+                    "type": "number"
+                },
+                "position_id": {
+                    "type": "integer"
+                },
+                "salary": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SalaryOverride": {
+            "type": "object",
+            "required": [
+                "justification"
+            ],
+            "properties": {
+                "justification": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SalaryStats": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "max": {
+                    "type": "number"
+                },
+                "mean": {
+                    "type": "number"
+                },
+                "median": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                },
+                "p25": {
+                    "type": "number"
+                },
+                "p75": {
+                    "type": "number"
+                },
+                "p90": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.SearchHighlights": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SearchHit": {
+            "type": "object",
+            "properties": {
+                "employee": {
+                    "$ref": "#/definitions/models.Employee"
+                },
+                "highlights": {
+                    "$ref": "#/definitions/models.SearchHighlights"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.StatusTransitionRequest": {
+            "type": "object",
+            "properties": {
+                "effective_date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TagCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TagsRequest": {
+            "type": "object",
+            "required": [
+                "tags"
+            ],
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.Tenant": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency is assumed for the tenant's salaries that do not name one",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID names the tenant in the X-Tenant-ID header, subdomains and token claims",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "strict_salary_bands": {
+                    "description": "StrictSalaryBands rejects salaries outside the band of any of the\ntenant's positions, as if every position were strict",
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TenureBucket": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "max_years": {
+                    "type": "number"
+                },
+                "min_years": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Webhook": {
+            "type": "object",
+            "required": [
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "event_types": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.EmployeeEventType"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "event_type": {
+                    "$ref": "#/definitions/models.EmployeeEventType"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_attempt_at": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.DeliveryStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "integer"
                 }
             }
         }
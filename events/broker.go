@@ -0,0 +1,43 @@
+package events
+
+import (
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// DefaultRetainedEvents is how many past events a broker keeps for resuming subscribers
+const DefaultRetainedEvents = 1000
+
+// Broker fans employee events out to subscribers in process. It keeps the
+// most recent events so that a subscriber reconnecting with the last ID it
+// saw can catch up on what it missed.
+type Broker interface {
+	// Publish delivers each event to every matching subscriber and returns
+	// the events it published. IDs must increase: an event without an ID is
+	// given the next one, and an event whose ID is not above the last
+	// published ID is a redelivery and is dropped.
+	Publish(events ...models.EmployeeEvent) []models.EmployeeEvent
+	// Subscribe registers a subscriber for the events that match the filter.
+	// When after is not nil, the retained events following that ID are
+	// returned for replay.
+	Subscribe(filter models.EventFilter, after *uint64) *Subscription
+}
+
+// Subscription is one subscriber's view of a Broker. Events arrive on C
+// until Close is called or the subscriber falls so far behind that its
+// buffer fills; C is then closed and the subscriber should resubscribe
+// after the last event it handled.
+type Subscription struct {
+	// Replay holds the retained events after the requested ID, oldest first
+	Replay []models.EmployeeEvent
+	// Missed is set when events after the requested ID are no longer
+	// retained, so the subscriber has to reload its state
+	Missed bool
+	C      <-chan models.EmployeeEvent
+
+	close func()
+}
+
+// Close stops delivery and releases the subscription
+func (s *Subscription) Close() {
+	s.close()
+}
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// subscriberBuffer is how many undelivered events a subscriber may have
+// before it is dropped as too slow
+const subscriberBuffer = 64
+
+type subscriber struct {
+	filter models.EventFilter
+	ch     chan models.EmployeeEvent
+}
+
+type brokerImpl struct {
+	mu          sync.Mutex
+	lastID      uint64
+	retain      int
+	log         []models.EmployeeEvent
+	subscribers map[*subscriber]struct{}
+}
+
+// NewBroker returns a Broker keeping the given number of past events, or
+// DefaultRetainedEvents when it is not positive
+func NewBroker(retain int) Broker {
+	if retain <= 0 {
+		retain = DefaultRetainedEvents
+	}
+	return &brokerImpl{
+		retain:      retain,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (b *brokerImpl) Publish(events ...models.EmployeeEvent) []models.EmployeeEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	published := make([]models.EmployeeEvent, 0, len(events))
+	for _, event := range events {
+		if event.ID == 0 {
+			event.ID = b.lastID + 1
+		} else if event.ID <= b.lastID {
+			// Already published; the outbox relay delivers at least once
+			continue
+		}
+		b.lastID = event.ID
+		if event.OccurredAt.IsZero() {
+			event.OccurredAt = now
+		}
+		b.log = append(b.log, event)
+		published = append(published, event)
+
+		for sub := range b.subscribers {
+			if !sub.filter.Matches(event) {
+				continue
+			}
+			select {
+			case sub.ch <- event:
+			default:
+				// Never block publishers on a slow subscriber; it can
+				// resume from the retained log
+				b.remove(sub)
+			}
+		}
+	}
+	if len(b.log) > b.retain {
+		b.log = append([]models.EmployeeEvent(nil), b.log[len(b.log)-b.retain:]...)
+	}
+	return published
+}
+
+func (b *brokerImpl) Subscribe(filter models.EventFilter, after *uint64) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &subscriber{filter: filter, ch: make(chan models.EmployeeEvent, subscriberBuffer)}
+	b.subscribers[sub] = struct{}{}
+	subscription := &Subscription{
+		C: sub.ch,
+		close: func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			b.remove(sub)
+		},
+	}
+	if after == nil {
+		return subscription
+	}
+
+	// An ID ahead of the log comes from before a restart, so it is as stale
+	// as one that has fallen out of it
+	oldest := b.lastID + 1
+	if len(b.log) > 0 {
+		oldest = b.log[0].ID
+	}
+	if *after > b.lastID || *after+1 < oldest {
+		subscription.Missed = true
+	}
+	for _, event := range b.log {
+		if event.ID > *after && filter.Matches(event) {
+			subscription.Replay = append(subscription.Replay, event)
+		}
+	}
+	return subscription
+}
+
+// remove drops a subscriber and closes its channel; callers hold b.mu
+func (b *brokerImpl) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.ch)
+	}
+}
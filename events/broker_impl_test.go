@@ -0,0 +1,111 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/stretchr/testify/suite"
+)
+
+type BrokerTestSuite struct {
+	suite.Suite
+	broker Broker
+}
+
+func (suite *BrokerTestSuite) SetupTest() {
+	suite.broker = NewBroker(3)
+}
+
+func TestBrokerTestSuite(t *testing.T) {
+	suite.Run(t, new(BrokerTestSuite))
+}
+
+func event(eventType models.EmployeeEventType, id uint, departmentID *uint) models.EmployeeEvent {
+	return models.EmployeeEvent{Type: eventType, EmployeeID: id, Employee: models.Employee{ID: id, DepartmentID: departmentID}}
+}
+
+func ids(events []models.EmployeeEvent) []uint64 {
+	result := make([]uint64, len(events))
+	for i, event := range events {
+		result[i] = event.ID
+	}
+	return result
+}
+
+func (suite *BrokerTestSuite) TestPublishAssignsIDs() {
+	published := suite.broker.Publish(event(models.EventEmployeeCreated, 1, nil), event(models.EventEmployeeUpdated, 1, nil))
+	suite.Equal([]uint64{1, 2}, ids(published))
+	suite.False(published[0].OccurredAt.IsZero())
+}
+
+func (suite *BrokerTestSuite) TestPublishDropsRedeliveries() {
+	stored := event(models.EventEmployeeCreated, 1, nil)
+	stored.ID = 10
+	suite.Equal([]uint64{10}, ids(suite.broker.Publish(stored)))
+
+	subscription := suite.broker.Subscribe(models.EventFilter{}, nil)
+	defer subscription.Close()
+	next := event(models.EventEmployeeUpdated, 1, nil)
+	next.ID = 11
+	suite.Equal([]uint64{11}, ids(suite.broker.Publish(stored, next)))
+	suite.Equal(uint64(11), (<-subscription.C).ID)
+	suite.Empty(subscription.C)
+}
+
+func (suite *BrokerTestSuite) TestSubscribeFilters() {
+	engineering, design := uint(1), uint(2)
+	byDepartment := suite.broker.Subscribe(models.EventFilter{DepartmentID: &engineering}, nil)
+	defer byDepartment.Close()
+	byID := suite.broker.Subscribe(models.EventFilter{EmployeeIDs: []uint{5}}, nil)
+	defer byID.Close()
+
+	suite.broker.Publish(event(models.EventEmployeeCreated, 4, &design), event(models.EventEmployeeCreated, 5, &engineering))
+
+	received := <-byDepartment.C
+	suite.Equal(uint(5), received.EmployeeID)
+	received = <-byID.C
+	suite.Equal(uint(5), received.EmployeeID)
+	suite.Empty(byDepartment.C)
+	suite.Empty(byID.C)
+}
+
+func (suite *BrokerTestSuite) TestResumeFromRetainedLog() {
+	for id := uint(1); id <= 4; id++ {
+		suite.broker.Publish(event(models.EventEmployeeCreated, id, nil))
+	}
+
+	after := uint64(2)
+	subscription := suite.broker.Subscribe(models.EventFilter{}, &after)
+	defer subscription.Close()
+	suite.False(subscription.Missed)
+	suite.Equal([]uint64{3, 4}, ids(subscription.Replay))
+
+	// Only the last three events are retained, so event 1 is gone
+	after = 0
+	stale := suite.broker.Subscribe(models.EventFilter{}, &after)
+	defer stale.Close()
+	suite.True(stale.Missed)
+	suite.Equal([]uint64{2, 3, 4}, ids(stale.Replay))
+
+	// An ID from before a restart is ahead of the log
+	after = 10
+	restarted := suite.broker.Subscribe(models.EventFilter{}, &after)
+	defer restarted.Close()
+	suite.True(restarted.Missed)
+	suite.Empty(restarted.Replay)
+}
+
+func (suite *BrokerTestSuite) TestSlowSubscriberIsDropped() {
+	subscription := suite.broker.Subscribe(models.EventFilter{}, nil)
+	for i := 0; i <= subscriberBuffer; i++ {
+		suite.broker.Publish(event(models.EventEmployeeUpdated, 1, nil))
+	}
+
+	received := 0
+	for range subscription.C {
+		received++
+	}
+	suite.Equal(subscriberBuffer, received)
+	// Closing after the broker dropped it is harmless
+	subscription.Close()
+}
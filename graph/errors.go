@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// Error codes sent in the extensions of GraphQL errors, matching the HTTP
+// statuses the REST API returns for the same domain errors
+const (
+	CodeNotFound        = "NOT_FOUND"
+	CodeBadUserInput    = "BAD_USER_INPUT"
+	CodeConflict        = "CONFLICT"
+	CodeForbidden       = "FORBIDDEN"
+	CodeQueryTooComplex = "QUERY_TOO_COMPLEX"
+	CodeInternalError   = "INTERNAL_SERVER_ERROR"
+)
+
+// codedError is a resolver error with a code clients can branch on
+type codedError struct {
+	err  error
+	code string
+}
+
+func (e codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e codedError) Unwrap() error {
+	return e.err
+}
+
+// Extensions implements gqlerrors.ExtendedError
+func (e codedError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// resolverError attaches the code of a domain error to it
+func resolverError(err error) error {
+	code := CodeInternalError
+	switch {
+	case errors.Is(err, models.ErrEmployeeNotFound), errors.Is(err, models.ErrDepartmentNotFound),
+		errors.Is(err, models.ErrPositionNotFound):
+		code = CodeNotFound
+	case errors.Is(err, models.ErrValidation):
+		code = CodeBadUserInput
+	case errors.Is(err, models.ErrConflict), errors.Is(err, models.ErrInvalidTransition),
+		errors.Is(err, models.ErrRolledBack):
+		code = CodeConflict
+	case errors.Is(err, models.ErrForbidden):
+		code = CodeForbidden
+	}
+	return codedError{err: err, code: code}
+}
@@ -0,0 +1,15 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/graphql-go/graphql"
+)
+
+// Executor runs GraphQL requests against the employee schema
+type Executor interface {
+	// Execute runs one request. Invalid and rejected queries are reported in
+	// the result's errors, like errors raised while resolving fields.
+	Execute(ctx context.Context, request models.GraphQLRequest) *graphql.Result
+}
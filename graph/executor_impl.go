@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// executorImpl is the concrete implementation of Executor
+// (see executor.go for the interface definition)
+type executorImpl struct {
+	employeeService service.EmployeeService
+	employeeRepo    repo.EmployeeRepository
+	departmentRepo  repo.DepartmentRepository
+	schema          graphql.Schema
+	maxDepth        int
+	maxComplexity   int
+}
+
+// ExecutorOption configures optional Executor behaviour
+type ExecutorOption func(*executorImpl)
+
+// WithLimits sets the maximum depth and complexity of a query. Non-positive
+// values keep the defaults.
+func WithLimits(maxDepth, maxComplexity int) ExecutorOption {
+	return func(e *executorImpl) {
+		if maxDepth > 0 {
+			e.maxDepth = maxDepth
+		}
+		if maxComplexity > 0 {
+			e.maxComplexity = maxComplexity
+		}
+	}
+}
+
+// NewExecutor creates a new instance of Executor. Queries and mutations go
+// through the employee service; relations are loaded in batches straight
+// from the repositories.
+func NewExecutor(employeeService service.EmployeeService, employeeRepo repo.EmployeeRepository,
+	departmentRepo repo.DepartmentRepository, opts ...ExecutorOption) (Executor, error) {
+	e := &executorImpl{
+		employeeService: employeeService,
+		employeeRepo:    employeeRepo,
+		departmentRepo:  departmentRepo,
+		maxDepth:        DefaultMaxDepth,
+		maxComplexity:   DefaultMaxComplexity,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	schema, err := e.buildSchema()
+	if err != nil {
+		return nil, err
+	}
+	e.schema = schema
+	return e, nil
+}
+
+// Execute parses and validates the request, rejects it when it is too deep
+// or too complex, and runs it with fresh loaders
+func (e *executorImpl) Execute(ctx context.Context, request models.GraphQLRequest) *graphql.Result {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if validation := graphql.ValidateDocument(&e.schema, document, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+	if err := e.checkLimits(document, request); err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{*err}}
+	}
+
+	ctx = context.WithValue(ctx, loadersKey{}, newLoaders(ctx, e.employeeRepo, e.departmentRepo))
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        e.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       ctx,
+	})
+}
+
+// checkLimits measures the operation the request runs. A missing operation
+// is left for the executor to report.
+func (e *executorImpl) checkLimits(document *ast.Document, request models.GraphQLRequest) *gqlerrors.FormattedError {
+	var operation *ast.OperationDefinition
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.OperationDefinition:
+			if request.OperationName == "" || (definition.Name != nil && definition.Name.Value == request.OperationName) {
+				operation = definition
+			}
+		case *ast.FragmentDefinition:
+			fragments[definition.Name.Value] = definition
+		}
+	}
+	if operation == nil {
+		return nil
+	}
+
+	cost := measureOperation(operation, fragments, request.Variables)
+	var message string
+	switch {
+	case cost.depth > e.maxDepth:
+		message = fmt.Sprintf("query depth %d exceeds the limit of %d", cost.depth, e.maxDepth)
+	case cost.complexity > e.maxComplexity:
+		message = fmt.Sprintf("query complexity %d exceeds the limit of %d", cost.complexity, e.maxComplexity)
+	default:
+		return nil
+	}
+	err := gqlerrors.NewFormattedError(message)
+	err.Extensions = map[string]interface{}{"code": CodeQueryTooComplex}
+	return &err
+}
@@ -0,0 +1,181 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	repomocks "github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ExecutorTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	svc            *mocks.MockEmployeeService
+	employeeRepo   *repomocks.MockEmployeeRepository
+	departmentRepo *repomocks.MockDepartmentRepository
+	executor       Executor
+	ctx            context.Context
+}
+
+func (suite *ExecutorTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockEmployeeService(suite.ctrl)
+	suite.employeeRepo = repomocks.NewMockEmployeeRepository(suite.ctrl)
+	suite.departmentRepo = repomocks.NewMockDepartmentRepository(suite.ctrl)
+	executor, err := NewExecutor(suite.svc, suite.employeeRepo, suite.departmentRepo, WithLimits(5, 200))
+	suite.Require().NoError(err)
+	suite.executor = executor
+	suite.ctx = auth.NewContext(context.Background(), auth.Principal{Subject: "hr-lead", Roles: []string{auth.RoleHR}})
+}
+
+func (suite *ExecutorTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutorTestSuite))
+}
+
+// execute runs the query and returns the result as JSON
+func (suite *ExecutorTestSuite) execute(query string, variables map[string]interface{}) string {
+	result := suite.executor.Execute(suite.ctx, models.GraphQLRequest{Query: query, Variables: variables})
+	body, err := json.Marshal(result)
+	suite.Require().NoError(err)
+	return string(body)
+}
+
+func ptr(id uint) *uint {
+	return &id
+}
+
+func (suite *ExecutorTestSuite) TestEmployeesLoadRelationsInBatches() {
+	employees := []models.Employee{
+		{ID: 1, Name: "Alice", DepartmentID: ptr(10), ManagerID: ptr(3)},
+		{ID: 2, Name: "Bob", DepartmentID: ptr(10), ManagerID: ptr(3)},
+		{ID: 4, Name: "Dan", DepartmentID: ptr(11)},
+	}
+	filter := models.EmployeeFilter{Pagination: models.Pagination{Page: 1, PageSize: 2}, Status: "active"}
+	suite.svc.EXPECT().GetAllEmployees(gomock.Any(), filter).Return(employees, int64(3), nil)
+	// One query per relation, whatever the number of employees
+	suite.departmentRepo.EXPECT().FindByIDs(gomock.Any(), []uint{10, 11}).Return([]models.Department{{ID: 10, Name: "Eng"}, {ID: 11, Name: "Ops"}}, nil)
+	suite.employeeRepo.EXPECT().FindByIDs(gomock.Any(), []uint{3}).Return([]models.Employee{{ID: 3, Name: "Carol"}}, nil)
+	suite.employeeRepo.EXPECT().FindByManagerIDs(gomock.Any(), []uint{1, 2, 4}).Return([]models.Employee{{ID: 5, Name: "Eve", ManagerID: ptr(4)}}, nil)
+	suite.employeeRepo.EXPECT().FindSalaryHistory(gomock.Any(), []uint{1, 2, 4}).Return([]models.SalaryChange{
+		{EmployeeID: 1, Salary: 50000, Currency: "USD", ChangedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	body := suite.execute(`query ($size: Int) {
+		employees(pageSize: $size, status: ACTIVE) {
+			totalCount page pageSize
+			items { name department { name } manager { name } reports { name } salaryHistory { salary changedAt } }
+		}
+	}`, map[string]interface{}{"size": 2})
+	suite.JSONEq(`{"data":{"employees":{"totalCount":3,"page":1,"pageSize":2,"items":[
+		{"name":"Alice","department":{"name":"Eng"},"manager":{"name":"Carol"},"reports":[],"salaryHistory":[{"salary":50000,"changedAt":"2024-01-01T00:00:00Z"}]},
+		{"name":"Bob","department":{"name":"Eng"},"manager":{"name":"Carol"},"reports":[],"salaryHistory":[]},
+		{"name":"Dan","department":{"name":"Ops"},"manager":null,"reports":[{"name":"Eve"}],"salaryHistory":[]}
+	]}}}`, body)
+}
+
+func (suite *ExecutorTestSuite) TestEmployeeNotFoundIsNull() {
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(9), models.EmployeeView{}).Return(models.Employee{}, models.ErrEmployeeNotFound)
+
+	body := suite.execute(`{ employee(id: 9) { name } }`, nil)
+	suite.JSONEq(`{"data":{"employee":null}}`, body)
+}
+
+func (suite *ExecutorTestSuite) TestSalaryHistoryRequiresPermission() {
+	suite.ctx = auth.NewContext(context.Background(), auth.Principal{Subject: "dev1", Roles: []string{auth.RoleEmployee}})
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).Return(models.Employee{ID: 1, Name: "Alice", Status: models.StatusActive, Salary: 50000, Currency: "USD"}, nil).Times(4)
+
+	for _, field := range []string{"salaryHistory { salary }", "salary", "currency", "salaryOutOfBand"} {
+		body := suite.execute(`{ employee(id: 1) { name status `+field+` } }`, nil)
+		suite.Contains(body, `"code":"FORBIDDEN"`, field)
+		suite.Contains(body, `"data":{"employee":null}`, field)
+		suite.NotContains(body, "50000", field)
+	}
+}
+
+func (suite *ExecutorTestSuite) TestCreateEmployee() {
+	suite.svc.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.Equal("Carol", e.Name)
+		suite.Equal(uint(2), *e.DepartmentID)
+		suite.False(e.JoinDate.IsZero(), "join date defaults to now")
+		suite.Equal(&models.SalaryOverride{Justification: "Market rate", ApprovedBy: "hr-lead"}, e.SalaryOverride)
+		e.ID = 7
+		return e, nil
+	})
+
+	body := suite.execute(`mutation {
+		createEmployee(
+			input: {name: "Carol", email: "carol@example.com", position: "Dev", salary: 90000, departmentId: 2}
+			salaryOverride: {justification: "Market rate"}
+		) { id name }
+	}`, nil)
+	suite.JSONEq(`{"data":{"createEmployee":{"id":7,"name":"Carol"}}}`, body)
+}
+
+func (suite *ExecutorTestSuite) TestCreateEmployeeInvalid() {
+	body := suite.execute(`mutation { createEmployee(input: {name: "Carol", email: "nope", position: "Dev", salary: 1}) { id } }`, nil)
+	suite.Contains(body, `"code":"BAD_USER_INPUT"`)
+}
+
+func (suite *ExecutorTestSuite) TestUpdateAndDeleteEmployeeErrors() {
+	suite.svc.EXPECT().Update(gomock.Any(), uint(3), gomock.Any()).Return(models.Employee{}, fmt.Errorf("%w: manager cycle", models.ErrConflict))
+	body := suite.execute(`mutation { updateEmployee(id: 3, input: {name: "Dan", email: "dan@example.com", position: "QA", salary: 1, managerId: 4}) { id } }`, nil)
+	suite.Contains(body, `"code":"CONFLICT"`)
+	suite.Contains(body, "manager cycle")
+
+	suite.svc.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+	suite.JSONEq(`{"data":{"deleteEmployee":true}}`, suite.execute(`mutation { deleteEmployee(id: 5) }`, nil))
+}
+
+func (suite *ExecutorTestSuite) TestRejectsDeepQueries() {
+	body := suite.execute(`{ employee(id: 1) { manager { manager { manager { manager { name } } } } } }`, nil)
+	suite.Contains(body, "query depth 6 exceeds the limit of 5")
+	suite.Contains(body, `"code":"QUERY_TOO_COMPLEX"`)
+}
+
+func (suite *ExecutorTestSuite) TestRejectsComplexQueries() {
+	// employees costs 1 + 100 * (items 1 + name 1 + reports (1 + 10 * name 1)) = 1301,
+	// counting the fields spread from the fragment
+	body := suite.execute(`
+		query { employees(pageSize: 500) { items { ...Person } } }
+		fragment Person on Employee { name reports { name } }`, nil)
+	suite.Contains(body, "query complexity 1301 exceeds the limit of 200")
+
+	// Introspection is not counted
+	result := suite.executor.Execute(suite.ctx, models.GraphQLRequest{Query: `{ __schema { types { name fields { name type { name ofType { name ofType { name } } } } } } }`})
+	suite.Empty(result.Errors)
+}
+
+func (suite *ExecutorTestSuite) TestReportsInvalidQueries() {
+	result := suite.executor.Execute(suite.ctx, models.GraphQLRequest{Query: `{ employee(id: 1) { nickname } }`})
+	suite.Require().Len(result.Errors, 1)
+	suite.Contains(result.Errors[0].Message, `Cannot query field "nickname"`)
+
+	result = suite.executor.Execute(suite.ctx, models.GraphQLRequest{Query: `{ employee(`})
+	suite.NotEmpty(result.Errors)
+}
+
+func (suite *ExecutorTestSuite) TestLoaderSharesFailures() {
+	calls := 0
+	l := newLoader(func(keys []uint) (map[uint]string, error) {
+		calls++
+		return nil, models.ErrConflict
+	})
+	first, second := l.Load(1), l.Load(2)
+	_, err := first()
+	suite.ErrorIs(err, models.ErrConflict)
+	_, err = second()
+	suite.ErrorIs(err, models.ErrConflict)
+	suite.Equal(1, calls)
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Default query limits
+const (
+	DefaultMaxDepth      = 8
+	DefaultMaxComplexity = 1000
+)
+
+// listEstimate is the number of items assumed for lists whose length is not
+// set by an argument, such as an employee's reports
+const listEstimate = 10
+
+// queryCost is the depth and complexity of an operation
+type queryCost struct {
+	depth      int
+	complexity int
+}
+
+// measureOperation computes the depth and complexity of an operation. Every
+// field costs one, and the selections of a list field are counted once per
+// expected item: the page size for employees and listEstimate for other
+// lists. Introspection fields are free, so tools can load the schema.
+func measureOperation(operation *ast.OperationDefinition, fragments map[string]*ast.FragmentDefinition, variables map[string]interface{}) queryCost {
+	m := measurer{fragments: fragments, variables: variables}
+	return m.selectionSet(operation.SelectionSet)
+}
+
+type measurer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+func (m measurer) selectionSet(set *ast.SelectionSet) queryCost {
+	var cost queryCost
+	if set == nil {
+		return cost
+	}
+	for _, selection := range set.Selections {
+		var child queryCost
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+			inner := m.selectionSet(selection.SelectionSet)
+			child = queryCost{
+				depth:      inner.depth + 1,
+				complexity: 1 + m.listSize(selection)*inner.complexity,
+			}
+		case *ast.InlineFragment:
+			child = m.selectionSet(selection.SelectionSet)
+		case *ast.FragmentSpread:
+			// Fragment cycles are rejected by validation before the operation is measured
+			if fragment, ok := m.fragments[selection.Name.Value]; ok {
+				child = m.selectionSet(fragment.SelectionSet)
+			}
+		}
+		cost.complexity += child.complexity
+		if child.depth > cost.depth {
+			cost.depth = child.depth
+		}
+	}
+	return cost
+}
+
+// listSize is the number of items a field is expected to return
+func (m measurer) listSize(field *ast.Field) int {
+	switch field.Name.Value {
+	case "employees":
+		p := models.Pagination{PageSize: m.intArgument(field, "pageSize")}
+		p.Normalize()
+		return p.PageSize
+	case "reports", "salaryHistory":
+		return listEstimate
+	default:
+		return 1
+	}
+}
+
+// intArgument returns the value of an Int argument given as a literal or a
+// variable, or 0 when it is not set
+func (m measurer) intArgument(field *ast.Field, name string) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != name {
+			continue
+		}
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			n, _ := strconv.Atoi(value.Value)
+			return n
+		case *ast.Variable:
+			switch n := m.variables[value.Name.Value].(type) {
+			case int:
+				return n
+			case float64:
+				return int(n)
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,152 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// loader batches lookups by key within one request. Load queues a key and
+// returns a thunk; the executor resolves thunks only after every field at the
+// same depth has been resolved, so the first thunk called fetches all queued
+// keys in one call and the rest are answered from the cache.
+type loader[K comparable, V any] struct {
+	fetch func(keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	queued  []K
+	results map[K]V
+	errs    map[K]error
+}
+
+func newLoader[K comparable, V any](fetch func(keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{fetch: fetch, results: make(map[K]V), errs: make(map[K]error)}
+}
+
+// Load returns a thunk resolving to the value for key, or to nil when the
+// fetch found none
+func (l *loader[K, V]) Load(key K) func() (interface{}, error) {
+	l.mu.Lock()
+	if _, done := l.results[key]; !done {
+		l.queued = append(l.queued, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.flush()
+		if err := l.errs[key]; err != nil {
+			return nil, err
+		}
+		value, ok := l.results[key]
+		if !ok {
+			return nil, nil
+		}
+		return value, nil
+	}
+}
+
+// flush fetches the queued keys that have no result yet. The caller holds mu.
+func (l *loader[K, V]) flush() {
+	if len(l.queued) == 0 {
+		return
+	}
+	seen := make(map[K]bool, len(l.queued))
+	var keys []K
+	for _, key := range l.queued {
+		if _, done := l.results[key]; !done && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	l.queued = nil
+	if len(keys) == 0 {
+		return
+	}
+
+	found, err := l.fetch(keys)
+	for _, key := range keys {
+		if err != nil {
+			l.errs[key] = err
+			continue
+		}
+		if value, ok := found[key]; ok {
+			l.results[key] = value
+		}
+	}
+}
+
+// loaders holds the batching loaders of one request
+type loaders struct {
+	employees     *loader[uint, models.Employee]
+	departments   *loader[uint, models.Department]
+	reports       *loader[uint, []models.Employee]
+	salaryHistory *loader[uint, []models.SalaryChange]
+}
+
+type loadersKey struct{}
+
+// newLoaders creates the loaders for one request, querying on behalf of its context
+func newLoaders(ctx context.Context, employeeRepo repo.EmployeeRepository, departmentRepo repo.DepartmentRepository) *loaders {
+	return &loaders{
+		employees: newLoader(func(ids []uint) (map[uint]models.Employee, error) {
+			employees, err := employeeRepo.FindByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uint]models.Employee, len(employees))
+			for _, employee := range employees {
+				byID[employee.ID] = employee
+			}
+			return byID, nil
+		}),
+		departments: newLoader(func(ids []uint) (map[uint]models.Department, error) {
+			departments, err := departmentRepo.FindByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uint]models.Department, len(departments))
+			for _, department := range departments {
+				byID[department.ID] = department
+			}
+			return byID, nil
+		}),
+		reports: newLoader(func(managerIDs []uint) (map[uint][]models.Employee, error) {
+			employees, err := employeeRepo.FindByManagerIDs(ctx, managerIDs)
+			if err != nil {
+				return nil, err
+			}
+			// Every manager gets an entry, so those without reports resolve to an empty list
+			byManager := make(map[uint][]models.Employee, len(managerIDs))
+			for _, id := range managerIDs {
+				byManager[id] = []models.Employee{}
+			}
+			for _, employee := range employees {
+				byManager[*employee.ManagerID] = append(byManager[*employee.ManagerID], employee)
+			}
+			return byManager, nil
+		}),
+		salaryHistory: newLoader(func(employeeIDs []uint) (map[uint][]models.SalaryChange, error) {
+			changes, err := employeeRepo.FindSalaryHistory(ctx, employeeIDs)
+			if err != nil {
+				return nil, err
+			}
+			byEmployee := make(map[uint][]models.SalaryChange, len(employeeIDs))
+			for _, id := range employeeIDs {
+				byEmployee[id] = []models.SalaryChange{}
+			}
+			for _, change := range changes {
+				byEmployee[change.EmployeeID] = append(byEmployee[change.EmployeeID], change)
+			}
+			return byEmployee, nil
+		}),
+	}
+}
+
+// loadersFrom returns the loaders of the request being executed
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
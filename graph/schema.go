@@ -0,0 +1,385 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/graphql-go/graphql"
+)
+
+// employeePage is the source of the EmployeePage type
+type employeePage struct {
+	items      []models.Employee
+	total      int64
+	pagination models.Pagination
+}
+
+// buildSchema defines the GraphQL types and wires their resolvers to the
+// service and the loaders
+func (e *executorImpl) buildSchema() (graphql.Schema, error) {
+	statusEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "EmployeeStatus",
+		Description: "A stage in the employee lifecycle",
+		Values: graphql.EnumValueConfigMap{
+			"ONBOARDING": &graphql.EnumValueConfig{Value: models.StatusOnboarding},
+			"ACTIVE":     &graphql.EnumValueConfig{Value: models.StatusActive},
+			"ON_LEAVE":   &graphql.EnumValueConfig{Value: models.StatusOnLeave},
+			"TERMINATED": &graphql.EnumValueConfig{Value: models.StatusTerminated},
+		},
+	})
+
+	departmentType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Department",
+		Fields: graphql.Fields{
+			"id":          departmentField(graphql.NewNonNull(graphql.Int), func(d models.Department) interface{} { return d.ID }),
+			"name":        departmentField(graphql.NewNonNull(graphql.String), func(d models.Department) interface{} { return d.Name }),
+			"description": departmentField(graphql.NewNonNull(graphql.String), func(d models.Department) interface{} { return d.Description }),
+			"createdAt":   departmentField(graphql.NewNonNull(graphql.DateTime), func(d models.Department) interface{} { return d.CreatedAt }),
+			"updatedAt":   departmentField(graphql.NewNonNull(graphql.DateTime), func(d models.Department) interface{} { return d.UpdatedAt }),
+		},
+	})
+
+	salaryChangeType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "SalaryChange",
+		Description: "A salary an employee was given and when",
+		Fields: graphql.Fields{
+			"salary":    salaryChangeField(graphql.NewNonNull(graphql.Float), func(c models.SalaryChange) interface{} { return c.Salary }),
+			"currency":  salaryChangeField(graphql.NewNonNull(graphql.String), func(c models.SalaryChange) interface{} { return c.Currency }),
+			"changedAt": salaryChangeField(graphql.NewNonNull(graphql.DateTime), func(c models.SalaryChange) interface{} { return c.ChangedAt }),
+		},
+	})
+
+	var employeeType *graphql.Object
+	employeeType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Employee",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":                employeeField(graphql.NewNonNull(graphql.Int), func(e models.Employee) interface{} { return e.ID }),
+				"name":              employeeField(graphql.NewNonNull(graphql.String), func(e models.Employee) interface{} { return e.Name }),
+				"email":             employeeField(graphql.NewNonNull(graphql.String), func(e models.Employee) interface{} { return e.Email }),
+				"position":          employeeField(graphql.NewNonNull(graphql.String), func(e models.Employee) interface{} { return e.Position }),
+				"positionId":        employeeField(graphql.Int, func(e models.Employee) interface{} { return optionalID(e.PositionID) }),
+				"salary":            salaryField("salary", graphql.NewNonNull(graphql.Float), func(e models.Employee) interface{} { return e.Salary }),
+				"currency":          salaryField("currency", graphql.NewNonNull(graphql.String), func(e models.Employee) interface{} { return e.Currency }),
+				"salaryOutOfBand":   salaryField("salaryOutOfBand", graphql.NewNonNull(graphql.Boolean), func(e models.Employee) interface{} { return e.SalaryOutOfBand }),
+				"departmentId":      employeeField(graphql.Int, func(e models.Employee) interface{} { return optionalID(e.DepartmentID) }),
+				"managerId":         employeeField(graphql.Int, func(e models.Employee) interface{} { return optionalID(e.ManagerID) }),
+				"status":            employeeField(graphql.NewNonNull(statusEnum), func(e models.Employee) interface{} { return e.Status }),
+				"terminationDate":   employeeField(graphql.DateTime, func(e models.Employee) interface{} { return e.TerminationDate }),
+				"terminationReason": employeeField(graphql.NewNonNull(graphql.String), func(e models.Employee) interface{} { return e.TerminationReason }),
+				"joinDate":          employeeField(graphql.NewNonNull(graphql.DateTime), func(e models.Employee) interface{} { return e.JoinDate }),
+				"createdAt":         employeeField(graphql.NewNonNull(graphql.DateTime), func(e models.Employee) interface{} { return e.CreatedAt }),
+				"updatedAt":         employeeField(graphql.NewNonNull(graphql.DateTime), func(e models.Employee) interface{} { return e.UpdatedAt }),
+				"department": {
+					Type: departmentType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						employee := p.Source.(models.Employee)
+						if employee.DepartmentID == nil {
+							return nil, nil
+						}
+						return loadersFrom(p.Context).departments.Load(*employee.DepartmentID), nil
+					},
+				},
+				"manager": {
+					Type: employeeType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						employee := p.Source.(models.Employee)
+						if employee.ManagerID == nil {
+							return nil, nil
+						}
+						return loadersFrom(p.Context).employees.Load(*employee.ManagerID), nil
+					},
+				},
+				"reports": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(employeeType))),
+					Description: "The employees reporting directly to this employee",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadersFrom(p.Context).reports.Load(p.Source.(models.Employee).ID), nil
+					},
+				},
+				"salaryHistory": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(salaryChangeType))),
+					Description: "The employee's salaries, oldest first. Requires the salary:read permission.",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if err := authorizeSalaryRead(p.Context, "salary history"); err != nil {
+							return nil, err
+						}
+						return loadersFrom(p.Context).salaryHistory.Load(p.Source.(models.Employee).ID), nil
+					},
+				},
+			}
+		}),
+	})
+
+	employeePageType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "EmployeePage",
+		Description: "One page of the employees matching a query",
+		Fields: graphql.Fields{
+			"items": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(employeeType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(employeePage).items, nil
+				},
+			},
+			"totalCount": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(employeePage).total, nil
+				},
+			},
+			"page": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(employeePage).pagination.Page, nil
+				},
+			},
+			"pageSize": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(employeePage).pagination.PageSize, nil
+				},
+			},
+		},
+	})
+
+	employeeInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "EmployeeInput",
+		Description: "The editable fields of an employee, validated as in the REST API",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"email":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"position":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"positionId":   &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"salary":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+			"currency":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"departmentId": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"managerId":    &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"joinDate":     &graphql.InputObjectFieldConfig{Type: graphql.DateTime, Description: "Defaults to now on create"},
+		},
+	})
+
+	salaryOverrideInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "SalaryOverrideInput",
+		Description: "Accepts a salary outside the position's band. Requires the salary:override permission.",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"justification": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"employee": &graphql.Field{
+				Type:        employeeType,
+				Description: "An employee by ID, or null when there is none",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					employee, err := e.employeeService.GetEmployeeByID(p.Context, uint(p.Args["id"].(int)), models.EmployeeView{})
+					if errors.Is(err, models.ErrEmployeeNotFound) {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, resolverError(err)
+					}
+					return employee, nil
+				},
+			},
+			"employees": &graphql.Field{
+				Type:        graphql.NewNonNull(employeePageType),
+				Description: "A page of employees, optionally filtered",
+				Args: graphql.FieldConfigArgument{
+					"page":         &graphql.ArgumentConfig{Type: graphql.Int, Description: "Page number (default 1)"},
+					"pageSize":     &graphql.ArgumentConfig{Type: graphql.Int, Description: "Page size (default 20, max 100)"},
+					"name":         &graphql.ArgumentConfig{Type: graphql.String, Description: "Name substring"},
+					"email":        &graphql.ArgumentConfig{Type: graphql.String},
+					"position":     &graphql.ArgumentConfig{Type: graphql.String},
+					"departmentId": &graphql.ArgumentConfig{Type: graphql.Int},
+					"managerId":    &graphql.ArgumentConfig{Type: graphql.Int},
+					"status":       &graphql.ArgumentConfig{Type: statusEnum},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					filter := models.EmployeeFilter{
+						Pagination:   models.Pagination{Page: intArg(p.Args, "page"), PageSize: intArg(p.Args, "pageSize")},
+						Name:         stringArg(p.Args, "name"),
+						Email:        stringArg(p.Args, "email"),
+						Position:     stringArg(p.Args, "position"),
+						DepartmentID: idArg(p.Args, "departmentId"),
+						ManagerID:    idArg(p.Args, "managerId"),
+					}
+					if status, ok := p.Args["status"].(models.EmployeeStatus); ok {
+						filter.Status = string(status)
+					}
+					filter.Normalize()
+
+					employees, total, err := e.employeeService.GetAllEmployees(p.Context, filter)
+					if err != nil {
+						return nil, resolverError(err)
+					}
+					return employeePage{items: employees, total: total, pagination: filter.Pagination}, nil
+				},
+			},
+		},
+	})
+
+	mutationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(employeeType),
+				Args: graphql.FieldConfigArgument{
+					"input":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(employeeInput)},
+					"salaryOverride": &graphql.ArgumentConfig{Type: salaryOverrideInput},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					employee, err := bindEmployee(p)
+					if err != nil {
+						return nil, err
+					}
+					if employee.JoinDate.IsZero() {
+						employee.JoinDate = time.Now()
+					}
+					created, err := e.employeeService.Create(p.Context, employee)
+					if err != nil {
+						return nil, resolverError(err)
+					}
+					return created, nil
+				},
+			},
+			"updateEmployee": &graphql.Field{
+				Type:        graphql.NewNonNull(employeeType),
+				Description: "Replaces an employee's editable fields. Status changes go through the REST lifecycle endpoints.",
+				Args: graphql.FieldConfigArgument{
+					"id":             &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"input":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(employeeInput)},
+					"salaryOverride": &graphql.ArgumentConfig{Type: salaryOverrideInput},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					employee, err := bindEmployee(p)
+					if err != nil {
+						return nil, err
+					}
+					updated, err := e.employeeService.Update(p.Context, uint(p.Args["id"].(int)), employee)
+					if err != nil {
+						return nil, resolverError(err)
+					}
+					return updated, nil
+				},
+			},
+			"deleteEmployee": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Boolean),
+				Description: "Deletes an employee, handing their reports to their manager",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if err := e.employeeService.Delete(p.Context, uint(p.Args["id"].(int))); err != nil {
+						return nil, resolverError(err)
+					}
+					return true, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType, Mutation: mutationType})
+}
+
+// bindEmployee reads the input and salaryOverride arguments of a mutation,
+// validates them like a REST request body and authorizes any override
+func bindEmployee(p graphql.ResolveParams) (models.Employee, error) {
+	input := p.Args["input"].(map[string]interface{})
+	employee := models.Employee{
+		Name:         stringArg(input, "name"),
+		Email:        stringArg(input, "email"),
+		Position:     stringArg(input, "position"),
+		PositionID:   idArg(input, "positionId"),
+		Currency:     stringArg(input, "currency"),
+		DepartmentID: idArg(input, "departmentId"),
+		ManagerID:    idArg(input, "managerId"),
+	}
+	employee.Salary, _ = input["salary"].(float64)
+	if joinDate, ok := input["joinDate"].(time.Time); ok {
+		employee.JoinDate = joinDate
+	}
+	if override, ok := p.Args["salaryOverride"].(map[string]interface{}); ok {
+		employee.SalaryOverride = &models.SalaryOverride{Justification: stringArg(override, "justification")}
+	}
+
+	if err := binding.Validator.ValidateStruct(&employee); err != nil {
+		return models.Employee{}, resolverError(fmt.Errorf("%w: %s", models.ErrValidation, err.Error()))
+	}
+	if err := auth.PrincipalFromContext(p.Context).AuthorizeSalaryOverride(employee.SalaryOverride); err != nil {
+		return models.Employee{}, resolverError(err)
+	}
+	return employee, nil
+}
+
+func employeeField(t graphql.Output, get func(models.Employee) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(models.Employee)), nil
+	}}
+}
+
+// salaryField is an employeeField that requires the salary:read permission
+func salaryField(name string, t graphql.Output, get func(models.Employee) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Description: "Requires the salary:read permission.", Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		if err := authorizeSalaryRead(p.Context, name); err != nil {
+			return nil, err
+		}
+		return get(p.Source.(models.Employee)), nil
+	}}
+}
+
+// authorizeSalaryRead fails unless the caller may read salaries
+func authorizeSalaryRead(ctx context.Context, what string) error {
+	if !auth.PrincipalFromContext(ctx).Can(auth.PermSalaryRead) {
+		return resolverError(fmt.Errorf("%w: %s requires the %s permission", models.ErrForbidden, what, auth.PermSalaryRead))
+	}
+	return nil
+}
+
+func departmentField(t graphql.Output, get func(models.Department) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(models.Department)), nil
+	}}
+}
+
+func salaryChangeField(t graphql.Output, get func(models.SalaryChange) interface{}) *graphql.Field {
+	return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(models.SalaryChange)), nil
+	}}
+}
+
+// optionalID returns the ID, or nil so that a missing ID resolves to null
+func optionalID(id *uint) interface{} {
+	if id == nil {
+		return nil
+	}
+	return *id
+}
+
+func intArg(args map[string]interface{}, name string) int {
+	n, _ := args[name].(int)
+	return n
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+func idArg(args map[string]interface{}, name string) *uint {
+	n, ok := args[name].(int)
+	if !ok {
+		return nil
+	}
+	id := uint(n)
+	return &id
+}
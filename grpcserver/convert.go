@@ -0,0 +1,93 @@
+package grpcserver
+
+import (
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	employeev1 "github.com/chinmay-sawant/gin-example/proto/employee/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoEmployee converts a stored employee to its message
+func toProtoEmployee(e models.Employee) *employeev1.Employee {
+	return &employeev1.Employee{
+		Id:                uint32(e.ID),
+		Name:              e.Name,
+		Email:             e.Email,
+		Position:          e.Position,
+		PositionId:        toProtoID(e.PositionID),
+		Salary:            e.Salary,
+		Currency:          e.Currency,
+		SalaryOutOfBand:   e.SalaryOutOfBand,
+		DepartmentId:      toProtoID(e.DepartmentID),
+		ManagerId:         toProtoID(e.ManagerID),
+		Status:            string(e.Status),
+		TerminationDate:   toProtoTime(e.TerminationDate),
+		TerminationReason: e.TerminationReason,
+		JoinDate:          toProtoTime(&e.JoinDate),
+		CreatedAt:         toProtoTime(&e.CreatedAt),
+		UpdatedAt:         toProtoTime(&e.UpdatedAt),
+	}
+}
+
+// fromProtoEmployee converts the editable fields of a message to an
+// employee. IDs, timestamps and the status are managed by the service.
+func fromProtoEmployee(e *employeev1.Employee, override *employeev1.SalaryOverride) models.Employee {
+	employee := models.Employee{
+		Name:         e.GetName(),
+		Email:        e.GetEmail(),
+		Position:     e.GetPosition(),
+		PositionID:   fromProtoID(e.PositionId),
+		Salary:       e.GetSalary(),
+		Currency:     e.GetCurrency(),
+		DepartmentID: fromProtoID(e.DepartmentId),
+		ManagerID:    fromProtoID(e.ManagerId),
+		Status:       models.EmployeeStatus(e.GetStatus()),
+	}
+	if e.GetJoinDate() != nil {
+		employee.JoinDate = e.GetJoinDate().AsTime()
+	}
+	if override != nil {
+		employee.SalaryOverride = &models.SalaryOverride{Justification: override.GetJustification()}
+	}
+	return employee
+}
+
+// toProtoEvent converts an employee change to its message
+func toProtoEvent(event models.EmployeeEvent) *employeev1.EmployeeEvent {
+	message := &employeev1.EmployeeEvent{
+		Id:         event.ID,
+		Type:       string(event.Type),
+		EmployeeId: uint32(event.EmployeeID),
+		Employee:   toProtoEmployee(event.Employee),
+		OccurredAt: toProtoTime(&event.OccurredAt),
+	}
+	if event.Previous != nil {
+		message.Previous = toProtoEmployee(*event.Previous)
+	}
+	return message
+}
+
+func toProtoID(id *uint) *uint32 {
+	if id == nil {
+		return nil
+	}
+	value := uint32(*id)
+	return &value
+}
+
+func fromProtoID(id *uint32) *uint {
+	if id == nil {
+		return nil
+	}
+	value := uint(*id)
+	return &value
+}
+
+// toProtoTime leaves unset times unset instead of sending the zero time
+func toProtoTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,169 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	employeev1 "github.com/chinmay-sawant/gin-example/proto/employee/v1"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// employeeServer implements the generated employeev1.EmployeeServiceServer
+// interface by delegating to the same EmployeeService as the REST API
+type employeeServer struct {
+	employeev1.UnimplementedEmployeeServiceServer
+	employeeService service.EmployeeService
+}
+
+// NewEmployeeServer creates a new gRPC EmployeeService backed by the employee service
+func NewEmployeeServer(employeeService service.EmployeeService) employeev1.EmployeeServiceServer {
+	return &employeeServer{
+		employeeService: employeeService,
+	}
+}
+
+// GetEmployee returns an employee by ID
+func (s *employeeServer) GetEmployee(ctx context.Context, req *employeev1.GetEmployeeRequest) (*employeev1.Employee, error) {
+	employee, err := s.employeeService.GetEmployeeByID(ctx, uint(req.GetId()), models.EmployeeView{})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProtoEmployee(employee), nil
+}
+
+// ListEmployees returns one page of the employees matching the filters
+func (s *employeeServer) ListEmployees(ctx context.Context, req *employeev1.ListEmployeesRequest) (*employeev1.ListEmployeesResponse, error) {
+	filter := models.EmployeeFilter{
+		Pagination:   models.Pagination{Page: int(req.GetPage()), PageSize: int(req.GetPageSize())},
+		Name:         req.GetName(),
+		Email:        req.GetEmail(),
+		Position:     req.GetPosition(),
+		DepartmentID: fromProtoID(req.DepartmentId),
+		ManagerID:    fromProtoID(req.ManagerId),
+		Status:       req.GetStatus(),
+	}
+	filter.Normalize()
+
+	employees, total, err := s.employeeService.GetAllEmployees(ctx, filter)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	response := &employeev1.ListEmployeesResponse{
+		Employees:  make([]*employeev1.Employee, len(employees)),
+		TotalCount: total,
+		Page:       int32(filter.Page),
+		PageSize:   int32(filter.PageSize),
+	}
+	for i := range employees {
+		response.Employees[i] = toProtoEmployee(employees[i])
+	}
+	return response, nil
+}
+
+// CreateEmployee creates an employee, defaulting the join date to now
+func (s *employeeServer) CreateEmployee(ctx context.Context, req *employeev1.CreateEmployeeRequest) (*employeev1.Employee, error) {
+	employee, err := bindEmployee(ctx, req.GetEmployee(), req.GetSalaryOverride())
+	if err != nil {
+		return nil, err
+	}
+	if employee.JoinDate.IsZero() {
+		employee.JoinDate = time.Now()
+	}
+
+	created, err := s.employeeService.Create(ctx, employee)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProtoEmployee(created), nil
+}
+
+// UpdateEmployee replaces an employee's editable fields
+func (s *employeeServer) UpdateEmployee(ctx context.Context, req *employeev1.UpdateEmployeeRequest) (*employeev1.Employee, error) {
+	employee, err := bindEmployee(ctx, req.GetEmployee(), req.GetSalaryOverride())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.employeeService.Update(ctx, uint(req.GetId()), employee)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProtoEmployee(updated), nil
+}
+
+// DeleteEmployee deletes an employee
+func (s *employeeServer) DeleteEmployee(ctx context.Context, req *employeev1.DeleteEmployeeRequest) (*emptypb.Empty, error) {
+	if err := s.employeeService.Delete(ctx, uint(req.GetId())); err != nil {
+		return nil, statusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// WatchEmployees streams employee changes until the client cancels. A reset
+// is sent first when the changes after last_event_id are no longer retained.
+// A client that falls too far behind gets UNAVAILABLE and should call again
+// with the last event ID it handled.
+func (s *employeeServer) WatchEmployees(req *employeev1.WatchEmployeesRequest, stream grpc.ServerStreamingServer[employeev1.WatchEmployeesResponse]) error {
+	filter := models.EventFilter{DepartmentID: fromProtoID(req.DepartmentId)}
+	for _, id := range req.GetEmployeeIds() {
+		filter.EmployeeIDs = append(filter.EmployeeIDs, uint(id))
+	}
+
+	subscription, err := s.employeeService.SubscribeEvents(stream.Context(), filter, req.LastEventId)
+	if err != nil {
+		return statusError(err)
+	}
+	defer subscription.Close()
+
+	if subscription.Missed {
+		reset := &employeev1.StreamReset{LastEventId: req.GetLastEventId()}
+		if err := stream.Send(&employeev1.WatchEmployeesResponse{Message: &employeev1.WatchEmployeesResponse_StreamReset{StreamReset: reset}}); err != nil {
+			return err
+		}
+	}
+	for _, event := range subscription.Replay {
+		if err := sendEvent(stream, event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-subscription.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscriber fell behind, resume from the last event ID")
+			}
+			if err := sendEvent(stream, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// bindEmployee converts and validates an employee message the way the REST
+// API binds a request body, and authorizes any salary override
+func bindEmployee(ctx context.Context, message *employeev1.Employee, override *employeev1.SalaryOverride) (models.Employee, error) {
+	if message == nil {
+		return models.Employee{}, status.Error(codes.InvalidArgument, "employee is required")
+	}
+	employee := fromProtoEmployee(message, override)
+	if err := binding.Validator.ValidateStruct(&employee); err != nil {
+		return models.Employee{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := auth.PrincipalFromContext(ctx).AuthorizeSalaryOverride(employee.SalaryOverride); err != nil {
+		return models.Employee{}, statusError(err)
+	}
+	return employee, nil
+}
+
+func sendEvent(stream grpc.ServerStreamingServer[employeev1.WatchEmployeesResponse], event models.EmployeeEvent) error {
+	return stream.Send(&employeev1.WatchEmployeesResponse{Message: &employeev1.WatchEmployeesResponse_Event{Event: toProtoEvent(event)}})
+}
@@ -0,0 +1,299 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	employeev1 "github.com/chinmay-sawant/gin-example/proto/employee/v1"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type EmployeeServerTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	svc    *mocks.MockEmployeeService
+	secret []byte
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	client employeev1.EmployeeServiceClient
+}
+
+func (suite *EmployeeServerTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockEmployeeService(suite.ctrl)
+	suite.secret = []byte("test-secret")
+
+	listener := bufconn.Listen(1 << 20)
+	suite.server = NewServer(suite.svc, suite.secret, func(_ context.Context, id string) (models.Tenant, error) {
+		if id != tenant.Default && id != "acme" {
+			return models.Tenant{}, models.ErrTenantNotFound
+		}
+		return models.Tenant{ID: id}, nil
+	})
+	go suite.server.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	suite.Require().NoError(err)
+	suite.conn = conn
+	suite.client = employeev1.NewEmployeeServiceClient(conn)
+}
+
+func (suite *EmployeeServerTestSuite) TearDownTest() {
+	suite.conn.Close()
+	suite.server.Stop()
+	suite.ctrl.Finish()
+}
+
+func TestEmployeeServerTestSuite(t *testing.T) {
+	suite.Run(t, new(EmployeeServerTestSuite))
+}
+
+// as returns a context authenticated with a token for the subject and roles
+func (suite *EmployeeServerTestSuite) as(subject string, roles ...string) context.Context {
+	return suite.asTenant("", subject, roles...)
+}
+
+// asTenant is as with a token bound to the tenant
+func (suite *EmployeeServerTestSuite) asTenant(tenantID, subject string, roles ...string) context.Context {
+	claims := auth.Claims{Roles: roles, Tenant: tenantID, RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(suite.secret)
+	suite.Require().NoError(err)
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+signed)
+}
+
+func (suite *EmployeeServerTestSuite) TestGetEmployee() {
+	departmentID := uint(2)
+	joined := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).Return(models.Employee{
+		ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000,
+		DepartmentID: &departmentID, Status: models.StatusActive, JoinDate: joined,
+	}, nil)
+
+	employee, err := suite.client.GetEmployee(context.Background(), &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Equal("Alice", employee.GetName())
+	suite.Equal(uint32(2), employee.GetDepartmentId())
+	suite.Nil(employee.ManagerId)
+	suite.Equal("active", employee.GetStatus())
+	suite.Equal(joined, employee.GetJoinDate().AsTime())
+	suite.Nil(employee.GetTerminationDate())
+}
+
+func (suite *EmployeeServerTestSuite) TestGetEmployeeNotFound() {
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(9), models.EmployeeView{}).Return(models.Employee{}, models.ErrEmployeeNotFound)
+
+	_, err := suite.client.GetEmployee(context.Background(), &employeev1.GetEmployeeRequest{Id: 9})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *EmployeeServerTestSuite) TestListEmployees() {
+	departmentID := uint(3)
+	filter := models.EmployeeFilter{
+		Pagination:   models.Pagination{Page: 2, PageSize: models.MaxPageSize},
+		Name:         "ali",
+		DepartmentID: &departmentID,
+		Status:       "active",
+	}
+	suite.svc.EXPECT().GetAllEmployees(gomock.Any(), filter).Return([]models.Employee{{ID: 1, Name: "Alice"}}, int64(101), nil)
+
+	departmentFilter := uint32(3)
+	response, err := suite.client.ListEmployees(context.Background(), &employeev1.ListEmployeesRequest{
+		Page: 2, PageSize: 500, Name: "ali", DepartmentId: &departmentFilter, Status: "active",
+	})
+	suite.Require().NoError(err)
+	suite.Len(response.GetEmployees(), 1)
+	suite.Equal(int64(101), response.GetTotalCount())
+	suite.Equal(int32(2), response.GetPage())
+	suite.Equal(int32(models.MaxPageSize), response.GetPageSize())
+}
+
+func (suite *EmployeeServerTestSuite) TestCreateEmployee() {
+	suite.svc.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.False(e.JoinDate.IsZero(), "join date defaults to now")
+		suite.Nil(e.SalaryOverride)
+		e.ID = 7
+		return e, nil
+	})
+
+	employee, err := suite.client.CreateEmployee(suite.as("clerk", auth.RoleEmployee), &employeev1.CreateEmployeeRequest{
+		Employee: &employeev1.Employee{Name: "Carol", Email: "carol@example.com", Position: "Dev", Salary: 60000},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(uint32(7), employee.GetId())
+}
+
+func (suite *EmployeeServerTestSuite) TestCreateEmployeeInvalid() {
+	_, err := suite.client.CreateEmployee(context.Background(), &employeev1.CreateEmployeeRequest{
+		Employee: &employeev1.Employee{Name: "Carol", Email: "not-an-email", Position: "Dev", Salary: 60000},
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.client.CreateEmployee(context.Background(), &employeev1.CreateEmployeeRequest{})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *EmployeeServerTestSuite) TestCreateEmployeeSalaryOverride() {
+	request := &employeev1.CreateEmployeeRequest{
+		Employee:       &employeev1.Employee{Name: "Carol", Email: "carol@example.com", Position: "Dev", Salary: 900000},
+		SalaryOverride: &employeev1.SalaryOverride{Justification: "Market rate"},
+	}
+
+	_, err := suite.client.CreateEmployee(suite.as("clerk", auth.RoleEmployee), request)
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	suite.svc.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.Equal(&models.SalaryOverride{Justification: "Market rate", ApprovedBy: "hr-lead"}, e.SalaryOverride)
+		return e, nil
+	})
+	_, err = suite.client.CreateEmployee(suite.as("hr-lead", auth.RoleHR), request)
+	suite.NoError(err)
+}
+
+func (suite *EmployeeServerTestSuite) TestInvalidToken() {
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer nope")
+	_, err := suite.client.GetEmployee(ctx, &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (suite *EmployeeServerTestSuite) TestCallsRunForTheirTenant() {
+	suite.svc.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).DoAndReturn(
+		func(ctx context.Context, id uint, _ models.EmployeeView) (models.Employee, error) {
+			tenantID, _ := tenant.FromContext(ctx)
+			return models.Employee{ID: id, Name: tenantID}, nil
+		}).Times(3)
+
+	employee, err := suite.client.GetEmployee(context.Background(), &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Equal(tenant.Default, employee.GetName())
+	employee, err = suite.client.GetEmployee(metadata.AppendToOutgoingContext(suite.as("root", auth.RoleAdmin), "x-tenant-id", "acme"), &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Equal("acme", employee.GetName())
+	employee, err = suite.client.GetEmployee(suite.asTenant("acme", "clerk", auth.RoleEmployee), &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Equal("acme", employee.GetName())
+
+	// A token bound to one tenant cannot be used for another
+	ctx := metadata.AppendToOutgoingContext(suite.asTenant("acme", "clerk", auth.RoleEmployee), "x-tenant-id", "default")
+	_, err = suite.client.GetEmployee(ctx, &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	// Callers whose token is not bound to a tenant cannot name one
+	_, err = suite.client.GetEmployee(metadata.AppendToOutgoingContext(context.Background(), "x-tenant-id", "acme"), &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = suite.client.GetEmployee(metadata.AppendToOutgoingContext(suite.as("root", auth.RoleAdmin), "x-tenant-id", "globex"), &employeev1.GetEmployeeRequest{Id: 1})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *EmployeeServerTestSuite) TestUpdateEmployee() {
+	managerID := uint32(4)
+	suite.svc.EXPECT().Update(gomock.Any(), uint(3), gomock.Any()).DoAndReturn(func(_ context.Context, _ uint, e models.Employee) (models.Employee, error) {
+		suite.Equal(uint(4), *e.ManagerID)
+		e.ID = 3
+		return e, nil
+	})
+
+	employee, err := suite.client.UpdateEmployee(context.Background(), &employeev1.UpdateEmployeeRequest{
+		Id:       3,
+		Employee: &employeev1.Employee{Name: "Dan", Email: "dan@example.com", Position: "QA", Salary: 40000, ManagerId: &managerID},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(uint32(4), employee.GetManagerId())
+}
+
+func (suite *EmployeeServerTestSuite) TestUpdateEmployeeConflict() {
+	suite.svc.EXPECT().Update(gomock.Any(), uint(3), gomock.Any()).Return(models.Employee{}, fmt.Errorf("%w: manager cycle", models.ErrConflict))
+
+	_, err := suite.client.UpdateEmployee(context.Background(), &employeev1.UpdateEmployeeRequest{
+		Id:       3,
+		Employee: &employeev1.Employee{Name: "Dan", Email: "dan@example.com", Position: "QA", Salary: 40000},
+	})
+	suite.Equal(codes.Aborted, status.Code(err))
+	suite.Contains(status.Convert(err).Message(), "manager cycle")
+}
+
+func (suite *EmployeeServerTestSuite) TestDeleteEmployee() {
+	suite.svc.EXPECT().Delete(gomock.Any(), uint(5)).Return(nil)
+	_, err := suite.client.DeleteEmployee(context.Background(), &employeev1.DeleteEmployeeRequest{Id: 5})
+	suite.NoError(err)
+
+	suite.svc.EXPECT().Delete(gomock.Any(), uint(6)).Return(models.ErrEmployeeNotFound)
+	_, err = suite.client.DeleteEmployee(context.Background(), &employeev1.DeleteEmployeeRequest{Id: 6})
+	suite.Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *EmployeeServerTestSuite) TestWatchEmployees() {
+	broker := events.NewBroker(2)
+	for i := 0; i < 3; i++ {
+		broker.Publish(models.EmployeeEvent{Type: models.EventEmployeeCreated, EmployeeID: 1, Employee: models.Employee{ID: 1}})
+	}
+	after := uint64(0)
+	suite.svc.EXPECT().SubscribeEvents(gomock.Any(), models.EventFilter{EmployeeIDs: []uint{1}}, &after).DoAndReturn(
+		func(_ context.Context, filter models.EventFilter, after *uint64) (*events.Subscription, error) {
+			return broker.Subscribe(filter, after), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := suite.client.WatchEmployees(ctx, &employeev1.WatchEmployeesRequest{EmployeeIds: []uint32{1}, LastEventId: &after})
+	suite.Require().NoError(err)
+
+	message, err := stream.Recv()
+	suite.Require().NoError(err)
+	suite.Equal(uint64(0), message.GetStreamReset().GetLastEventId())
+	for _, id := range []uint64{2, 3} {
+		message, err = stream.Recv()
+		suite.Require().NoError(err)
+		suite.Equal(id, message.GetEvent().GetId())
+	}
+
+	previous := models.Employee{ID: 1, Name: "Old"}
+	broker.Publish(
+		models.EmployeeEvent{Type: models.EventEmployeeUpdated, EmployeeID: 2, Employee: models.Employee{ID: 2}},
+		models.EmployeeEvent{Type: models.EventEmployeeUpdated, EmployeeID: 1, Employee: models.Employee{ID: 1, Name: "New"}, Previous: &previous},
+	)
+	message, err = stream.Recv()
+	suite.Require().NoError(err)
+	suite.Equal(uint64(5), message.GetEvent().GetId())
+	suite.Equal("employee.updated", message.GetEvent().GetType())
+	suite.Equal("New", message.GetEvent().GetEmployee().GetName())
+	suite.Equal("Old", message.GetEvent().GetPrevious().GetName())
+}
+
+func (suite *EmployeeServerTestSuite) TestStatusError() {
+	cases := map[error]codes.Code{
+		models.ErrDepartmentNotFound:                                codes.NotFound,
+		fmt.Errorf("%w: salary outside band", models.ErrValidation): codes.InvalidArgument,
+		models.ErrRolledBack:                                        codes.Aborted,
+		fmt.Errorf("%w: terminated", models.ErrInvalidTransition):   codes.FailedPrecondition,
+		models.ErrForbidden:                                         codes.PermissionDenied,
+		errors.New("disk full"):                                     codes.Internal,
+	}
+	for err, code := range cases {
+		suite.Equal(code, status.Code(statusError(err)), err.Error())
+	}
+}
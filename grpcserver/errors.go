@@ -0,0 +1,31 @@
+package grpcserver
+
+import (
+	"errors"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusError maps a domain error to a gRPC status, using the code that
+// matches the HTTP status the REST API returns for it
+func statusError(err error) error {
+	var code codes.Code
+	switch {
+	case errors.Is(err, models.ErrEmployeeNotFound), errors.Is(err, models.ErrDepartmentNotFound),
+		errors.Is(err, models.ErrPositionNotFound):
+		code = codes.NotFound
+	case errors.Is(err, models.ErrValidation):
+		code = codes.InvalidArgument
+	case errors.Is(err, models.ErrConflict), errors.Is(err, models.ErrRolledBack):
+		code = codes.Aborted
+	case errors.Is(err, models.ErrInvalidTransition):
+		code = codes.FailedPrecondition
+	case errors.Is(err, models.ErrForbidden):
+		code = codes.PermissionDenied
+	default:
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
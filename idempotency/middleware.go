@@ -0,0 +1,170 @@
+// Package idempotency makes retried mutating requests safe. A client that
+// sends an Idempotency-Key header with a POST, PUT, PATCH or DELETE gets the
+// same response for every retry of that request, and the request runs once.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// HeaderKey is the request header carrying the client's key
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed marks a response replayed from an earlier request
+	HeaderReplayed = "Idempotent-Replayed"
+	// MaxKeyLength is the longest key accepted
+	MaxKeyLength = 255
+)
+
+// Middleware honours the Idempotency-Key header on mutating requests. The
+// first request with a key runs and its response is stored with a
+// fingerprint of the method, URL and body. Retries with the same key and
+// fingerprint get the stored response back, a retry while the first request
+// is still running gets 409 and reusing the key for a different request gets
+// 422. Keys are scoped to the caller and their tenant, so it must run after
+// auth.Middleware and tenant.Middleware, and anonymous callers get 401.
+// Server errors and panics release the key, so the request can be retried.
+func Middleware(idempotencyService service.IdempotencyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, ok := c.Request.Header[http.CanonicalHeaderKey(HeaderKey)]
+		if !ok || !mutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+		if len(key) != 1 || key[0] == "" || len(key[0]) > MaxKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key must be a single value of 1 to 255 characters"})
+			return
+		}
+
+		if !auth.FromContext(c).Authenticated() {
+			// Anonymous callers cannot be told apart, so they would share keys
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Idempotency-Key requires an authenticated caller"})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "failed to read the request body: " + err.Error()})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		record, err := idempotencyService.Begin(c.Request.Context(), scope(c), key[0], fingerprint(c.Request, body))
+		if err != nil {
+			c.AbortWithStatusJSON(errorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+		if record.Completed {
+			replay(c, record)
+			return
+		}
+
+		// The key is stored or released even when the client has gone away
+		ctx := context.WithoutCancel(c.Request.Context())
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		completed := false
+		defer func() {
+			// Reached without completing when a handler panics or the response
+			// could not be stored; either way a retry should run again
+			if !completed {
+				if err := idempotencyService.Release(ctx, record); err != nil {
+					log.Printf("idempotency: releasing key %q: %v", record.Key, err)
+				}
+			}
+		}()
+
+		c.Next()
+
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+		if err := idempotencyService.Complete(ctx, record, status, recorder.Header().Clone(), recorder.body.Bytes()); err != nil {
+			log.Printf("idempotency: storing the response for key %q: %v", record.Key, err)
+			return
+		}
+		completed = true
+	}
+}
+
+func mutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// scope returns whose keys a request's key is told apart from: the
+// caller's within their tenant. Without authentication every caller is the
+// developer, so callers are told apart by their address instead.
+func scope(c *gin.Context) string {
+	subject := auth.FromContext(c).Subject
+	if subject == auth.Developer.Subject {
+		subject += "@" + c.ClientIP()
+	}
+	if id, ok := tenant.FromContext(c.Request.Context()); ok {
+		return id + "/" + subject
+	}
+	return subject
+}
+
+// fingerprint identifies a request by its method, URL and body
+func fingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	io.WriteString(hash, r.Method+" "+r.URL.RequestURI()+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, models.ErrIdempotencyReused):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, models.ErrConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// replay writes a stored response
+func replay(c *gin.Context, record models.IdempotencyRecord) {
+	for name, values := range record.Header {
+		c.Writer.Header()[name] = values
+	}
+	c.Header(HeaderReplayed, "true")
+	c.Status(record.StatusCode)
+	c.Writer.Write(record.Body)
+	c.Abort()
+}
+
+// responseRecorder keeps a copy of the response body as it is written
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
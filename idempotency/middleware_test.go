@@ -0,0 +1,186 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+	ctrl  *gomock.Controller
+	svc   *mocks.MockIdempotencyService
+	r     *gin.Engine
+	calls int
+	// principal is the caller of every request
+	principal auth.Principal
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.svc = mocks.NewMockIdempotencyService(suite.ctrl)
+	suite.calls = 0
+	suite.principal = auth.Principal{Subject: "alice", Roles: []string{auth.RoleHR}}
+
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.New()
+	suite.r.Use(gin.CustomRecovery(func(c *gin.Context, _ any) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	suite.r.Use(Middleware(suite.svc))
+	suite.r.POST("/employees", func(c *gin.Context) {
+		suite.calls++
+		var body map[string]interface{}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.Header("Location", "/employees/6")
+		c.JSON(http.StatusCreated, gin.H{"id": 6, "name": body["name"]})
+	})
+	suite.r.GET("/employees", func(c *gin.Context) {
+		suite.calls++
+		c.JSON(http.StatusOK, []string{})
+	})
+	suite.r.DELETE("/employees/:id", func(c *gin.Context) {
+		suite.calls++
+		if c.Param("id") == "boom" {
+			panic("boom")
+		}
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "database is down"})
+	})
+}
+
+func (suite *MiddlewareTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) request(method, path, key, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(HeaderKey, key)
+	}
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *MiddlewareTestSuite) TestFirstRequestIsStored() {
+	record := models.IdempotencyRecord{ID: 1, Scope: "alice", Key: "key-1"}
+	var fingerprint string
+	suite.svc.EXPECT().Begin(gomock.Any(), "alice", "key-1", gomock.Any()).DoAndReturn(func(_ context.Context, _, _, f string) (models.IdempotencyRecord, error) {
+		fingerprint = f
+		return record, nil
+	})
+	suite.svc.EXPECT().Complete(gomock.Any(), record, http.StatusCreated, gomock.Any(), []byte(`{"id":6,"name":"Fay"}`)).DoAndReturn(
+		func(_ context.Context, _ models.IdempotencyRecord, _ int, header http.Header, _ []byte) error {
+			suite.Equal("/employees/6", header.Get("Location"))
+			return nil
+		})
+
+	w := suite.request("POST", "/employees", "key-1", `{"name":"Fay"}`)
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.JSONEq(`{"id":6,"name":"Fay"}`, w.Body.String(), "the handler still reads the body")
+	suite.Empty(w.Header().Get(HeaderReplayed))
+	suite.Equal(1, suite.calls)
+
+	// The fingerprint covers the body
+	suite.svc.EXPECT().Begin(gomock.Any(), "alice", "key-2", gomock.Any()).DoAndReturn(func(_ context.Context, _, _, f string) (models.IdempotencyRecord, error) {
+		suite.NotEqual(fingerprint, f)
+		return models.IdempotencyRecord{}, models.ErrConflict
+	})
+	suite.request("POST", "/employees", "key-2", `{"name":"Gus"}`)
+}
+
+func (suite *MiddlewareTestSuite) TestRetryIsReplayed() {
+	suite.svc.EXPECT().Begin(gomock.Any(), "alice", "key-1", gomock.Any()).Return(models.IdempotencyRecord{
+		ID: 1, Completed: true, StatusCode: http.StatusCreated,
+		Header: http.Header{"Content-Type": {"application/json; charset=utf-8"}, "Location": {"/employees/6"}},
+		Body:   []byte(`{"id":6,"name":"Fay"}`),
+	}, nil)
+
+	w := suite.request("POST", "/employees", "key-1", `{"name":"Fay"}`)
+	suite.Equal(http.StatusCreated, w.Code)
+	suite.Equal(`{"id":6,"name":"Fay"}`, w.Body.String())
+	suite.Equal("/employees/6", w.Header().Get("Location"))
+	suite.Equal("true", w.Header().Get(HeaderReplayed))
+	suite.Equal(0, suite.calls, "a replay does not run the handler")
+}
+
+func (suite *MiddlewareTestSuite) TestKeyInProgressOrReused() {
+	suite.svc.EXPECT().Begin(gomock.Any(), "alice", "key-1", gomock.Any()).Return(models.IdempotencyRecord{}, models.ErrConflict)
+	w := suite.request("POST", "/employees", "key-1", `{"name":"Fay"}`)
+	suite.Equal(http.StatusConflict, w.Code)
+
+	suite.svc.EXPECT().Begin(gomock.Any(), "alice", "key-1", gomock.Any()).Return(models.IdempotencyRecord{}, models.ErrIdempotencyReused)
+	w = suite.request("POST", "/employees", "key-1", `{"name":"Gus"}`)
+	suite.Equal(http.StatusUnprocessableEntity, w.Code)
+	suite.Equal(0, suite.calls)
+}
+
+func (suite *MiddlewareTestSuite) TestServerErrorsAndPanicsReleaseKey() {
+	record := models.IdempotencyRecord{ID: 1, Scope: "alice", Key: "key-1"}
+	suite.svc.EXPECT().Begin(gomock.Any(), "alice", "key-1", gomock.Any()).Return(record, nil).Times(2)
+	suite.svc.EXPECT().Release(gomock.Any(), record).Return(nil).Times(2)
+
+	w := suite.request("DELETE", "/employees/1", "key-1", "")
+	suite.Equal(http.StatusServiceUnavailable, w.Code)
+	w = suite.request("DELETE", "/employees/boom", "key-1", "")
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestRequestsWithoutKeyOrReadsPassThrough() {
+	suite.Equal(http.StatusCreated, suite.request("POST", "/employees", "", `{"name":"Fay"}`).Code)
+	suite.Equal(http.StatusOK, suite.request("GET", "/employees", "key-1", "").Code)
+	suite.Equal(2, suite.calls)
+}
+
+func (suite *MiddlewareTestSuite) TestInvalidKey() {
+	w := suite.request("POST", "/employees", strings.Repeat("k", MaxKeyLength+1), `{"name":"Fay"}`)
+	suite.Equal(http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/employees", strings.NewReader(`{}`))
+	req.Header[HeaderKey] = []string{""}
+	suite.r.ServeHTTP(w, req)
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Equal(0, suite.calls)
+}
+
+func (suite *MiddlewareTestSuite) TestAnonymousCallersCannotUseKeys() {
+	suite.principal = auth.Anonymous
+	w := suite.request("POST", "/employees", "key-1", `{"name":"Fay"}`)
+	suite.Equal(http.StatusUnauthorized, w.Code)
+	suite.Equal(0, suite.calls)
+}
+
+func (suite *MiddlewareTestSuite) TestKeysAreScopedToClientWithoutAuthentication() {
+	// Every caller is the developer, so their keys are told apart by address
+	suite.principal = auth.Developer
+	suite.svc.EXPECT().Begin(gomock.Any(), "dev@192.0.2.1", "key-1", gomock.Any()).Return(models.IdempotencyRecord{}, models.ErrConflict)
+	suite.svc.EXPECT().Begin(gomock.Any(), "dev@192.0.2.2", "key-1", gomock.Any()).Return(models.IdempotencyRecord{}, models.ErrConflict)
+	for _, addr := range []string{"192.0.2.1:1234", "192.0.2.2:1234"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/employees", strings.NewReader(`{"name":"Fay"}`))
+		req.Header.Set(HeaderKey, "key-1")
+		req.RemoteAddr = addr
+		suite.r.ServeHTTP(w, req)
+		suite.Equal(http.StatusConflict, w.Code)
+	}
+}
@@ -1,10 +1,29 @@
 package main
 
 import (
+	"context"
+	"expvar"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/cache"
 	"github.com/chinmay-sawant/gin-example/controllers"
 	"github.com/chinmay-sawant/gin-example/db"
 	"github.com/chinmay-sawant/gin-example/docs"
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/graph"
+	"github.com/chinmay-sawant/gin-example/grpcserver"
+	"github.com/chinmay-sawant/gin-example/idempotency"
+	"github.com/chinmay-sawant/gin-example/openapi"
+	"github.com/chinmay-sawant/gin-example/outbox"
 	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/search"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/chinmay-sawant/gin-example/tenant"
 	"github.com/gin-gonic/gin"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -24,16 +43,142 @@ func main() {
 
 	// Create a new Gin router
 	router := gin.Default()
-	// Use gin-swagger middleware to expose Swagger UI
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
-	employeeRepo := repo.NewEmployeeRepository()
+	// Resolve the caller from a bearer token signed with JWT_SECRET
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Println("JWT_SECRET is not set, authentication is disabled and every request runs as admin")
+	}
+
+	// Employee lookups by ID are cached in process, EMPLOYEE_CACHE_SIZE
+	// entries (default 1000) for EMPLOYEE_CACHE_TTL (a Go duration, default 1m)
+	employeeCacheSize, _ := strconv.Atoi(os.Getenv("EMPLOYEE_CACHE_SIZE"))
+	employeeCacheTTL, _ := time.ParseDuration(os.Getenv("EMPLOYEE_CACHE_TTL"))
+	employeeRepo := repo.NewCachedEmployeeRepository(repo.NewEmployeeRepository(), cache.NewLRU(employeeCacheSize),
+		repo.WithEmployeeCacheTTL(employeeCacheTTL))
+	// Cache hits and misses are published with the runtime metrics at /debug/vars
+	expvar.Publish("employee_cache", expvar.Func(func() interface{} { return employeeRepo.CacheStats() }))
+	router.GET("/debug/vars", gin.WrapH(expvar.Handler()))
+	departmentRepo := repo.NewDepartmentRepository()
+	positionRepo := repo.NewPositionRepository()
+	analyticsRepo := repo.NewAnalyticsRepository()
+	webhookRepo := repo.NewWebhookRepository()
+	outboxRepo := repo.NewOutboxRepository()
+	idempotencyRepo := repo.NewIdempotencyRepository()
+	tenantRepo := repo.NewTenantRepository()
+	customFieldRepo := repo.NewCustomFieldRepository()
+	tagRepo := repo.NewTagRepository()
+	unitOfWork := repo.NewUnitOfWork()
+	// Create services
+	// Bulk endpoint limits, BULK_MAX_ITEMS and BULK_BATCH_SIZE override the defaults
+	bulkMaxItems, _ := strconv.Atoi(os.Getenv("BULK_MAX_ITEMS"))
+	bulkBatchSize, _ := strconv.Atoi(os.Getenv("BULK_BATCH_SIZE"))
+	// Employee search uses SQLite FTS5 when the database supports it
+	employeeIndex := search.NewEmployeeIndex(db.DB)
+	// Employee changes are kept for resuming event streams, EVENT_LOG_SIZE overrides how many
+	eventLogSize, _ := strconv.Atoi(os.Getenv("EVENT_LOG_SIZE"))
+	eventBroker := events.NewBroker(eventLogSize)
+	employeeService := service.NewEmployeeService(employeeRepo, departmentRepo, positionRepo,
+		service.WithBulkLimits(bulkMaxItems, bulkBatchSize), service.WithSearchIndex(employeeIndex),
+		service.WithEventBroker(eventBroker), service.WithTenantSettings(tenantRepo),
+		service.WithUnitOfWork(unitOfWork), service.WithCustomFields(customFieldRepo))
+	if err := employeeService.RebuildSearchIndex(context.Background()); err != nil {
+		log.Fatalf("Failed to build the employee search index: %v", err)
+	}
+	departmentService := service.NewDepartmentService(departmentRepo, employeeRepo)
+	positionService := service.NewPositionService(positionRepo)
+	analyticsService := service.NewAnalyticsService(analyticsRepo)
+	tenantService := service.NewTenantService(tenantRepo)
+	customFieldService := service.NewCustomFieldService(customFieldRepo, employeeRepo, unitOfWork)
+	tagService := service.NewTagService(tagRepo, employeeRepo, unitOfWork)
+	// Webhook deliveries are retried WEBHOOK_MAX_ATTEMPTS times with backoff
+	// from WEBHOOK_RETRY_DELAY up to WEBHOOK_MAX_RETRY_DELAY (Go durations)
+	webhookMaxAttempts, _ := strconv.Atoi(os.Getenv("WEBHOOK_MAX_ATTEMPTS"))
+	webhookRetryDelay, _ := time.ParseDuration(os.Getenv("WEBHOOK_RETRY_DELAY"))
+	webhookMaxRetryDelay, _ := time.ParseDuration(os.Getenv("WEBHOOK_MAX_RETRY_DELAY"))
+	webhookService := service.NewWebhookService(webhookRepo,
+		service.WithRetryPolicy(webhookMaxAttempts, webhookRetryDelay, webhookMaxRetryDelay))
+	go service.RunWebhookDispatcher(context.Background(), webhookService, service.DefaultWebhookPollInterval)
+	// Employee changes are stored in the outbox with the change itself and
+	// relayed to the event broker, the webhook queue and, when OUTBOX_FILE
+	// is set, an NDJSON file. OUTBOX_RETENTION (a Go duration) sets how long
+	// relayed events are kept.
+	outboxSinks := []outbox.Sink{outbox.NewBusSink(eventBroker), outbox.NewWebhookSink(webhookService)}
+	if path := os.Getenv("OUTBOX_FILE"); path != "" {
+		fileSink, err := outbox.NewFileSink(path)
+		if err != nil {
+			log.Fatalf("Failed to open the outbox file: %v", err)
+		}
+		outboxSinks = append(outboxSinks, fileSink)
+	}
+	outboxRetention, _ := time.ParseDuration(os.Getenv("OUTBOX_RETENTION"))
+	relay := outbox.NewRelay(outboxRepo, outboxSinks, outbox.WithRetention(outboxRetention))
+	go relay.Run(context.Background())
+	// Idempotency-Key responses are kept for IDEMPOTENCY_TTL (a Go duration, default 24h)
+	idempotencyTTL, _ := time.ParseDuration(os.Getenv("IDEMPOTENCY_TTL"))
+	idempotencyService := service.NewIdempotencyService(idempotencyRepo, service.WithIdempotencyTTL(idempotencyTTL))
 	// Create controllers
-	employeeController := controllers.NewEmployeeController(employeeRepo)
+	employeeController := controllers.NewEmployeeController(employeeService)
+	departmentController := controllers.NewDepartmentController(departmentService)
+	positionController := controllers.NewPositionController(positionService)
+	analyticsController := controllers.NewAnalyticsController(analyticsService)
+	webhookController := controllers.NewWebhookController(webhookService)
+	tenantController := controllers.NewTenantController(tenantService)
+	customFieldController := controllers.NewCustomFieldController(customFieldService)
+	tagController := controllers.NewTagController(tagService)
+	// GRAPHQL_MAX_DEPTH and GRAPHQL_MAX_COMPLEXITY override the GraphQL query limits
+	graphqlMaxDepth, _ := strconv.Atoi(os.Getenv("GRAPHQL_MAX_DEPTH"))
+	graphqlMaxComplexity, _ := strconv.Atoi(os.Getenv("GRAPHQL_MAX_COMPLEXITY"))
+	graphqlExecutor, err := graph.NewExecutor(employeeService, employeeRepo, departmentRepo,
+		graph.WithLimits(graphqlMaxDepth, graphqlMaxComplexity))
+	if err != nil {
+		log.Fatalf("Failed to build the GraphQL schema: %v", err)
+	}
+	graphqlController := controllers.NewGraphQLController(graphqlExecutor)
+	scimController := controllers.NewSCIMController(service.NewSCIMService(employeeService, departmentRepo))
+
+	// Every request runs for one tenant, named by the token's tenant claim,
+	// the X-Tenant-ID header or a subdomain of TENANT_BASE_DOMAIN
+	tenantMiddleware := tenant.Middleware(tenantService.GetTenantByID, os.Getenv("TENANT_BASE_DOMAIN"))
 
 	// Routes
-	v1 := router.Group("/api/v1")
+	v1 := router.Group("/api/v1", auth.Middleware([]byte(jwtSecret)), tenantMiddleware, idempotency.Middleware(idempotencyService))
 	employeeController.RegisterRoutes(v1)
+	departmentController.RegisterRoutes(v1)
+	positionController.RegisterRoutes(v1)
+	analyticsController.RegisterRoutes(v1)
+	webhookController.RegisterRoutes(v1)
+	graphqlController.RegisterRoutes(v1)
+	tenantController.RegisterRoutes(v1)
+	customFieldController.RegisterRoutes(v1)
+	tagController.RegisterRoutes(v1)
+	// Identity providers provision employees through SCIM 2.0 at /scim/v2
+	scimController.RegisterRoutes(router.Group("/scim/v2", auth.Middleware([]byte(jwtSecret)), tenantMiddleware,
+		idempotency.Middleware(idempotencyService)))
+	// Add the routes built at runtime, such as the CRUD routes, to the Swagger docs
+	if err := openapi.Merge(docs.SwaggerInfo); err != nil {
+		log.Fatalf("Failed to document the API: %v", err)
+	}
+	// Use gin-swagger middleware to expose Swagger UI. The spec is served for
+	// the caller's tenant, whose custom fields it describes.
+	router.GET("/swagger/*any", auth.Middleware([]byte(jwtSecret)), tenantMiddleware,
+		openapi.Handler(docs.SwaggerInfo, ginSwagger.WrapHandler(swaggerfiles.Handler)))
+
+	// Serve the gRPC API on GRPC_PORT (default 9090) next to the REST API
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+	grpcListener, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		log.Fatalf("Failed to listen for gRPC: %v", err)
+	}
+	grpcServer := grpcserver.NewServer(employeeService, []byte(jwtSecret), tenantService.GetTenantByID)
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatalf("gRPC server stopped: %v", err)
+		}
+	}()
 
 	// Start the server
 	router.Run(":8080")
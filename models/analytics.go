@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// AnalyticsGroupBy is the employee attribute headcount and salary statistics are grouped by
+type AnalyticsGroupBy string
+
+const (
+	GroupByPosition   AnalyticsGroupBy = "position"
+	GroupByDepartment AnalyticsGroupBy = "department"
+	GroupByStatus     AnalyticsGroupBy = "status"
+)
+
+// UnassignedGroup is the group of employees without a department
+const UnassignedGroup = "unassigned"
+
+// MonthFormat is the layout of the months in movement reports
+const MonthFormat = "2006-01"
+
+// AnalyticsFilter selects the employees a headcount, salary or tenure report
+// covers and how they are grouped. Terminated employees are left out unless
+// asked for.
+type AnalyticsFilter struct {
+	GroupBy           AnalyticsGroupBy `form:"group_by" binding:"omitempty,oneof=position department status"`
+	IncludeTerminated bool             `form:"include_terminated"`
+}
+
+// Normalize groups by department when no grouping is given
+func (f *AnalyticsFilter) Normalize() {
+	if f.GroupBy == "" {
+		f.GroupBy = GroupByDepartment
+	}
+}
+
+// HeadcountGroup is the number of employees in one group
+type HeadcountGroup struct {
+	Group string `json:"group" gorm:"column:group_key"`
+	Count int64  `json:"count"`
+}
+
+// SalaryStats summarises the salaries of one group in one currency.
+// Percentiles are interpolated linearly between the nearest salaries.
+type SalaryStats struct {
+	Group    string  `json:"group" gorm:"column:group_key"`
+	Currency string  `json:"currency"`
+	Count    int64   `json:"count"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	Mean     float64 `json:"mean"`
+	Median   float64 `json:"median"`
+	P25      float64 `json:"p25"`
+	P75      float64 `json:"p75"`
+	P90      float64 `json:"p90"`
+}
+
+// MovementFilter limits a movement report to the months from From to To,
+// both inclusive and formatted as YYYY-MM
+type MovementFilter struct {
+	From string `form:"from"`
+	To   string `form:"to"`
+}
+
+// Validate checks that both months parse and are in order
+func (f MovementFilter) Validate() error {
+	var from, to time.Time
+	var err error
+	if f.From != "" {
+		if from, err = time.Parse(MonthFormat, f.From); err != nil {
+			return fmt.Errorf("%w: from must be a month formatted as YYYY-MM", ErrValidation)
+		}
+	}
+	if f.To != "" {
+		if to, err = time.Parse(MonthFormat, f.To); err != nil {
+			return fmt.Errorf("%w: to must be a month formatted as YYYY-MM", ErrValidation)
+		}
+	}
+	if f.From != "" && f.To != "" && to.Before(from) {
+		return fmt.Errorf("%w: from must not be after to", ErrValidation)
+	}
+	return nil
+}
+
+// MonthlyMovement counts the hires and terminations effective in one month
+type MonthlyMovement struct {
+	Month        string `json:"month"`
+	Hires        int64  `json:"hires"`
+	Terminations int64  `json:"terminations"`
+}
+
+// TenureBucket counts the employees whose tenure in years is at least
+// MinYears and below MaxYears; a MaxYears of zero leaves the bucket open
+type TenureBucket struct {
+	Label    string  `json:"label"`
+	MinYears float64 `json:"min_years"`
+	MaxYears float64 `json:"max_years,omitempty"`
+	Count    int64   `json:"count"`
+}
+
+// TenureBuckets are the buckets of a tenure report, shortest tenure first
+var TenureBuckets = []TenureBucket{
+	{Label: "<1y", MinYears: 0, MaxYears: 1},
+	{Label: "1-2y", MinYears: 1, MaxYears: 2},
+	{Label: "2-5y", MinYears: 2, MaxYears: 5},
+	{Label: "5-10y", MinYears: 5, MaxYears: 10},
+	{Label: "10y+", MinYears: 10},
+}
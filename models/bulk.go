@@ -0,0 +1,127 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// BulkMode selects how a bulk request handles failing items
+type BulkMode string
+
+const (
+	// BulkAtomic applies every item in one transaction or none of them
+	BulkAtomic BulkMode = "atomic"
+	// BulkBestEffort applies every item that succeeds and reports the rest
+	BulkBestEffort BulkMode = "best_effort"
+)
+
+// BulkItemResult is the outcome of one item of a bulk request
+type BulkItemResult struct {
+	Index    int       `json:"index"`
+	ID       uint      `json:"id,omitempty"`
+	Status   int       `json:"status"`
+	Error    string    `json:"error,omitempty"`
+	Employee *Employee `json:"employee,omitempty"`
+
+	// Err is the item's failure, mapped to Status and Error by the controller
+	Err error `json:"-"`
+}
+
+// BulkResponse summarises a bulk request and lists the per-item results in request order
+type BulkResponse struct {
+	Mode      BulkMode         `json:"mode"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []BulkItemResult `json:"results"`
+}
+
+// EmployeePatch is a partial employee update; fields left out are unchanged.
+// department_id and manager_id given as null remove the employee from their
+// department or take away their manager.
+type EmployeePatch struct {
+	ID             uint            `json:"id" binding:"required"`
+	Name           *string         `json:"name" binding:"omitempty,min=1"`
+	Email          *string         `json:"email" binding:"omitempty,email"`
+	Position       *string         `json:"position" binding:"omitempty,min=1"`
+	PositionID     *uint           `json:"position_id"`
+	Salary         *float64        `json:"salary" binding:"omitempty,gt=0"`
+	Currency       *string         `json:"currency" binding:"omitempty,len=3"`
+	DepartmentID   *uint           `json:"department_id"`
+	ManagerID      *uint           `json:"manager_id"`
+	JoinDate       *time.Time      `json:"join_date"`
+	SalaryOverride *SalaryOverride `json:"salary_override"`
+	// CustomFields sets the custom field values given and removes those given as null
+	CustomFields CustomFieldValues `json:"custom_fields"`
+
+	// ClearDepartment and ClearManager record department_id and manager_id given as null
+	ClearDepartment bool `json:"-"`
+	ClearManager    bool `json:"-"`
+}
+
+// UnmarshalJSON decodes the patch and tells a null department_id or
+// manager_id apart from one that was left out
+func (p *EmployeePatch) UnmarshalJSON(data []byte) error {
+	type patch EmployeePatch
+	if err := json.Unmarshal(data, (*patch)(p)); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	p.ClearDepartment = isNull(fields, "department_id")
+	p.ClearManager = isNull(fields, "manager_id")
+	return nil
+}
+
+// isNull reports whether the field is present and null
+func isNull(fields map[string]json.RawMessage, name string) bool {
+	value, ok := fields[name]
+	return ok && string(value) == "null"
+}
+
+// Apply copies the fields present in the patch onto the employee
+func (p EmployeePatch) Apply(employee *Employee) {
+	if p.Name != nil {
+		employee.Name = *p.Name
+	}
+	if p.Email != nil {
+		employee.Email = *p.Email
+	}
+	if p.Position != nil {
+		employee.Position = *p.Position
+	}
+	if p.PositionID != nil {
+		employee.PositionID = p.PositionID
+	}
+	if p.Salary != nil {
+		employee.Salary = *p.Salary
+	}
+	if p.Currency != nil {
+		employee.Currency = *p.Currency
+	}
+	if p.DepartmentID != nil || p.ClearDepartment {
+		employee.DepartmentID = p.DepartmentID
+	}
+	if p.ManagerID != nil || p.ClearManager {
+		employee.ManagerID = p.ManagerID
+	}
+	if p.JoinDate != nil {
+		employee.JoinDate = *p.JoinDate
+	}
+	if p.CustomFields != nil {
+		values := make(CustomFieldValues, len(employee.CustomFields)+len(p.CustomFields))
+		for name, value := range employee.CustomFields {
+			values[name] = value
+		}
+		for name, value := range p.CustomFields {
+			if value == nil {
+				delete(values, name)
+			} else {
+				values[name] = value
+			}
+		}
+		employee.CustomFields = values
+	}
+	employee.SalaryOverride = p.SalaryOverride
+}
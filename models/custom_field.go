@@ -0,0 +1,247 @@
+package models
+
+import (
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// CustomFieldType is the type of the values of a custom field
+type CustomFieldType string
+
+const (
+	CustomFieldString  CustomFieldType = "string"
+	CustomFieldInteger CustomFieldType = "integer"
+	CustomFieldNumber  CustomFieldType = "number"
+	CustomFieldBoolean CustomFieldType = "boolean"
+	// CustomFieldDate values are dates like 2024-01-31
+	CustomFieldDate CustomFieldType = "date"
+)
+
+// CustomFieldFilters are the filters of the custom field list
+var CustomFieldFilters = []FilterField{
+	{Name: "name", Partial: true, Description: "Filter by name substring"},
+	{Name: "type", Enum: []string{"string", "integer", "number", "boolean", "date"}, Description: "Filter by value type"},
+}
+
+// CustomFieldValues holds the values of an employee's custom fields by field name
+type CustomFieldValues map[string]interface{}
+
+// MarshalXML encodes the values as one element per field, in name order
+func (v CustomFieldValues) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(v)) {
+		if err := e.EncodeElement(fmt.Sprint(v[name]), xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML decodes one element per field. XML values are text, which
+// CheckValue converts to the field's type.
+func (v *CustomFieldValues) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	values := CustomFieldValues{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			var text string
+			if err := d.DecodeElement(&text, &t); err != nil {
+				return err
+			}
+			values[t.Name.Local] = customFieldText(text)
+		case xml.EndElement:
+			*v = values
+			return nil
+		}
+	}
+}
+
+// customFieldText is a value read from a format without types, such as XML
+type customFieldText string
+
+// customFieldDateLayout is the layout of date values
+const customFieldDateLayout = "2006-01-02"
+
+// customFieldName is the form of custom field names, which are also the keys
+// of the custom_fields object of employees
+var customFieldName = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// CustomField defines an employee attribute that admins add at runtime, such
+// as a shirt size or a cost center. Employees carry their values in
+// custom_fields, keyed by the field name.
+type CustomField struct {
+	ID       uint   `json:"id" gorm:"primary_key"`
+	TenantID string `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"uniqueIndex:idx_custom_field_name"`
+	// Name cannot change once the field is defined
+	Name string `json:"name" binding:"required" gorm:"uniqueIndex:idx_custom_field_name"`
+	// Type cannot change once the field is defined
+	Type     CustomFieldType `json:"type" binding:"required,oneof=string integer number boolean date"`
+	Required bool            `json:"required"`
+	// EnumValues, when set, are the only values a string field accepts
+	EnumValues []string `json:"enum_values,omitempty" gorm:"serializer:json"`
+	// Pattern, when set, is a regular expression every value of a string field must match in full
+	Pattern     string    `json:"pattern,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Validate checks that the definition is usable
+func (f CustomField) Validate() error {
+	if !customFieldName.MatchString(f.Name) {
+		return fmt.Errorf("%w: custom field names start with a lowercase letter followed by up to 62 lowercase letters, digits or underscores", ErrValidation)
+	}
+	switch f.Type {
+	case CustomFieldString, CustomFieldInteger, CustomFieldNumber, CustomFieldBoolean, CustomFieldDate:
+	default:
+		return fmt.Errorf("%w: unknown custom field type %q", ErrValidation, f.Type)
+	}
+	if f.Type != CustomFieldString && (len(f.EnumValues) > 0 || f.Pattern != "") {
+		return fmt.Errorf("%w: only string custom fields take enum values or a pattern", ErrValidation)
+	}
+	if f.Pattern != "" {
+		if _, err := regexp.Compile(f.Pattern); err != nil {
+			return fmt.Errorf("%w: invalid pattern: %s", ErrValidation, err.Error())
+		}
+	}
+	for _, value := range f.EnumValues {
+		if err := f.matchPattern(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckValue checks a value decoded from a request against the definition
+// and returns it as it is stored
+func (f CustomField) CheckValue(value interface{}) (interface{}, error) {
+	if text, ok := value.(customFieldText); ok {
+		parsed, err := f.ParseValue(string(text))
+		if err != nil {
+			return nil, err
+		}
+		value = parsed
+	}
+	switch f.Type {
+	case CustomFieldString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, f.typeError()
+		}
+		if len(f.EnumValues) > 0 && !slices.Contains(f.EnumValues, s) {
+			return nil, fmt.Errorf("%w: custom field %s must be one of %v", ErrValidation, f.Name, f.EnumValues)
+		}
+		return s, f.matchPattern(s)
+	case CustomFieldInteger:
+		n, ok := number(value)
+		if !ok || n != math.Trunc(n) || math.Abs(n) > 1<<53 {
+			return nil, f.typeError()
+		}
+		return int64(n), nil
+	case CustomFieldNumber:
+		n, ok := number(value)
+		if !ok {
+			return nil, f.typeError()
+		}
+		return n, nil
+	case CustomFieldBoolean:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, f.typeError()
+		}
+		return b, nil
+	case CustomFieldDate:
+		s, ok := value.(string)
+		if !ok {
+			return nil, f.typeError()
+		}
+		if _, err := time.Parse(customFieldDateLayout, s); err != nil {
+			return nil, f.typeError()
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown custom field type %q", ErrValidation, f.Type)
+	}
+}
+
+// ParseValue reads a value from its text form, as list filters give it
+func (f CustomField) ParseValue(text string) (interface{}, error) {
+	switch f.Type {
+	case CustomFieldInteger:
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, f.typeError()
+		}
+		return n, nil
+	case CustomFieldNumber:
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, f.typeError()
+		}
+		return n, nil
+	case CustomFieldBoolean:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, f.typeError()
+		}
+		return b, nil
+	case CustomFieldDate:
+		if _, err := time.Parse(customFieldDateLayout, text); err != nil {
+			return nil, f.typeError()
+		}
+		return text, nil
+	default:
+		return text, nil
+	}
+}
+
+// matchPattern checks a string value against the field's pattern
+func (f CustomField) matchPattern(value string) error {
+	if f.Pattern == "" {
+		return nil
+	}
+	pattern, err := regexp.Compile(`^(?:` + f.Pattern + `)$`)
+	if err != nil {
+		return fmt.Errorf("%w: invalid pattern: %s", ErrValidation, err.Error())
+	}
+	if !pattern.MatchString(value) {
+		return fmt.Errorf("%w: custom field %s must match %s", ErrValidation, f.Name, f.Pattern)
+	}
+	return nil
+}
+
+// number returns a numeric value as a float64. JSON decodes numbers as
+// float64, while YAML and MessagePack decode integers to integer types.
+func number(value interface{}) (float64, bool) {
+	n := reflect.ValueOf(value)
+	switch n.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return n.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(n.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(n.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+func (f CustomField) typeError() error {
+	if f.Type == CustomFieldDate {
+		return fmt.Errorf("%w: custom field %s must be a date like 2024-01-31", ErrValidation, f.Name)
+	}
+	return fmt.Errorf("%w: custom field %s must be of type %s", ErrValidation, f.Name, f.Type)
+}
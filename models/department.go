@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+// Department represents a team or organisational unit that employees belong to
+type Department struct {
+	ID          uint      `json:"id" gorm:"primary_key"`
+	TenantID    string    `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"uniqueIndex:idx_department_name"`
+	Name        string    `json:"name" binding:"required" gorm:"uniqueIndex:idx_department_name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
@@ -6,13 +6,33 @@ import (
 
 // Employee represents the employee entity
 type Employee struct {
-	ID        uint      `json:"id" gorm:"primary_key"`
-	Name      string    `json:"name" binding:"required"`
-	Email     string    `json:"email" binding:"required,email"`
-	Position  string    `json:"position" binding:"required"`
-	Salary    float64   `json:"salary" binding:"required"`
-	JoinDate  time.Time `json:"join_date"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	DeletedAt time.Time `json:"deleted_at,omitempty" gorm:"index"`
+	ID                uint           `json:"id" gorm:"primary_key"`
+	TenantID          string         `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	Name              string         `json:"name" binding:"required"`
+	Email             string         `json:"email" binding:"required,email"`
+	Position          string         `json:"position" binding:"required_without=PositionID"`
+	PositionID        *uint          `json:"position_id,omitempty" gorm:"index"`
+	Salary            float64        `json:"salary" binding:"required"`
+	Currency          string         `json:"currency" binding:"omitempty,len=3"`
+	SalaryOutOfBand   bool           `json:"salary_out_of_band"`
+	DepartmentID      *uint          `json:"department_id,omitempty" gorm:"index"`
+	ManagerID         *uint          `json:"manager_id,omitempty" gorm:"index"`
+	Status            EmployeeStatus `json:"status" binding:"omitempty,oneof=onboarding active on_leave terminated" gorm:"default:active;index"`
+	TerminationDate   *time.Time     `json:"termination_date,omitempty"`
+	TerminationReason string         `json:"termination_reason,omitempty"`
+	JoinDate          time.Time      `json:"join_date"`
+	// CustomFields holds the values of the tenant's custom fields by field name.
+	// Updates without it keep the stored values.
+	CustomFields CustomFieldValues `json:"custom_fields,omitempty" gorm:"serializer:json"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	DeletedAt    time.Time         `json:"deleted_at,omitempty" gorm:"index"`
+
+	// SalaryOverride is only read from requests; it is never stored on the employee
+	SalaryOverride *SalaryOverride `json:"salary_override,omitempty" gorm:"-"`
+
+	// Department, Manager and Tags are only filled in when a response expands them
+	Department *Department `json:"department,omitempty" gorm:"-"`
+	Manager    *Employee   `json:"manager,omitempty" gorm:"-"`
+	Tags       []string    `json:"tags,omitempty" gorm:"-"`
 }
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// EmployeeStatus is a stage in the employee lifecycle
+type EmployeeStatus string
+
+const (
+	StatusOnboarding EmployeeStatus = "onboarding"
+	StatusActive     EmployeeStatus = "active"
+	StatusOnLeave    EmployeeStatus = "on_leave"
+	StatusTerminated EmployeeStatus = "terminated"
+)
+
+// statusTransitions lists the statuses each status may move to. Terminated is final.
+var statusTransitions = map[EmployeeStatus][]EmployeeStatus{
+	StatusOnboarding: {StatusActive, StatusTerminated},
+	StatusActive:     {StatusOnLeave, StatusTerminated},
+	StatusOnLeave:    {StatusActive, StatusTerminated},
+}
+
+// CanTransitionTo reports whether the lifecycle allows moving from s to next
+func (s EmployeeStatus) CanTransitionTo(next EmployeeStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusTransitionRequest carries the data needed to move an employee to a new status
+type StatusTransitionRequest struct {
+	EffectiveDate *time.Time `json:"effective_date"`
+	Reason        string     `json:"reason"`
+	ChangedBy     string     `json:"-"`
+}
+
+// EmployeeStatusTransition records one change of an employee's status
+type EmployeeStatusTransition struct {
+	ID            uint           `json:"id" gorm:"primary_key"`
+	TenantID      string         `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	EmployeeID    uint           `json:"employee_id" gorm:"index"`
+	FromStatus    EmployeeStatus `json:"from_status"`
+	ToStatus      EmployeeStatus `json:"to_status"`
+	Reason        string         `json:"reason"`
+	EffectiveDate time.Time      `json:"effective_date"`
+	ChangedBy     string         `json:"changed_by"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
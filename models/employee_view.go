@@ -0,0 +1,135 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Relations an employee response can embed
+const (
+	ExpandDepartment = "department"
+	ExpandManager    = "manager"
+	ExpandTags       = "tags"
+)
+
+// employeeFields maps each selectable JSON field of Employee to its struct
+// field index. Selectable fields are the stored columns, whose names match
+// their JSON names.
+var employeeFields = func() map[string]int {
+	fields := make(map[string]int)
+	employeeType := reflect.TypeOf(Employee{})
+	for i := 0; i < employeeType.NumField(); i++ {
+		field := employeeType.Field(i)
+		if field.Tag.Get("gorm") == "-" || field.Tag.Get("json") == "-" {
+			continue
+		}
+		fields[jsonName(field)] = i
+	}
+	return fields
+}()
+
+// EmployeeView selects which fields an employee response includes and which
+// related resources it embeds. The zero value returns every field and embeds
+// nothing.
+type EmployeeView struct {
+	Fields []string
+	Expand []string
+}
+
+// ParseEmployeeView parses the comma separated fields and expand query
+// parameters, rejecting names it does not know
+func ParseEmployeeView(fields, expand string) (EmployeeView, error) {
+	var view EmployeeView
+	for _, field := range splitList(fields) {
+		if _, ok := employeeFields[field]; !ok {
+			return EmployeeView{}, fmt.Errorf("%w: unknown field %q", ErrValidation, field)
+		}
+		view.Fields = append(view.Fields, field)
+	}
+	for _, relation := range splitList(expand) {
+		if relation != ExpandDepartment && relation != ExpandManager && relation != ExpandTags {
+			return EmployeeView{}, fmt.Errorf("%w: cannot expand %q, use %s, %s or %s", ErrValidation, relation, ExpandDepartment, ExpandManager, ExpandTags)
+		}
+		view.Expand = append(view.Expand, relation)
+	}
+	return view, nil
+}
+
+// Sparse reports whether the view limits the returned fields
+func (v EmployeeView) Sparse() bool {
+	return len(v.Fields) > 0
+}
+
+// Expands reports whether the view embeds the given relation
+func (v EmployeeView) Expands(relation string) bool {
+	return contains(v.Expand, relation)
+}
+
+// Columns returns the columns to load for the view, including the foreign
+// keys the expanded relations need, or nil to load every column
+func (v EmployeeView) Columns() []string {
+	if !v.Sparse() {
+		return nil
+	}
+	columns := append([]string(nil), v.Fields...)
+	if v.Expands(ExpandDepartment) && !contains(columns, "department_id") {
+		columns = append(columns, "department_id")
+	}
+	if v.Expands(ExpandManager) && !contains(columns, "manager_id") {
+		columns = append(columns, "manager_id")
+	}
+	if v.Expands(ExpandTags) && !contains(columns, "id") {
+		columns = append(columns, "id")
+	}
+	return columns
+}
+
+// Project returns only the selected fields and expanded relations of the
+// employee, keyed by their JSON names
+func (v EmployeeView) Project(employee Employee) map[string]interface{} {
+	value := reflect.ValueOf(employee)
+	projected := make(map[string]interface{}, len(v.Fields)+len(v.Expand))
+	for _, field := range v.Fields {
+		projected[field] = value.Field(employeeFields[field]).Interface()
+	}
+	if v.Expands(ExpandDepartment) {
+		projected[ExpandDepartment] = employee.Department
+	}
+	if v.Expands(ExpandManager) {
+		projected[ExpandManager] = employee.Manager
+	}
+	if v.Expands(ExpandTags) {
+		projected[ExpandTags] = employee.Tags
+	}
+	return projected
+}
+
+// jsonName returns the name a struct field is encoded with in JSON
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// splitList splits a comma separated query parameter, dropping blanks and repeats
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" && !contains(items, item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func contains(items []string, item string) bool {
+	for _, existing := range items {
+		if existing == item {
+			return true
+		}
+	}
+	return false
+}
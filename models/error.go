@@ -1,6 +1,27 @@
 package models
 
+import "errors"
+
 // ErrorResponse defines the structure for error responses.
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// Domain errors returned by the repository and service layers. Controllers map
+// them to HTTP status codes, so wrap them with fmt.Errorf("%w") to add detail.
+var (
+	ErrEmployeeNotFound    = errors.New("employee not found")
+	ErrDepartmentNotFound  = errors.New("department not found")
+	ErrPositionNotFound    = errors.New("position not found")
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrDeliveryNotFound    = errors.New("webhook delivery not found")
+	ErrTenantNotFound      = errors.New("tenant not found")
+	ErrCustomFieldNotFound = errors.New("custom field not found")
+	ErrTagNotFound         = errors.New("tag not found")
+	ErrValidation          = errors.New("validation failed")
+	ErrConflict            = errors.New("conflict")
+	ErrInvalidTransition   = errors.New("invalid status transition")
+	ErrForbidden           = errors.New("forbidden")
+	ErrRolledBack          = errors.New("not applied because another item in the atomic batch failed")
+	ErrIdempotencyReused   = errors.New("idempotency key was already used for a different request")
+)
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+// EmployeeEventType names the change an employee event records
+type EmployeeEventType string
+
+const (
+	EventEmployeeCreated EmployeeEventType = "employee.created"
+	EventEmployeeUpdated EmployeeEventType = "employee.updated"
+	EventEmployeeDeleted EmployeeEventType = "employee.deleted"
+)
+
+// EmployeeEvent records one change to an employee. IDs increase by one with
+// every event, so a subscriber can resume after the last ID it saw.
+type EmployeeEvent struct {
+	ID         uint64            `json:"id"`
+	TenantID   string            `json:"tenant_id,omitempty"`
+	Type       EmployeeEventType `json:"type"`
+	EmployeeID uint              `json:"employee_id"`
+	// Employee is the record after the change, or the last stored record for deletions
+	Employee Employee `json:"employee"`
+	// Previous is the record before the change, for updates only
+	Previous   *Employee `json:"previous,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// EventFilter selects the events a subscriber receives. Empty fields match
+// every event; an event matches a department by the employee's department
+// after the change. Subscribers only receive their own tenant's events.
+type EventFilter struct {
+	// TenantID is set from the subscriber's request, an empty one matches every tenant
+	TenantID     string `form:"-" json:"-" xml:"-" yaml:"-" codec:"-"`
+	DepartmentID *uint  `form:"department_id" json:"department_id,omitempty"`
+	EmployeeIDs  []uint `form:"id" json:"employee_ids,omitempty"`
+}
+
+// Matches reports whether the event passes the filter
+func (f EventFilter) Matches(event EmployeeEvent) bool {
+	if f.TenantID != "" && event.TenantID != f.TenantID {
+		return false
+	}
+	if f.DepartmentID != nil {
+		if event.Employee.DepartmentID == nil || *event.Employee.DepartmentID != *f.DepartmentID {
+			return false
+		}
+	}
+	if len(f.EmployeeIDs) == 0 {
+		return true
+	}
+	for _, id := range f.EmployeeIDs {
+		if id == event.EmployeeID {
+			return true
+		}
+	}
+	return false
+}
+
+// EventClientMessage is a message from a WebSocket event client. Type is
+// subscribe, unsubscribe or ping; ID names the subscription the message is about.
+type EventClientMessage struct {
+	Type        string      `json:"type"`
+	ID          string      `json:"id,omitempty"`
+	Filter      EventFilter `json:"filter"`
+	LastEventID *uint64     `json:"last_event_id,omitempty"`
+}
+
+// EventServerMessage is a message to a WebSocket event client. Type is
+// subscribed, unsubscribed, event, reset, error or pong; ID names the
+// subscription the message is about.
+type EventServerMessage struct {
+	Type  string         `json:"type"`
+	ID    string         `json:"id,omitempty"`
+	Event *EmployeeEvent `json:"event,omitempty"`
+	Error string         `json:"error,omitempty"`
+}
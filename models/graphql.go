@@ -0,0 +1,8 @@
+package models
+
+// GraphQLRequest is the body of a GraphQL request
+type GraphQLRequest struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
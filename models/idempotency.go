@@ -0,0 +1,23 @@
+package models
+
+import (
+	"net/http"
+	"time"
+)
+
+// IdempotencyRecord remembers a request sent with an Idempotency-Key header
+// and, once the request has been handled, the response to replay for retries
+// of it. Keys are scoped to the caller, so two clients can use the same key.
+type IdempotencyRecord struct {
+	ID          uint   `gorm:"primary_key"`
+	Scope       string `gorm:"uniqueIndex:idx_idempotency_key"`
+	Key         string `gorm:"uniqueIndex:idx_idempotency_key"`
+	Fingerprint string
+	// Completed is false while the first request is still being handled
+	Completed  bool
+	StatusCode int
+	Header     http.Header `gorm:"serializer:json"`
+	Body       []byte
+	CreatedAt  time.Time
+	ExpiresAt  time.Time `gorm:"index"`
+}
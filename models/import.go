@@ -0,0 +1,43 @@
+package models
+
+// ImportAction is what an import does with a row
+type ImportAction string
+
+const (
+	// ImportCreate adds a new employee
+	ImportCreate ImportAction = "create"
+	// ImportUpdate updates the employee with the row's email
+	ImportUpdate ImportAction = "update"
+)
+
+// ImportRow is one parsed row of an employee import. Only the columns the
+// file provides are set on Patch; rows are matched to employees by email, so
+// Patch.ID is not used.
+type ImportRow struct {
+	Line   int
+	Patch  EmployeePatch
+	Status EmployeeStatus
+
+	// Err is set when the row could not be parsed
+	Err error
+}
+
+// ImportRowResult reports what happened, or would happen, to one row
+type ImportRowResult struct {
+	Line   int          `json:"line"`
+	Email  string       `json:"email,omitempty"`
+	Action ImportAction `json:"action,omitempty"`
+	ID     uint         `json:"id,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// ImportReport summarises an import. Nothing is written unless every row is
+// valid and the import is not a dry run, in which case Committed is true.
+type ImportReport struct {
+	DryRun    bool              `json:"dry_run"`
+	Committed bool              `json:"committed"`
+	Created   int               `json:"created"`
+	Updated   int               `json:"updated"`
+	Failed    int               `json:"failed"`
+	Rows      []ImportRowResult `json:"rows"`
+}
@@ -0,0 +1,9 @@
+package models
+
+// OrgChartNode is one employee in the org chart together with their reports
+type OrgChartNode struct {
+	ID       uint           `json:"id"`
+	Name     string         `json:"name"`
+	Position string         `json:"position"`
+	Reports  []OrgChartNode `json:"reports"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// OutboxEvent is an employee event stored in the same transaction as the
+// change it records. Its ID becomes the event ID, which sinks use to drop
+// events delivered to them more than once.
+type OutboxEvent struct {
+	ID         uint64            `gorm:"primaryKey;autoIncrement"`
+	TenantID   string            `gorm:"index"`
+	Type       EmployeeEventType `gorm:"size:32"`
+	EmployeeID uint              `gorm:"index"`
+	Employee   Employee          `gorm:"serializer:json"`
+	Previous   *Employee         `gorm:"serializer:json"`
+	OccurredAt time.Time         `gorm:"index"`
+}
+
+// Event returns the stored event as it is delivered to sinks
+func (e OutboxEvent) Event() EmployeeEvent {
+	return EmployeeEvent{
+		ID:         e.ID,
+		TenantID:   e.TenantID,
+		Type:       e.Type,
+		EmployeeID: e.EmployeeID,
+		Employee:   e.Employee,
+		Previous:   e.Previous,
+		OccurredAt: e.OccurredAt,
+	}
+}
+
+// OutboxCursor records the last outbox event a sink has accepted
+type OutboxCursor struct {
+	Sink        string `gorm:"primaryKey"`
+	LastEventID uint64
+	UpdatedAt   time.Time
+}
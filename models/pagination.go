@@ -0,0 +1,91 @@
+package models
+
+const (
+	// DefaultPageSize is used when a list request does not specify page_size
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page_size a list request may ask for
+	MaxPageSize = 100
+)
+
+// Pagination holds the page options shared by all list endpoints
+type Pagination struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// Normalize fills in defaults for page options that were not provided
+func (p *Pagination) Normalize() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		p.PageSize = MaxPageSize
+	}
+}
+
+// Offset returns the number of rows to skip for the current page
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+// EmployeeFilter holds the pagination and filter options for listing employees
+type EmployeeFilter struct {
+	Pagination
+	Name         string `form:"name"`
+	Email        string `form:"email"`
+	Position     string `form:"position"`
+	DepartmentID *uint  `form:"department_id"`
+	ManagerID    *uint  `form:"manager_id"`
+	Status       string `form:"status" binding:"omitempty,oneof=onboarding active on_leave terminated"`
+	// CustomFields matches custom field values by field name. They are read
+	// from custom_fields[name] query parameters as text and converted to the
+	// field's type by the service.
+	CustomFields map[string]interface{} `form:"-"`
+	// Tags matches the tags employees carry. It is read from the tags,
+	// tags_any and tags_not query parameters.
+	Tags TagQuery `form:"-"`
+
+	// View selects the columns and relations of the returned employees
+	View EmployeeView `form:"-"`
+}
+
+// FilterType is the type of the values a list filter accepts
+type FilterType string
+
+const (
+	FilterString  FilterType = "string"
+	FilterInteger FilterType = "integer"
+)
+
+// FilterField describes a query parameter that filters a generic resource list
+type FilterField struct {
+	// Name is the query parameter
+	Name string
+	// Column is the database column filtered on, Name when empty
+	Column string
+	// Type is the type of the accepted values, FilterString when empty
+	Type FilterType
+	// Enum lists the accepted values when only some are valid
+	Enum []string
+	// Partial matches rows whose column contains the value instead of equalling it
+	Partial     bool
+	Description string
+}
+
+// ColumnName returns the database column the filter applies to
+func (f FilterField) ColumnName() string {
+	if f.Column == "" {
+		return f.Name
+	}
+	return f.Column
+}
+
+// ListQuery holds the pagination and filters of a generic resource list
+type ListQuery struct {
+	Pagination
+	// Filters holds the value of each filter given, by query parameter
+	Filters map[string]string
+}
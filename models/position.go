@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+)
+
+// DefaultCurrency is assumed for salaries and salary bands that do not name one
+const DefaultCurrency = "USD"
+
+// Position is an entry in the managed job catalog
+type Position struct {
+	ID          uint         `json:"id" gorm:"primary_key"`
+	TenantID    string       `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"uniqueIndex:idx_position_title_level"`
+	Title       string       `json:"title" binding:"required" gorm:"uniqueIndex:idx_position_title_level"`
+	Level       string       `json:"level" gorm:"uniqueIndex:idx_position_title_level"`
+	Family      string       `json:"family"`
+	StrictBand  bool         `json:"strict_band"`
+	SalaryBands []SalaryBand `json:"salary_bands" binding:"omitempty,dive" gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// SalaryBand is the accepted salary range for a position in one currency
+type SalaryBand struct {
+	ID         uint    `json:"-" gorm:"primary_key"`
+	TenantID   string  `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	PositionID uint    `json:"-" gorm:"uniqueIndex:idx_band_position_currency"`
+	Currency   string  `json:"currency" binding:"required,len=3" gorm:"uniqueIndex:idx_band_position_currency"`
+	MinSalary  float64 `json:"min_salary" binding:"gte=0"`
+	MaxSalary  float64 `json:"max_salary" binding:"gtefield=MinSalary"`
+}
+
+// Contains reports whether the salary lies inside the band
+func (b SalaryBand) Contains(salary float64) bool {
+	return salary >= b.MinSalary && salary <= b.MaxSalary
+}
+
+// BandFor returns the position's salary band for the currency, if it has one
+func (p Position) BandFor(currency string) (SalaryBand, bool) {
+	for _, band := range p.SalaryBands {
+		if band.Currency == currency {
+			return band, true
+		}
+	}
+	return SalaryBand{}, false
+}
+
+// SalaryOverride asks to accept a salary outside the position's band
+type SalaryOverride struct {
+	Justification string `json:"justification" binding:"required"`
+	ApprovedBy    string `json:"-"`
+}
+
+// SalaryBandOverride records an accepted out-of-band salary and why it was allowed
+type SalaryBandOverride struct {
+	ID            uint      `json:"id" gorm:"primary_key"`
+	TenantID      string    `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	EmployeeID    uint      `json:"employee_id" gorm:"index"`
+	PositionID    uint      `json:"position_id"`
+	Salary        float64   `json:"salary"`
+	Currency      string    `json:"currency"`
+	MinSalary     float64   `json:"min_salary"`
+	MaxSalary     float64   `json:"max_salary"`
+	Justification string    `json:"justification"`
+	ApprovedBy    string    `json:"approved_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+// SalaryChange records a salary an employee was given and when. One is
+// stored when an employee is created and whenever their salary or currency
+// changes, in the same transaction as the change.
+type SalaryChange struct {
+	ID         uint      `json:"id" gorm:"primary_key"`
+	TenantID   string    `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	EmployeeID uint      `json:"employee_id" gorm:"index"`
+	Salary     float64   `json:"salary"`
+	Currency   string    `json:"currency"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
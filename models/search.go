@@ -0,0 +1,16 @@
+package models
+
+// SearchHit is one employee matching a search, in relevance order
+type SearchHit struct {
+	Employee   Employee         `json:"employee"`
+	Score      float64          `json:"score"`
+	Highlights SearchHighlights `json:"highlights"`
+}
+
+// SearchHighlights holds the searched fields with the matched words marked
+// as <mark>word</mark>; fields without a match are left empty
+type SearchHighlights struct {
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Position string `json:"position,omitempty"`
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// tagName is the form of tag names once normalized, such as on-call or team_lead
+var tagName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Tag is a label for grouping employees outside the position and department
+// structure, such as on-call, mentor or remote. Tags are created when first
+// given to an employee.
+type Tag struct {
+	ID        uint      `json:"id" gorm:"primary_key"`
+	TenantID  string    `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"uniqueIndex:idx_tag_name"`
+	Name      string    `json:"name" gorm:"uniqueIndex:idx_tag_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EmployeeTag joins employees to the tags they carry
+type EmployeeTag struct {
+	TenantID   string    `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	EmployeeID uint      `json:"employee_id" gorm:"primaryKey"`
+	TagID      uint      `json:"tag_id" gorm:"primaryKey;index"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TagCount is a tag with the number of employees carrying it
+type TagCount struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// TagsRequest is the body of a request adding tags to an employee
+type TagsRequest struct {
+	Tags []string `json:"tags" binding:"required,min=1"`
+}
+
+// TagQuery selects employees by their tags. An employee matches when they
+// carry every tag of All, at least one tag of Any and none of None; empty
+// lists match every employee.
+type TagQuery struct {
+	All  []string
+	Any  []string
+	None []string
+}
+
+// ParseTagQuery parses the comma separated tags, tags_any and tags_not query parameters
+func ParseTagQuery(all, some, none string) (TagQuery, error) {
+	var query TagQuery
+	var err error
+	if query.All, err = ParseTags(splitList(all)); err != nil {
+		return TagQuery{}, err
+	}
+	if query.Any, err = ParseTags(splitList(some)); err != nil {
+		return TagQuery{}, err
+	}
+	if query.None, err = ParseTags(splitList(none)); err != nil {
+		return TagQuery{}, err
+	}
+	return query, nil
+}
+
+// ParseTags normalizes tag names, dropping repeats
+func ParseTags(names []string) ([]string, error) {
+	var tags []string
+	for _, name := range names {
+		tag, err := NormalizeTag(name)
+		if err != nil {
+			return nil, err
+		}
+		if !contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+// NormalizeTag returns the name a tag is stored under: trimmed and lowercase.
+// Tags start with a letter or digit followed by up to 62 letters, digits,
+// hyphens or underscores.
+func NormalizeTag(name string) (string, error) {
+	tag := strings.ToLower(strings.TrimSpace(name))
+	if !tagName.MatchString(tag) {
+		return "", fmt.Errorf("%w: invalid tag %q, tags start with a letter or digit followed by up to 62 letters, digits, hyphens or underscores", ErrValidation, name)
+	}
+	return tag, nil
+}
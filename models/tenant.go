@@ -0,0 +1,42 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// tenantIDPattern keeps tenant IDs usable as subdomain labels
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Tenant is a subsidiary or other organisation sharing the deployment. Its
+// employees, departments, job catalog and webhooks are kept apart from
+// those of every other tenant.
+type Tenant struct {
+	// ID names the tenant in the X-Tenant-ID header, subdomains and token claims
+	ID   string `json:"id" binding:"required" gorm:"primaryKey"`
+	Name string `json:"name" binding:"required"`
+	// Currency is assumed for the tenant's salaries that do not name one
+	Currency string `json:"currency" binding:"omitempty,len=3"`
+	// StrictSalaryBands rejects salaries outside the band of any of the
+	// tenant's positions, as if every position were strict
+	StrictSalaryBands bool      `json:"strict_salary_bands"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Validate checks that the ID can be used as a subdomain label
+func (t Tenant) Validate() error {
+	if !tenantIDPattern.MatchString(t.ID) {
+		return fmt.Errorf("%w: tenant id %q must be lowercase letters, digits and hyphens", ErrValidation, t.ID)
+	}
+	return nil
+}
+
+// SalaryCurrency returns the currency assumed for the tenant's salaries
+func (t Tenant) SalaryCurrency() string {
+	if t.Currency == "" {
+		return DefaultCurrency
+	}
+	return t.Currency
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook-only event types. They are derived from employee.updated events, which
+// are still delivered to webhooks that subscribe to them.
+const (
+	EventEmployeeTerminated    EmployeeEventType = "employee.terminated"
+	EventEmployeeSalaryChanged EmployeeEventType = "employee.salary_changed"
+)
+
+// WebhookEventTypes returns the webhook event types an employee event is delivered as
+func WebhookEventTypes(event EmployeeEvent) []EmployeeEventType {
+	types := []EmployeeEventType{event.Type}
+	if event.Type != EventEmployeeUpdated || event.Previous == nil {
+		return types
+	}
+	if event.Employee.Status == StatusTerminated && event.Previous.Status != StatusTerminated {
+		types = append(types, EventEmployeeTerminated)
+	}
+	if event.Employee.Salary != event.Previous.Salary || event.Employee.Currency != event.Previous.Currency {
+		types = append(types, EventEmployeeSalaryChanged)
+	}
+	return types
+}
+
+// Webhook is a subscription that receives matching employee events as signed
+// HTTP POST requests. The secret is only returned when the webhook is created.
+type Webhook struct {
+	ID         uint                `json:"id" gorm:"primary_key"`
+	TenantID   string              `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	URL        string              `json:"url" binding:"required,url"`
+	EventTypes []EmployeeEventType `json:"event_types" binding:"required,min=1,dive,oneof=employee.created employee.updated employee.deleted employee.terminated employee.salary_changed" gorm:"serializer:json"`
+	Secret     string              `json:"secret,omitempty"`
+	Disabled   bool                `json:"disabled"`
+	CreatedAt  time.Time           `json:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// Subscribes reports whether the webhook receives events of the type
+func (w Webhook) Subscribes(eventType EmployeeEventType) bool {
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// DeliveryStatus is the state of a webhook delivery
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	// DeliveryDead marks a delivery that failed every attempt; it stays on the
+	// dead-letter list until it is retried
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// WebhookDelivery is one event queued for one webhook, with the outcome of its
+// latest attempt. An event is queued once per webhook and type however often
+// it is relayed, and the ID is sent with every attempt so receivers can drop
+// duplicates.
+type WebhookDelivery struct {
+	ID             uint              `json:"id" gorm:"primary_key"`
+	TenantID       string            `json:"-" xml:"-" yaml:"-" codec:"-" gorm:"index"`
+	WebhookID      uint              `json:"webhook_id" gorm:"uniqueIndex:idx_delivery_event"`
+	EventID        uint64            `json:"event_id" gorm:"uniqueIndex:idx_delivery_event"`
+	EventType      EmployeeEventType `json:"event_type" gorm:"uniqueIndex:idx_delivery_event"`
+	Payload        json.RawMessage   `json:"payload" gorm:"type:text" swaggertype:"object"`
+	Status         DeliveryStatus    `json:"status" gorm:"index"`
+	Attempts       int               `json:"attempts"`
+	NextAttemptAt  time.Time         `json:"next_attempt_at" gorm:"index"`
+	LastAttemptAt  *time.Time        `json:"last_attempt_at,omitempty"`
+	ResponseStatus int               `json:"response_status,omitempty"`
+	LastError      string            `json:"last_error,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+}
+
+// WebhookPayload is the body POSTed to a webhook
+type WebhookPayload struct {
+	EventID    uint64            `json:"event_id"`
+	Type       EmployeeEventType `json:"type"`
+	EmployeeID uint              `json:"employee_id"`
+	Employee   Employee          `json:"employee"`
+	Previous   *Employee         `json:"previous,omitempty"`
+	OccurredAt time.Time         `json:"occurred_at"`
+}
@@ -0,0 +1,281 @@
+// Package openapi documents routes that are registered at runtime, such as
+// those of the CRUD controller builder, in the Swagger spec swag generates
+// from the handler annotations. It also serves the spec with properties
+// that depend on the request, such as the custom fields of the caller's tenant.
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/swaggo/swag"
+)
+
+// Schema is a Swagger 2.0 schema object
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+}
+
+// Parameter is a Swagger 2.0 parameter object
+type Parameter struct {
+	Name        string   `json:"name"`
+	In          string   `json:"in"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	Schema      *Schema  `json:"schema,omitempty"`
+}
+
+// Response is a Swagger 2.0 response object
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+// Operation is a Swagger 2.0 operation object
+type Operation struct {
+	Summary     string              `json:"summary,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Consumes    []string            `json:"consumes,omitempty"`
+	Produces    []string            `json:"produces,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// PropertyFunc returns the schema of a property that depends on the request,
+// such as one describing the custom fields of the caller's tenant
+type PropertyFunc func(ctx context.Context) (*Schema, error)
+
+var (
+	mu          sync.Mutex
+	operations  = make(map[string]map[string]Operation)
+	definitions = make(map[string]*Schema)
+	properties  = make(map[string]map[string]PropertyFunc)
+	timeType    = reflect.TypeOf(time.Time{})
+)
+
+// Document adds an operation to the spec. route is the full route with
+// Swagger path parameters, such as /api/v1/employees/{id}.
+func Document(route, method string, operation Operation) {
+	mu.Lock()
+	defer mu.Unlock()
+	if operations[route] == nil {
+		operations[route] = make(map[string]Operation)
+	}
+	operations[route][strings.ToLower(method)] = operation
+}
+
+// DocumentProperty makes a property of a definition, such as
+// models.Employee, depend on the request. Handler resolves it each time the
+// spec is served.
+func DocumentProperty(definition, property string, fn PropertyFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	if properties[definition] == nil {
+		properties[definition] = make(map[string]PropertyFunc)
+	}
+	properties[definition][property] = fn
+}
+
+// SchemaOf returns the schema of values of type t. Structs are referenced
+// by definition, named like swag names them, such as models.Employee.
+func SchemaOf(t reflect.Type) *Schema {
+	mu.Lock()
+	defer mu.Unlock()
+	return schemaOf(t)
+}
+
+// ObjectSchema is the schema of free-form objects, such as {"error": "..."}
+func ObjectSchema() *Schema {
+	return &Schema{Type: "object", AdditionalProperties: true}
+}
+
+// Merge adds the documented operations and the definitions they reference
+// to spec. Operations and definitions the spec already has are kept, since
+// the annotations they were generated from describe them more closely. The
+// spec's info, such as its host and base path, must be set beforehand.
+func Merge(spec *swag.Spec) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(spec.ReadDoc()), &doc); err != nil {
+		return err
+	}
+	paths := section(doc, "paths")
+	for route, methods := range operations {
+		route = strings.TrimPrefix(route, strings.TrimSuffix(spec.BasePath, "/"))
+		documented, _ := paths[route].(map[string]interface{})
+		if documented == nil {
+			documented = make(map[string]interface{})
+			paths[route] = documented
+		}
+		for method, operation := range methods {
+			if _, ok := documented[method]; !ok {
+				documented[method] = operation
+			}
+		}
+	}
+	defs := section(doc, "definitions")
+	for name, schema := range definitions {
+		if _, ok := defs[name]; !ok {
+			defs[name] = schema
+		}
+	}
+
+	merged, err := json.MarshalIndent(doc, "", "    ")
+	if err != nil {
+		return err
+	}
+	spec.SwaggerTemplate = string(merged)
+	return nil
+}
+
+// Handler serves doc.json with the properties documented with
+// DocumentProperty resolved for the request, and passes the other requests,
+// such as those for the Swagger UI, to next
+func Handler(spec *swag.Spec, next gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasSuffix(c.Request.URL.Path, "/doc.json") {
+			next(c)
+			return
+		}
+		doc, err := Resolve(c.Request.Context(), spec)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", doc)
+	}
+}
+
+// Resolve returns spec with the properties documented with DocumentProperty
+// resolved for ctx. Properties of definitions the spec lacks are skipped.
+func Resolve(ctx context.Context, spec *swag.Spec) ([]byte, error) {
+	mu.Lock()
+	resolvers := make(map[string]map[string]PropertyFunc, len(properties))
+	for definition, fns := range properties {
+		resolvers[definition] = maps.Clone(fns)
+	}
+	mu.Unlock()
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(spec.ReadDoc()), &doc); err != nil {
+		return nil, err
+	}
+	defs := section(doc, "definitions")
+	for definition, fns := range resolvers {
+		schema, _ := defs[definition].(map[string]interface{})
+		if schema == nil {
+			continue
+		}
+		props := section(schema, "properties")
+		for property, fn := range fns {
+			resolved, err := fn(ctx)
+			if err != nil {
+				return nil, err
+			}
+			props[property] = resolved
+		}
+	}
+	return json.Marshal(doc)
+}
+
+// section returns the object under key in doc, adding it when missing
+func section(doc map[string]interface{}, key string) map[string]interface{} {
+	object, _ := doc[key].(map[string]interface{})
+	if object == nil {
+		object = make(map[string]interface{})
+		doc[key] = object
+	}
+	return object
+}
+
+func schemaOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: schemaOf(t.Elem())}
+	case reflect.Struct:
+		name := path.Base(t.PkgPath()) + "." + t.Name()
+		if _, ok := definitions[name]; !ok {
+			// Registered before the fields, so self-references end here
+			definitions[name] = &Schema{Type: "object"}
+			definitions[name] = structSchema(t)
+		}
+		return &Schema{Ref: "#/definitions/" + name}
+	default:
+		return &Schema{Type: "object"}
+	}
+}
+
+// structSchema documents the JSON encoding of a struct
+func structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		// Like encoding/json, the fields of embedded structs are promoted
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded := structSchema(field.Type)
+			for property, s := range embedded.Properties {
+				schema.Properties[property] = s
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = schemaOf(field.Type)
+		if slices.Contains(strings.Split(field.Tag.Get("binding"), ","), "required") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
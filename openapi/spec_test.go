@@ -0,0 +1,114 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/swaggo/swag"
+)
+
+type part struct {
+	ID       uint              `json:"id"`
+	Name     string            `json:"name" binding:"required,max=10"`
+	Parent   *part             `json:"parent,omitempty"`
+	Labels   map[string]string `json:"labels"`
+	Sizes    []float64         `json:"sizes"`
+	Internal string            `json:"-"`
+	Made     time.Time         `json:"made"`
+	Tags     []tag             `json:"tags"`
+	stamp
+}
+
+type tag struct {
+	Name string `json:"name"`
+}
+
+type stamp struct {
+	By string `json:"by"`
+}
+
+type SpecTestSuite struct {
+	suite.Suite
+}
+
+func TestSpecTestSuite(t *testing.T) {
+	suite.Run(t, new(SpecTestSuite))
+}
+
+func (suite *SpecTestSuite) TestSchemaOf() {
+	suite.Equal(&Schema{Ref: "#/definitions/openapi.part"}, SchemaOf(reflect.TypeOf(&part{})))
+
+	definition := definitions["openapi.part"]
+	suite.Equal([]string{"name"}, definition.Required)
+	suite.Equal(&Schema{Ref: "#/definitions/openapi.part"}, definition.Properties["parent"])
+	suite.Equal(&Schema{Type: "string", Format: "date-time"}, definition.Properties["made"])
+	suite.Equal(&Schema{Type: "array", Items: &Schema{Type: "number"}}, definition.Properties["sizes"])
+	suite.Equal(&Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, definition.Properties["labels"])
+	suite.Contains(definition.Properties, "by")
+	suite.NotContains(definition.Properties, "Internal")
+}
+
+func (suite *SpecTestSuite) TestMergeKeepsAnnotatedOperations() {
+	Document("/api/v1/parts", "GET", Operation{Summary: "Generated list"})
+	Document("/api/v1/parts", "POST", Operation{Summary: "Generated create", Parameters: []Parameter{
+		{Name: "part", In: "body", Schema: SchemaOf(reflect.TypeOf(part{}))},
+	}})
+	spec := &swag.Spec{BasePath: "/api/v1", SwaggerTemplate: `{"swagger":"2.0",
+		"paths":{"/parts":{"get":{"summary":"Annotated list"}}},
+		"definitions":{"openapi.part":{"type":"object","description":"Annotated"}}}`}
+	suite.Require().NoError(Merge(spec))
+
+	var doc struct {
+		Paths       map[string]map[string]Operation `json:"paths"`
+		Definitions map[string]map[string]interface{}
+	}
+	suite.Require().NoError(json.Unmarshal([]byte(spec.ReadDoc()), &doc))
+	suite.Equal("Annotated list", doc.Paths["/parts"]["get"].Summary)
+	suite.Equal("Generated create", doc.Paths["/parts"]["post"].Summary)
+	suite.Equal("Annotated", doc.Definitions["openapi.part"]["description"])
+	suite.Contains(doc.Definitions, "openapi.tag")
+}
+
+func (suite *SpecTestSuite) TestHandlerResolvesPropertiesPerRequest() {
+	type audienceKey struct{}
+	DocumentProperty("openapi.part", "labels", func(ctx context.Context) (*Schema, error) {
+		return &Schema{Type: "object", Description: "Labels of " + ctx.Value(audienceKey{}).(string)}, nil
+	})
+	DocumentProperty("openapi.missing", "labels", func(context.Context) (*Schema, error) {
+		return nil, errors.New("not resolved for definitions the spec lacks")
+	})
+	spec := &swag.Spec{SwaggerTemplate: `{"swagger":"2.0",
+		"definitions":{"openapi.part":{"type":"object","properties":{"name":{"type":"string"}}}}}`}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/swagger/*any", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), audienceKey{}, c.GetHeader("X-Audience")))
+	}, Handler(spec, func(c *gin.Context) { c.String(http.StatusOK, "swagger ui") }))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/swagger/doc.json", nil)
+	req.Header.Set("X-Audience", "acme")
+	router.ServeHTTP(w, req)
+	suite.Equal(http.StatusOK, w.Code)
+	var doc struct {
+		Definitions map[string]Schema `json:"definitions"`
+	}
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &doc))
+	part := doc.Definitions["openapi.part"]
+	suite.Equal("Labels of acme", part.Properties["labels"].Description)
+	suite.Equal("string", part.Properties["name"].Type)
+	suite.NotContains(doc.Definitions, "openapi.missing")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil))
+	suite.Equal("swagger ui", w.Body.String())
+}
@@ -0,0 +1,39 @@
+// Package outbox relays employee events stored in the outbox table to the
+// sinks that consume them.
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+const (
+	// DefaultPollInterval is how often the relay looks for new outbox events
+	DefaultPollInterval = 250 * time.Millisecond
+	// DefaultRetention is how long delivered events stay in the outbox
+	DefaultRetention = 24 * time.Hour
+	// DefaultBatchSize is how many events a sink receives at a time
+	DefaultBatchSize = 100
+)
+
+// Sink consumes outbox events in ID order. Delivery is at least once: after a
+// failure or a restart a sink may receive events it has already seen, and
+// should drop them by ID.
+type Sink interface {
+	// Name identifies the sink's cursor, so it must stay the same across restarts
+	Name() string
+	Deliver(events []models.EmployeeEvent) error
+}
+
+// Relay moves outbox events to its sinks. Each sink has its own cursor, so a
+// failing sink holds back only itself and retries the same events later.
+type Relay interface {
+	// DispatchPending delivers every stored event each sink has not accepted
+	// yet, then removes events all sinks have accepted once they are older
+	// than the retention period
+	DispatchPending() error
+	// Run calls DispatchPending every poll interval until ctx is done
+	Run(ctx context.Context)
+}
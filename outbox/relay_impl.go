@@ -0,0 +1,136 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+type relayImpl struct {
+	outboxRepo   repo.OutboxRepository
+	sinks        []Sink
+	pollInterval time.Duration
+	retention    time.Duration
+	batchSize    int
+	now          func() time.Time
+}
+
+// RelayOption configures optional Relay behaviour
+type RelayOption func(*relayImpl)
+
+// WithPollInterval sets how often the relay looks for new events. Non-positive
+// values keep the default.
+func WithPollInterval(interval time.Duration) RelayOption {
+	return func(r *relayImpl) {
+		if interval > 0 {
+			r.pollInterval = interval
+		}
+	}
+}
+
+// WithRetention sets how long delivered events stay in the outbox.
+// Non-positive values keep the default.
+func WithRetention(retention time.Duration) RelayOption {
+	return func(r *relayImpl) {
+		if retention > 0 {
+			r.retention = retention
+		}
+	}
+}
+
+// NewRelay returns a Relay delivering the events stored by outboxRepo to the sinks
+func NewRelay(outboxRepo repo.OutboxRepository, sinks []Sink, opts ...RelayOption) Relay {
+	r := &relayImpl{
+		outboxRepo:   outboxRepo,
+		sinks:        sinks,
+		pollInterval: DefaultPollInterval,
+		retention:    DefaultRetention,
+		batchSize:    DefaultBatchSize,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func (r *relayImpl) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.DispatchPending(); err != nil {
+				log.Printf("outbox: %v", err)
+			}
+		}
+	}
+}
+
+func (r *relayImpl) DispatchPending() error {
+	// The outbox holds the events of every tenant
+	ctx := tenant.System(context.Background())
+	cursors, err := r.outboxRepo.FindCursors(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	delivered := make([]uint64, len(r.sinks))
+	for i, sink := range r.sinks {
+		cursor, err := r.dispatch(ctx, sink, cursors[sink.Name()])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("sink %s: %w", sink.Name(), err))
+		}
+		delivered[i] = cursor
+	}
+	if len(r.sinks) > 0 {
+		if _, err := r.outboxRepo.DeleteThrough(ctx, minID(delivered), r.now().Add(-r.retention)); err != nil {
+			errs = append(errs, fmt.Errorf("purging delivered events: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// dispatch delivers the events after the cursor to the sink one batch at a
+// time, saving the cursor after each accepted batch, and returns the new cursor
+func (r *relayImpl) dispatch(ctx context.Context, sink Sink, cursor uint64) (uint64, error) {
+	for {
+		stored, err := r.outboxRepo.FindAfter(ctx, cursor, r.batchSize)
+		if err != nil || len(stored) == 0 {
+			return cursor, err
+		}
+		events := make([]models.EmployeeEvent, len(stored))
+		for i := range stored {
+			events[i] = stored[i].Event()
+		}
+		if err := sink.Deliver(events); err != nil {
+			return cursor, err
+		}
+		last := events[len(events)-1].ID
+		if err := r.outboxRepo.SaveCursor(ctx, sink.Name(), last); err != nil {
+			return cursor, err
+		}
+		cursor = last
+		if len(stored) < r.batchSize {
+			return cursor, nil
+		}
+	}
+}
+
+func minID(ids []uint64) uint64 {
+	lowest := ids[0]
+	for _, id := range ids[1:] {
+		if id < lowest {
+			lowest = id
+		}
+	}
+	return lowest
+}
@@ -0,0 +1,128 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// recordingSink remembers the event IDs it accepted and fails while err is set
+type recordingSink struct {
+	name string
+	ids  []uint64
+	err  error
+}
+
+func (s *recordingSink) Name() string {
+	return s.name
+}
+
+func (s *recordingSink) Deliver(events []models.EmployeeEvent) error {
+	if s.err != nil {
+		return s.err
+	}
+	for _, event := range events {
+		s.ids = append(s.ids, event.ID)
+	}
+	return nil
+}
+
+type RelayTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	repo   *mocks.MockOutboxRepository
+	bus    *recordingSink
+	hooks  *recordingSink
+	now    time.Time
+	relay  *relayImpl
+	stored []models.OutboxEvent
+}
+
+func (suite *RelayTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mocks.NewMockOutboxRepository(suite.ctrl)
+	suite.bus = &recordingSink{name: "bus"}
+	suite.hooks = &recordingSink{name: "webhooks"}
+	suite.now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	suite.relay = NewRelay(suite.repo, []Sink{suite.bus, suite.hooks}, WithRetention(time.Hour)).(*relayImpl)
+	suite.relay.batchSize = 2
+	suite.relay.now = func() time.Time { return suite.now }
+	suite.stored = nil
+	for id := uint64(1); id <= 3; id++ {
+		suite.stored = append(suite.stored, models.OutboxEvent{ID: id, Type: models.EventEmployeeCreated, EmployeeID: uint(id)})
+	}
+	// FindAfter serves the stored events like the table would
+	suite.repo.EXPECT().FindAfter(gomock.Any(), gomock.Any(), 2).DoAndReturn(func(_ context.Context, after uint64, limit int) ([]models.OutboxEvent, error) {
+		var page []models.OutboxEvent
+		for _, event := range suite.stored {
+			if event.ID > after && len(page) < limit {
+				page = append(page, event)
+			}
+		}
+		return page, nil
+	}).AnyTimes()
+}
+
+func (suite *RelayTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayTestSuite))
+}
+
+func (suite *RelayTestSuite) TestDispatchPendingDeliversInBatchesFromEachCursor() {
+	suite.repo.EXPECT().FindCursors(gomock.Any()).Return(map[string]uint64{"webhooks": 2}, nil)
+	suite.repo.EXPECT().SaveCursor(gomock.Any(), "bus", uint64(2)).Return(nil)
+	suite.repo.EXPECT().SaveCursor(gomock.Any(), "bus", uint64(3)).Return(nil)
+	suite.repo.EXPECT().SaveCursor(gomock.Any(), "webhooks", uint64(3)).Return(nil)
+	suite.repo.EXPECT().DeleteThrough(gomock.Any(), uint64(3), suite.now.Add(-time.Hour)).Return(int64(0), nil)
+
+	suite.NoError(suite.relay.DispatchPending())
+	suite.Equal([]uint64{1, 2, 3}, suite.bus.ids)
+	suite.Equal([]uint64{3}, suite.hooks.ids)
+}
+
+func (suite *RelayTestSuite) TestFailingSinkHoldsBackOnlyItself() {
+	suite.hooks.err = errors.New("database is locked")
+	suite.repo.EXPECT().FindCursors(gomock.Any()).Return(map[string]uint64{"bus": 1, "webhooks": 1}, nil)
+	suite.repo.EXPECT().SaveCursor(gomock.Any(), "bus", uint64(3)).Return(nil)
+	// Events are only purged up to the slowest sink's cursor
+	suite.repo.EXPECT().DeleteThrough(gomock.Any(), uint64(1), suite.now.Add(-time.Hour)).Return(int64(1), nil)
+
+	err := suite.relay.DispatchPending()
+	suite.ErrorContains(err, "sink webhooks: database is locked")
+	suite.Equal([]uint64{2, 3}, suite.bus.ids)
+	suite.Empty(suite.hooks.ids)
+
+	// The failed events are delivered again once the sink recovers
+	suite.hooks.err = nil
+	suite.repo.EXPECT().FindCursors(gomock.Any()).Return(map[string]uint64{"bus": 3, "webhooks": 1}, nil)
+	suite.repo.EXPECT().SaveCursor(gomock.Any(), "webhooks", uint64(3)).Return(nil)
+	suite.repo.EXPECT().DeleteThrough(gomock.Any(), uint64(3), suite.now.Add(-time.Hour)).Return(int64(2), nil)
+	suite.NoError(suite.relay.DispatchPending())
+	suite.Equal([]uint64{2, 3}, suite.hooks.ids)
+}
+
+func (suite *RelayTestSuite) TestUnsavedCursorRedeliversBatch() {
+	suite.relay.sinks = []Sink{suite.bus}
+	suite.repo.EXPECT().FindCursors(gomock.Any()).Return(map[string]uint64{"bus": 2}, nil)
+	suite.repo.EXPECT().SaveCursor(gomock.Any(), "bus", uint64(3)).Return(errors.New("disk full"))
+	suite.repo.EXPECT().DeleteThrough(gomock.Any(), uint64(2), gomock.Any()).Return(int64(0), nil)
+
+	suite.Error(suite.relay.DispatchPending())
+	suite.Equal([]uint64{3}, suite.bus.ids)
+
+	suite.repo.EXPECT().FindCursors(gomock.Any()).Return(map[string]uint64{"bus": 2}, nil)
+	suite.repo.EXPECT().SaveCursor(gomock.Any(), "bus", uint64(3)).Return(nil)
+	suite.repo.EXPECT().DeleteThrough(gomock.Any(), uint64(3), gomock.Any()).Return(int64(0), nil)
+	suite.NoError(suite.relay.DispatchPending())
+	// At-least-once: the sink sees event 3 again and drops it by ID
+	suite.Equal([]uint64{3, 3}, suite.bus.ids)
+}
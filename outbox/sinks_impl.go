@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+// busSink publishes events to the in-process broker, which drops the ones it
+// has already published
+type busSink struct {
+	broker events.Broker
+}
+
+// NewBusSink returns a Sink feeding the broker behind the event stream and WebSocket endpoints
+func NewBusSink(broker events.Broker) Sink {
+	return &busSink{broker: broker}
+}
+
+func (s *busSink) Name() string {
+	return "bus"
+}
+
+func (s *busSink) Deliver(events []models.EmployeeEvent) error {
+	s.broker.Publish(events...)
+	return nil
+}
+
+// EventEnqueuer queues an event for later delivery. Queueing the same event
+// twice must not deliver it twice.
+type EventEnqueuer interface {
+	EnqueueEvent(ctx context.Context, event models.EmployeeEvent) error
+}
+
+// webhookSink queues webhook deliveries for every event
+type webhookSink struct {
+	webhooks EventEnqueuer
+}
+
+// NewWebhookSink returns a Sink queueing webhook deliveries through the enqueuer
+func NewWebhookSink(webhooks EventEnqueuer) Sink {
+	return &webhookSink{webhooks: webhooks}
+}
+
+func (s *webhookSink) Name() string {
+	return "webhooks"
+}
+
+func (s *webhookSink) Deliver(events []models.EmployeeEvent) error {
+	for _, event := range events {
+		// Each event goes to the webhooks of the tenant it happened in
+		tenantID := event.TenantID
+		if tenantID == "" {
+			tenantID = tenant.Default
+		}
+		if err := s.webhooks.EnqueueEvent(tenant.NewContext(context.Background(), tenantID), event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileSink appends events to a file as newline-delimited JSON
+type fileSink struct {
+	path string
+}
+
+// NewFileSink returns a Sink appending one JSON event per line to the file at
+// path, which is created if needed. After a restart the file may repeat the
+// last events written; readers drop them by id.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{path: path}, file.Close()
+}
+
+func (s *fileSink) Name() string {
+	return "file"
+}
+
+func (s *fileSink) Deliver(events []models.EmployeeEvent) error {
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
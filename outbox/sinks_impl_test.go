@@ -0,0 +1,81 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/stretchr/testify/suite"
+)
+
+type SinksTestSuite struct {
+	suite.Suite
+}
+
+func TestSinksTestSuite(t *testing.T) {
+	suite.Run(t, new(SinksTestSuite))
+}
+
+func (suite *SinksTestSuite) TestBusSinkPublishesOnce() {
+	broker := events.NewBroker(10)
+	subscription := broker.Subscribe(models.EventFilter{}, nil)
+	defer subscription.Close()
+	sink := NewBusSink(broker)
+	batch := []models.EmployeeEvent{{ID: 1, Type: models.EventEmployeeCreated}, {ID: 2, Type: models.EventEmployeeDeleted}}
+
+	suite.NoError(sink.Deliver(batch))
+	suite.NoError(sink.Deliver(batch))
+	suite.Len(subscription.C, 2)
+}
+
+type enqueuerFunc func(context.Context, models.EmployeeEvent) error
+
+func (f enqueuerFunc) EnqueueEvent(ctx context.Context, event models.EmployeeEvent) error {
+	return f(ctx, event)
+}
+
+func (suite *SinksTestSuite) TestWebhookSinkEnqueuesEveryEventForItsTenant() {
+	var queued []uint64
+	var tenants []string
+	sink := NewWebhookSink(enqueuerFunc(func(ctx context.Context, event models.EmployeeEvent) error {
+		queued = append(queued, event.ID)
+		id, _ := tenant.FromContext(ctx)
+		tenants = append(tenants, id)
+		return nil
+	}))
+
+	// Events stored before tenants belong to the default tenant
+	suite.NoError(sink.Deliver([]models.EmployeeEvent{{ID: 4, TenantID: "acme"}, {ID: 5}}))
+	suite.Equal([]uint64{4, 5}, queued)
+	suite.Equal([]string{"acme", tenant.Default}, tenants)
+}
+
+func (suite *SinksTestSuite) TestFileSinkAppendsJSONLines() {
+	path := filepath.Join(suite.T().TempDir(), "events.ndjson")
+	sink, err := NewFileSink(path)
+	suite.Require().NoError(err)
+
+	suite.NoError(sink.Deliver([]models.EmployeeEvent{{ID: 1, Type: models.EventEmployeeCreated, EmployeeID: 7}}))
+	suite.NoError(sink.Deliver([]models.EmployeeEvent{{ID: 2, Type: models.EventEmployeeDeleted, EmployeeID: 7}}))
+
+	file, err := os.Open(path)
+	suite.Require().NoError(err)
+	defer file.Close()
+	var ids []uint64
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event models.EmployeeEvent
+		suite.NoError(json.Unmarshal(scanner.Bytes(), &event))
+		ids = append(ids, event.ID)
+	}
+	suite.Equal([]uint64{1, 2}, ids)
+
+	_, err = NewFileSink(filepath.Join(path, "not-a-directory", "events.ndjson"))
+	suite.Error(err)
+}
@@ -0,0 +1,1068 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: employee/v1/employee.proto
+
+package employeev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Employee struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Position        string                 `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	PositionId      *uint32                `protobuf:"varint,5,opt,name=position_id,json=positionId,proto3,oneof" json:"position_id,omitempty"`
+	Salary          float64                `protobuf:"fixed64,6,opt,name=salary,proto3" json:"salary,omitempty"`
+	Currency        string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	SalaryOutOfBand bool                   `protobuf:"varint,8,opt,name=salary_out_of_band,json=salaryOutOfBand,proto3" json:"salary_out_of_band,omitempty"`
+	DepartmentId    *uint32                `protobuf:"varint,9,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	ManagerId       *uint32                `protobuf:"varint,10,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	// One of onboarding, active, on_leave or terminated
+	Status            string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	TerminationDate   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=termination_date,json=terminationDate,proto3" json:"termination_date,omitempty"`
+	TerminationReason string                 `protobuf:"bytes,13,opt,name=termination_reason,json=terminationReason,proto3" json:"termination_reason,omitempty"`
+	JoinDate          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=join_date,json=joinDate,proto3" json:"join_date,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Employee) Reset() {
+	*x = Employee{}
+	mi := &file_employee_v1_employee_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Employee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Employee) ProtoMessage() {}
+
+func (x *Employee) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Employee.ProtoReflect.Descriptor instead.
+func (*Employee) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Employee) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Employee) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Employee) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Employee) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Employee) GetPositionId() uint32 {
+	if x != nil && x.PositionId != nil {
+		return *x.PositionId
+	}
+	return 0
+}
+
+func (x *Employee) GetSalary() float64 {
+	if x != nil {
+		return x.Salary
+	}
+	return 0
+}
+
+func (x *Employee) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Employee) GetSalaryOutOfBand() bool {
+	if x != nil {
+		return x.SalaryOutOfBand
+	}
+	return false
+}
+
+func (x *Employee) GetDepartmentId() uint32 {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return 0
+}
+
+func (x *Employee) GetManagerId() uint32 {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return 0
+}
+
+func (x *Employee) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Employee) GetTerminationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TerminationDate
+	}
+	return nil
+}
+
+func (x *Employee) GetTerminationReason() string {
+	if x != nil {
+		return x.TerminationReason
+	}
+	return ""
+}
+
+func (x *Employee) GetJoinDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinDate
+	}
+	return nil
+}
+
+func (x *Employee) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SalaryOverride accepts a salary outside the position's band. It requires
+// the salary:override permission.
+type SalaryOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Justification string                 `protobuf:"bytes,1,opt,name=justification,proto3" json:"justification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalaryOverride) Reset() {
+	*x = SalaryOverride{}
+	mi := &file_employee_v1_employee_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalaryOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalaryOverride) ProtoMessage() {}
+
+func (x *SalaryOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalaryOverride.ProtoReflect.Descriptor instead.
+func (*SalaryOverride) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SalaryOverride) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+type GetEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeRequest) Reset() {
+	*x = GetEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeRequest) ProtoMessage() {}
+
+func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetEmployeeRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number, starting at 1 (default 1)
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Page size (default 20, max 100)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Substring of the name
+	Name          string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string  `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Position      string  `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	DepartmentId  *uint32 `protobuf:"varint,6,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	ManagerId     *uint32 `protobuf:"varint,7,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	Status        string  `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesRequest) Reset() {
+	*x = ListEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesRequest) ProtoMessage() {}
+
+func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEmployeesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetDepartmentId() uint32 {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetManagerId() uint32 {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return 0
+}
+
+func (x *ListEmployeesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesResponse) Reset() {
+	*x = ListEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesResponse) ProtoMessage() {}
+
+func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *ListEmployeesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmployeesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CreateEmployeeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Employee       *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	SalaryOverride *SalaryOverride        `protobuf:"bytes,2,opt,name=salary_override,json=salaryOverride,proto3" json:"salary_override,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeRequest) Reset() {
+	*x = CreateEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeRequest) ProtoMessage() {}
+
+func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateEmployeeRequest) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetSalaryOverride() *SalaryOverride {
+	if x != nil {
+		return x.SalaryOverride
+	}
+	return nil
+}
+
+type UpdateEmployeeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Employee       *Employee              `protobuf:"bytes,2,opt,name=employee,proto3" json:"employee,omitempty"`
+	SalaryOverride *SalaryOverride        `protobuf:"bytes,3,opt,name=salary_override,json=salaryOverride,proto3" json:"salary_override,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeRequest) Reset() {
+	*x = UpdateEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateEmployeeRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEmployeeRequest) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetSalaryOverride() *SalaryOverride {
+	if x != nil {
+		return x.SalaryOverride
+	}
+	return nil
+}
+
+type DeleteEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeRequest) Reset() {
+	*x = DeleteEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeRequest) ProtoMessage() {}
+
+func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteEmployeeRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WatchEmployeesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only changes to employees in this department after the change
+	DepartmentId *uint32 `protobuf:"varint,1,opt,name=department_id,json=departmentId,proto3,oneof" json:"department_id,omitempty"`
+	// Only changes to these employees
+	EmployeeIds []uint32 `protobuf:"varint,2,rep,packed,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
+	// Replay the retained changes after this event ID before streaming new ones
+	LastEventId   *uint64 `protobuf:"varint,3,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEmployeesRequest) Reset() {
+	*x = WatchEmployeesRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEmployeesRequest) ProtoMessage() {}
+
+func (x *WatchEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*WatchEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchEmployeesRequest) GetDepartmentId() uint32 {
+	if x != nil && x.DepartmentId != nil {
+		return *x.DepartmentId
+	}
+	return 0
+}
+
+func (x *WatchEmployeesRequest) GetEmployeeIds() []uint32 {
+	if x != nil {
+		return x.EmployeeIds
+	}
+	return nil
+}
+
+func (x *WatchEmployeesRequest) GetLastEventId() uint64 {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return 0
+}
+
+type EmployeeEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of employee.created, employee.updated or employee.deleted
+	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	EmployeeId uint32 `protobuf:"varint,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// The record after the change, or the last stored record for deletions
+	Employee *Employee `protobuf:"bytes,4,opt,name=employee,proto3" json:"employee,omitempty"`
+	// The record before the change, for updates only
+	Previous      *Employee              `protobuf:"bytes,5,opt,name=previous,proto3" json:"previous,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeEvent) Reset() {
+	*x = EmployeeEvent{}
+	mi := &file_employee_v1_employee_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeEvent) ProtoMessage() {}
+
+func (x *EmployeeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeEvent.ProtoReflect.Descriptor instead.
+func (*EmployeeEvent) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EmployeeEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmployeeEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EmployeeEvent) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *EmployeeEvent) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *EmployeeEvent) GetPrevious() *Employee {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *EmployeeEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// StreamReset means the changes after the requested last_event_id are no
+// longer retained, so the client should reload its data
+type StreamReset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastEventId   uint64                 `protobuf:"varint,1,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamReset) Reset() {
+	*x = StreamReset{}
+	mi := &file_employee_v1_employee_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamReset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamReset) ProtoMessage() {}
+
+func (x *StreamReset) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamReset.ProtoReflect.Descriptor instead.
+func (*StreamReset) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamReset) GetLastEventId() uint64 {
+	if x != nil {
+		return x.LastEventId
+	}
+	return 0
+}
+
+type WatchEmployeesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*WatchEmployeesResponse_Event
+	//	*WatchEmployeesResponse_StreamReset
+	Message       isWatchEmployeesResponse_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEmployeesResponse) Reset() {
+	*x = WatchEmployeesResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEmployeesResponse) ProtoMessage() {}
+
+func (x *WatchEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*WatchEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchEmployeesResponse) GetMessage() isWatchEmployeesResponse_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *WatchEmployeesResponse) GetEvent() *EmployeeEvent {
+	if x != nil {
+		if x, ok := x.Message.(*WatchEmployeesResponse_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+func (x *WatchEmployeesResponse) GetStreamReset() *StreamReset {
+	if x != nil {
+		if x, ok := x.Message.(*WatchEmployeesResponse_StreamReset); ok {
+			return x.StreamReset
+		}
+	}
+	return nil
+}
+
+type isWatchEmployeesResponse_Message interface {
+	isWatchEmployeesResponse_Message()
+}
+
+type WatchEmployeesResponse_Event struct {
+	Event *EmployeeEvent `protobuf:"bytes,1,opt,name=event,proto3,oneof"`
+}
+
+type WatchEmployeesResponse_StreamReset struct {
+	StreamReset *StreamReset `protobuf:"bytes,2,opt,name=stream_reset,json=streamReset,proto3,oneof"`
+}
+
+func (*WatchEmployeesResponse_Event) isWatchEmployeesResponse_Message() {}
+
+func (*WatchEmployeesResponse_StreamReset) isWatchEmployeesResponse_Message() {}
+
+var File_employee_v1_employee_proto protoreflect.FileDescriptor
+
+const file_employee_v1_employee_proto_rawDesc = "" +
+	"\n" +
+	"\x1aemployee/v1/employee.proto\x12\vemployee.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x05\n" +
+	"\bEmployee\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\tR\bposition\x12$\n" +
+	"\vposition_id\x18\x05 \x01(\rH\x00R\n" +
+	"positionId\x88\x01\x01\x12\x16\n" +
+	"\x06salary\x18\x06 \x01(\x01R\x06salary\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12+\n" +
+	"\x12salary_out_of_band\x18\b \x01(\bR\x0fsalaryOutOfBand\x12(\n" +
+	"\rdepartment_id\x18\t \x01(\rH\x01R\fdepartmentId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"manager_id\x18\n" +
+	" \x01(\rH\x02R\tmanagerId\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12E\n" +
+	"\x10termination_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0fterminationDate\x12-\n" +
+	"\x12termination_reason\x18\r \x01(\tR\x11terminationReason\x127\n" +
+	"\tjoin_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\bjoinDate\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_position_idB\x10\n" +
+	"\x0e_department_idB\r\n" +
+	"\v_manager_id\"6\n" +
+	"\x0eSalaryOverride\x12$\n" +
+	"\rjustification\x18\x01 \x01(\tR\rjustification\"$\n" +
+	"\x12GetEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x94\x02\n" +
+	"\x14ListEmployeesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\tR\bposition\x12(\n" +
+	"\rdepartment_id\x18\x06 \x01(\rH\x00R\fdepartmentId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"manager_id\x18\a \x01(\rH\x01R\tmanagerId\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06statusB\x10\n" +
+	"\x0e_department_idB\r\n" +
+	"\v_manager_id\"\x9e\x01\n" +
+	"\x15ListEmployeesResponse\x123\n" +
+	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x90\x01\n" +
+	"\x15CreateEmployeeRequest\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12D\n" +
+	"\x0fsalary_override\x18\x02 \x01(\v2\x1b.employee.v1.SalaryOverrideR\x0esalaryOverride\"\xa0\x01\n" +
+	"\x15UpdateEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x121\n" +
+	"\bemployee\x18\x02 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x12D\n" +
+	"\x0fsalary_override\x18\x03 \x01(\v2\x1b.employee.v1.SalaryOverrideR\x0esalaryOverride\"'\n" +
+	"\x15DeleteEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xb1\x01\n" +
+	"\x15WatchEmployeesRequest\x12(\n" +
+	"\rdepartment_id\x18\x01 \x01(\rH\x00R\fdepartmentId\x88\x01\x01\x12!\n" +
+	"\femployee_ids\x18\x02 \x03(\rR\vemployeeIds\x12'\n" +
+	"\rlast_event_id\x18\x03 \x01(\x04H\x01R\vlastEventId\x88\x01\x01B\x10\n" +
+	"\x0e_department_idB\x10\n" +
+	"\x0e_last_event_id\"\xf7\x01\n" +
+	"\rEmployeeEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\rR\n" +
+	"employeeId\x121\n" +
+	"\bemployee\x18\x04 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x121\n" +
+	"\bprevious\x18\x05 \x01(\v2\x15.employee.v1.EmployeeR\bprevious\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"1\n" +
+	"\vStreamReset\x12\"\n" +
+	"\rlast_event_id\x18\x01 \x01(\x04R\vlastEventId\"\x96\x01\n" +
+	"\x16WatchEmployeesResponse\x122\n" +
+	"\x05event\x18\x01 \x01(\v2\x1a.employee.v1.EmployeeEventH\x00R\x05event\x12=\n" +
+	"\fstream_reset\x18\x02 \x01(\v2\x18.employee.v1.StreamResetH\x00R\vstreamResetB\t\n" +
+	"\amessage2\xf5\x03\n" +
+	"\x0fEmployeeService\x12E\n" +
+	"\vGetEmployee\x12\x1f.employee.v1.GetEmployeeRequest\x1a\x15.employee.v1.Employee\x12V\n" +
+	"\rListEmployees\x12!.employee.v1.ListEmployeesRequest\x1a\".employee.v1.ListEmployeesResponse\x12K\n" +
+	"\x0eCreateEmployee\x12\".employee.v1.CreateEmployeeRequest\x1a\x15.employee.v1.Employee\x12K\n" +
+	"\x0eUpdateEmployee\x12\".employee.v1.UpdateEmployeeRequest\x1a\x15.employee.v1.Employee\x12L\n" +
+	"\x0eDeleteEmployee\x12\".employee.v1.DeleteEmployeeRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x0eWatchEmployees\x12\".employee.v1.WatchEmployeesRequest\x1a#.employee.v1.WatchEmployeesResponse0\x01BDZBgithub.com/chinmay-sawant/gin-example/proto/employee/v1;employeev1b\x06proto3"
+
+var (
+	file_employee_v1_employee_proto_rawDescOnce sync.Once
+	file_employee_v1_employee_proto_rawDescData []byte
+)
+
+func file_employee_v1_employee_proto_rawDescGZIP() []byte {
+	file_employee_v1_employee_proto_rawDescOnce.Do(func() {
+		file_employee_v1_employee_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_employee_v1_employee_proto_rawDesc), len(file_employee_v1_employee_proto_rawDesc)))
+	})
+	return file_employee_v1_employee_proto_rawDescData
+}
+
+var file_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_employee_v1_employee_proto_goTypes = []any{
+	(*Employee)(nil),               // 0: employee.v1.Employee
+	(*SalaryOverride)(nil),         // 1: employee.v1.SalaryOverride
+	(*GetEmployeeRequest)(nil),     // 2: employee.v1.GetEmployeeRequest
+	(*ListEmployeesRequest)(nil),   // 3: employee.v1.ListEmployeesRequest
+	(*ListEmployeesResponse)(nil),  // 4: employee.v1.ListEmployeesResponse
+	(*CreateEmployeeRequest)(nil),  // 5: employee.v1.CreateEmployeeRequest
+	(*UpdateEmployeeRequest)(nil),  // 6: employee.v1.UpdateEmployeeRequest
+	(*DeleteEmployeeRequest)(nil),  // 7: employee.v1.DeleteEmployeeRequest
+	(*WatchEmployeesRequest)(nil),  // 8: employee.v1.WatchEmployeesRequest
+	(*EmployeeEvent)(nil),          // 9: employee.v1.EmployeeEvent
+	(*StreamReset)(nil),            // 10: employee.v1.StreamReset
+	(*WatchEmployeesResponse)(nil), // 11: employee.v1.WatchEmployeesResponse
+	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 13: google.protobuf.Empty
+}
+var file_employee_v1_employee_proto_depIdxs = []int32{
+	12, // 0: employee.v1.Employee.termination_date:type_name -> google.protobuf.Timestamp
+	12, // 1: employee.v1.Employee.join_date:type_name -> google.protobuf.Timestamp
+	12, // 2: employee.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: employee.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: employee.v1.ListEmployeesResponse.employees:type_name -> employee.v1.Employee
+	0,  // 5: employee.v1.CreateEmployeeRequest.employee:type_name -> employee.v1.Employee
+	1,  // 6: employee.v1.CreateEmployeeRequest.salary_override:type_name -> employee.v1.SalaryOverride
+	0,  // 7: employee.v1.UpdateEmployeeRequest.employee:type_name -> employee.v1.Employee
+	1,  // 8: employee.v1.UpdateEmployeeRequest.salary_override:type_name -> employee.v1.SalaryOverride
+	0,  // 9: employee.v1.EmployeeEvent.employee:type_name -> employee.v1.Employee
+	0,  // 10: employee.v1.EmployeeEvent.previous:type_name -> employee.v1.Employee
+	12, // 11: employee.v1.EmployeeEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	9,  // 12: employee.v1.WatchEmployeesResponse.event:type_name -> employee.v1.EmployeeEvent
+	10, // 13: employee.v1.WatchEmployeesResponse.stream_reset:type_name -> employee.v1.StreamReset
+	2,  // 14: employee.v1.EmployeeService.GetEmployee:input_type -> employee.v1.GetEmployeeRequest
+	3,  // 15: employee.v1.EmployeeService.ListEmployees:input_type -> employee.v1.ListEmployeesRequest
+	5,  // 16: employee.v1.EmployeeService.CreateEmployee:input_type -> employee.v1.CreateEmployeeRequest
+	6,  // 17: employee.v1.EmployeeService.UpdateEmployee:input_type -> employee.v1.UpdateEmployeeRequest
+	7,  // 18: employee.v1.EmployeeService.DeleteEmployee:input_type -> employee.v1.DeleteEmployeeRequest
+	8,  // 19: employee.v1.EmployeeService.WatchEmployees:input_type -> employee.v1.WatchEmployeesRequest
+	0,  // 20: employee.v1.EmployeeService.GetEmployee:output_type -> employee.v1.Employee
+	4,  // 21: employee.v1.EmployeeService.ListEmployees:output_type -> employee.v1.ListEmployeesResponse
+	0,  // 22: employee.v1.EmployeeService.CreateEmployee:output_type -> employee.v1.Employee
+	0,  // 23: employee.v1.EmployeeService.UpdateEmployee:output_type -> employee.v1.Employee
+	13, // 24: employee.v1.EmployeeService.DeleteEmployee:output_type -> google.protobuf.Empty
+	11, // 25: employee.v1.EmployeeService.WatchEmployees:output_type -> employee.v1.WatchEmployeesResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_employee_v1_employee_proto_init() }
+func file_employee_v1_employee_proto_init() {
+	if File_employee_v1_employee_proto != nil {
+		return
+	}
+	file_employee_v1_employee_proto_msgTypes[0].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[3].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[8].OneofWrappers = []any{}
+	file_employee_v1_employee_proto_msgTypes[11].OneofWrappers = []any{
+		(*WatchEmployeesResponse_Event)(nil),
+		(*WatchEmployeesResponse_StreamReset)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_employee_v1_employee_proto_rawDesc), len(file_employee_v1_employee_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_employee_v1_employee_proto_goTypes,
+		DependencyIndexes: file_employee_v1_employee_proto_depIdxs,
+		MessageInfos:      file_employee_v1_employee_proto_msgTypes,
+	}.Build()
+	File_employee_v1_employee_proto = out.File
+	file_employee_v1_employee_proto_goTypes = nil
+	file_employee_v1_employee_proto_depIdxs = nil
+}
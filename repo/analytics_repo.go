@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+type AnalyticsRepository interface {
+	CountHeadcount(ctx context.Context, filter models.AnalyticsFilter) ([]models.HeadcountGroup, error)
+	SalaryStats(ctx context.Context, filter models.AnalyticsFilter) ([]models.SalaryStats, error)
+	CountMovements(ctx context.Context, filter models.MovementFilter) ([]models.MonthlyMovement, error)
+	CountTenure(ctx context.Context, filter models.AnalyticsFilter, asOf time.Time) ([]models.TenureBucket, error)
+}
@@ -0,0 +1,161 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+)
+
+// salaryPercentiles maps each percentile column of SalaryStats to its fraction
+var salaryPercentiles = []struct {
+	column   string
+	fraction float64
+}{{"p25", 0.25}, {"median", 0.5}, {"p75", 0.75}, {"p90", 0.9}}
+
+type analyticsRepositoryImpl struct{}
+
+func NewAnalyticsRepository() AnalyticsRepository {
+	return &analyticsRepositoryImpl{}
+}
+
+// analyticsEmployees selects the employees a report covers, joined to their
+// department, with the column they are grouped by as group_key
+func analyticsEmployees(ctx context.Context, filter models.AnalyticsFilter) *gorm.DB {
+	tx := conn(ctx).Table("employees").
+		Joins("LEFT JOIN departments ON departments.id = employees.department_id")
+	switch filter.GroupBy {
+	case models.GroupByPosition:
+		tx = tx.Select("employees.*, employees.position AS group_key")
+	case models.GroupByStatus:
+		tx = tx.Select("employees.*, employees.status AS group_key")
+	default:
+		tx = tx.Select("employees.*, COALESCE(departments.name, ?) AS group_key", models.UnassignedGroup)
+	}
+	if !filter.IncludeTerminated {
+		tx = tx.Where("employees.status <> ?", models.StatusTerminated)
+	}
+	return tx
+}
+
+func (r *analyticsRepositoryImpl) CountHeadcount(ctx context.Context, filter models.AnalyticsFilter) ([]models.HeadcountGroup, error) {
+	var groups []models.HeadcountGroup
+	result := conn(ctx).Table("(?) AS e", analyticsEmployees(ctx, filter)).
+		Select("group_key, COUNT(*) AS count").
+		Group("group_key").
+		Order("count DESC, group_key").
+		Scan(&groups)
+	return groups, result.Error
+}
+
+// SalaryStats aggregates salaries per group and currency. Salaries are
+// ranked within their group so that each percentile can be interpolated
+// between the two salaries either side of it.
+func (r *analyticsRepositoryImpl) SalaryStats(ctx context.Context, filter models.AnalyticsFilter) ([]models.SalaryStats, error) {
+	columns := []string{"group_key", "currency", "COUNT(*) AS count",
+		"MIN(salary) AS min", "MAX(salary) AS max", "AVG(salary) AS mean"}
+	for _, p := range salaryPercentiles {
+		position := fmt.Sprintf("(MAX(n) - 1) * %g", p.fraction)
+		low := fmt.Sprintf("MAX(CASE WHEN salary_rank = FLOOR((n - 1) * %g) THEN salary END)", p.fraction)
+		high := fmt.Sprintf("COALESCE(MAX(CASE WHEN salary_rank = FLOOR((n - 1) * %g) + 1 THEN salary END), %s)", p.fraction, low)
+		columns = append(columns, fmt.Sprintf("%s + (%s - %s) * (%s - FLOOR(%s)) AS %s", low, high, low, position, position, p.column))
+	}
+	ranked := conn(ctx).Table("(?) AS e", analyticsEmployees(ctx, filter)).Select(`group_key, currency, salary,
+		ROW_NUMBER() OVER (PARTITION BY group_key, currency ORDER BY salary) - 1 AS salary_rank,
+		COUNT(*) OVER (PARTITION BY group_key, currency) AS n`)
+
+	var stats []models.SalaryStats
+	result := conn(ctx).Table("(?) AS ranked", ranked).
+		Select(strings.Join(columns, ", ")).
+		Group("group_key, currency").
+		Order("group_key, currency").
+		Scan(&stats)
+	return stats, result.Error
+}
+
+// employeeDates are the dates tenure and movement reports are computed from.
+// Months and durations are worked out in Go because SQLite and MySQL have no
+// date functions in common.
+type employeeDates struct {
+	JoinDate        time.Time
+	TerminationDate *time.Time
+}
+
+// CountMovements counts hires by join month and terminations by termination
+// month. Employees without a join date are not counted as hires.
+func (r *analyticsRepositoryImpl) CountMovements(ctx context.Context, filter models.MovementFilter) ([]models.MonthlyMovement, error) {
+	var rows []employeeDates
+	result := conn(ctx).Model(&models.Employee{}).Select("join_date", "termination_date").
+		Where("join_date > ? OR termination_date IS NOT NULL", time.Time{}).
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	byMonth := make(map[string]*models.MonthlyMovement)
+	add := func(date time.Time, hires, terminations int64) {
+		month := date.UTC().Format(models.MonthFormat)
+		if (filter.From != "" && month < filter.From) || (filter.To != "" && month > filter.To) {
+			return
+		}
+		movement := byMonth[month]
+		if movement == nil {
+			movement = &models.MonthlyMovement{Month: month}
+			byMonth[month] = movement
+		}
+		movement.Hires += hires
+		movement.Terminations += terminations
+	}
+	for _, row := range rows {
+		if row.JoinDate.After(time.Time{}) {
+			add(row.JoinDate, 1, 0)
+		}
+		if row.TerminationDate != nil {
+			add(*row.TerminationDate, 0, 1)
+		}
+	}
+
+	movements := make([]models.MonthlyMovement, 0, len(byMonth))
+	for _, movement := range byMonth {
+		movements = append(movements, *movement)
+	}
+	slices.SortFunc(movements, func(a, b models.MonthlyMovement) int {
+		return strings.Compare(a.Month, b.Month)
+	})
+	return movements, nil
+}
+
+// CountTenure counts employees per tenure bucket, measuring tenure from the
+// join date to asOf, or to the termination date for terminated employees.
+// Employees without a join date are left out.
+func (r *analyticsRepositoryImpl) CountTenure(ctx context.Context, filter models.AnalyticsFilter, asOf time.Time) ([]models.TenureBucket, error) {
+	var rows []employeeDates
+	result := analyticsEmployees(ctx, filter).
+		Select("employees.join_date, employees.termination_date").
+		Where("employees.join_date > ?", time.Time{}).
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	buckets := make([]models.TenureBucket, len(models.TenureBuckets))
+	copy(buckets, models.TenureBuckets)
+	for _, row := range rows {
+		end := asOf
+		if row.TerminationDate != nil {
+			end = *row.TerminationDate
+		}
+		years := end.Sub(row.JoinDate).Hours() / 24 / 365.25
+		for i, bucket := range buckets {
+			if bucket.MaxYears == 0 || years < bucket.MaxYears {
+				buckets[i].Count++
+				break
+			}
+		}
+	}
+	return buckets, nil
+}
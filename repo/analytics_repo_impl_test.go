@@ -0,0 +1,99 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type AnalyticsRepositoryTestSuite struct {
+	suite.Suite
+	previous  *gorm.DB
+	ctx       context.Context
+	analytics AnalyticsRepository
+}
+
+func (suite *AnalyticsRepositoryTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{TranslateError: true})
+	suite.Require().NoError(err)
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	scoped := []interface{}{&models.Employee{}, &models.Department{}}
+	suite.Require().NoError(database.AutoMigrate(scoped...))
+	suite.Require().NoError(database.Use(tenant.NewPlugin(scoped...)))
+	suite.previous, db.DB = db.DB, database
+
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+	suite.analytics = NewAnalyticsRepository()
+	date := func(value string) time.Time {
+		parsed, err := time.Parse(time.DateOnly, value)
+		suite.Require().NoError(err)
+		return parsed
+	}
+	terminated := date("2024-03-20")
+	employees := []models.Employee{
+		{Name: "Ann", Email: "ann@example.com", Salary: 100, Currency: "USD", Status: models.StatusActive, JoinDate: date("2023-01-10")},
+		{Name: "Bob", Email: "bob@example.com", Salary: 200, Currency: "USD", Status: models.StatusActive, JoinDate: date("2024-03-02")},
+		{Name: "Cid", Email: "cid@example.com", Salary: 400, Currency: "USD", Status: models.StatusTerminated, JoinDate: date("2020-03-01"), TerminationDate: &terminated},
+		// Employees without a join date are no hires and have no tenure
+		{Name: "Dee", Email: "dee@example.com", Salary: 300, Currency: "USD", Status: models.StatusActive},
+	}
+	suite.Require().NoError(db.DB.WithContext(suite.ctx).Create(&employees).Error)
+	// Another tenant's employees are never counted
+	other := tenant.NewContext(context.Background(), "globex")
+	suite.Require().NoError(db.DB.WithContext(other).Create(&models.Employee{Name: "Eve", Email: "eve@example.com", Salary: 1,
+		Currency: "USD", Status: models.StatusActive, JoinDate: date("2024-03-05")}).Error)
+}
+
+func (suite *AnalyticsRepositoryTestSuite) TearDownTest() {
+	db.DB = suite.previous
+}
+
+func TestAnalyticsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsRepositoryTestSuite))
+}
+
+func (suite *AnalyticsRepositoryTestSuite) TestCountMovements() {
+	movements, err := suite.analytics.CountMovements(suite.ctx, models.MovementFilter{})
+	suite.NoError(err)
+	suite.Equal([]models.MonthlyMovement{
+		{Month: "2020-03", Hires: 1},
+		{Month: "2023-01", Hires: 1},
+		{Month: "2024-03", Hires: 1, Terminations: 1},
+	}, movements)
+
+	movements, err = suite.analytics.CountMovements(suite.ctx, models.MovementFilter{From: "2021-01", To: "2023-12"})
+	suite.NoError(err)
+	suite.Equal([]models.MonthlyMovement{{Month: "2023-01", Hires: 1}}, movements)
+}
+
+func (suite *AnalyticsRepositoryTestSuite) TestCountTenure() {
+	asOf := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	buckets, err := suite.analytics.CountTenure(suite.ctx, models.AnalyticsFilter{IncludeTerminated: true}, asOf)
+	suite.NoError(err)
+	counts := make(map[string]int64, len(buckets))
+	for _, bucket := range buckets {
+		counts[bucket.Label] = bucket.Count
+	}
+	// Bob joined this year, Ann over a year ago and Cid left after four years
+	suite.Equal(map[string]int64{"<1y": 1, "1-2y": 1, "2-5y": 1, "5-10y": 0, "10y+": 0}, counts)
+}
+
+func (suite *AnalyticsRepositoryTestSuite) TestSalaryStats() {
+	stats, err := suite.analytics.SalaryStats(suite.ctx, models.AnalyticsFilter{GroupBy: models.GroupByStatus})
+	suite.NoError(err)
+	suite.Require().Len(stats, 1)
+	suite.Equal(int64(3), stats[0].Count)
+	suite.Equal(100.0, stats[0].Min)
+	suite.Equal(300.0, stats[0].Max)
+	suite.Equal(200.0, stats[0].Median)
+	suite.Equal(150.0, stats[0].P25)
+}
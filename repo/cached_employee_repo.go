@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"github.com/chinmay-sawant/gin-example/cache"
+)
+
+// CachedEmployeeRepository is an EmployeeRepository that serves employee
+// lookups by ID from a cache and reports how well the cache is doing
+type CachedEmployeeRepository interface {
+	EmployeeRepository
+	CacheStats() cache.Stats
+}
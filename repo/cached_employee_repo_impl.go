@@ -0,0 +1,251 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/cache"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+// DefaultEmployeeCacheTTL is how long a cached employee is served before it is reloaded
+const DefaultEmployeeCacheTTL = time.Minute
+
+// cachedEmployeeRepositoryImpl decorates an EmployeeRepository. Lookups by
+// ID are served from the cache, concurrent misses for one employee share a
+// single load, and every write through the repository drops the employees
+// it changed once it is committed. Everything else goes straight to the
+// wrapped repository.
+type cachedEmployeeRepositoryImpl struct {
+	EmployeeRepository
+	cache cache.Cache
+	ttl   time.Duration
+	loads cache.Group
+	// generation changes on every write, so loads that started before a
+	// write neither store nor share what they read
+	generation atomic.Uint64
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+}
+
+// CachedEmployeeRepositoryOption configures optional caching behaviour
+type CachedEmployeeRepositoryOption func(*cachedEmployeeRepositoryImpl)
+
+// WithEmployeeCacheTTL sets how long employees are cached. A non-positive TTL keeps the default.
+func WithEmployeeCacheTTL(ttl time.Duration) CachedEmployeeRepositoryOption {
+	return func(r *cachedEmployeeRepositoryImpl) {
+		if ttl > 0 {
+			r.ttl = ttl
+		}
+	}
+}
+
+// NewCachedEmployeeRepository wraps the repository with a cache, such as a
+// cache.LRU or a client for an external cache
+func NewCachedEmployeeRepository(employeeRepo EmployeeRepository, store cache.Cache, opts ...CachedEmployeeRepositoryOption) CachedEmployeeRepository {
+	r := &cachedEmployeeRepositoryImpl{
+		EmployeeRepository: employeeRepo,
+		cache:              store,
+		ttl:                DefaultEmployeeCacheTTL,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// employeeCacheKey returns the key of the employee of the tenant
+func employeeCacheKey(tenantID string, id uint) string {
+	return "employee:" + tenantID + ":" + strconv.FormatUint(uint64(id), 10)
+}
+
+// FindByID returns the cached employee, loading and caching it on a miss.
+// Missing employees are not cached, and neither are lookups outside a tenant.
+// Lookups within a unit of work bypass the cache, since they see changes
+// that may still be rolled back.
+func (r *cachedEmployeeRepositoryImpl) FindByID(ctx context.Context, id uint) (models.Employee, error) {
+	tenantID, ok := tenant.FromContext(ctx)
+	if !ok || InTransaction(ctx) {
+		return r.EmployeeRepository.FindByID(ctx, id)
+	}
+	key := employeeCacheKey(tenantID, id)
+	if encoded, ok, err := r.cache.Get(key); err != nil {
+		log.Printf("employee cache: reading %s: %v", key, err)
+	} else if ok {
+		var employee models.Employee
+		if err := json.Unmarshal(encoded, &employee); err == nil {
+			r.hits.Add(1)
+			employee.TenantID = tenantID
+			return employee, nil
+		}
+	}
+	r.misses.Add(1)
+
+	generation := r.generation.Load()
+	value, _, err := r.loads.Do(key+"@"+strconv.FormatUint(generation, 10), func() (interface{}, error) {
+		employee, err := r.EmployeeRepository.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := json.Marshal(employee)
+		if err != nil {
+			return nil, err
+		}
+		r.store(key, encoded, generation)
+		return encoded, nil
+	})
+	if err != nil {
+		return models.Employee{}, err
+	}
+	// Every caller decodes its own copy, so callers sharing a load cannot
+	// change each other's employee
+	var employee models.Employee
+	err = json.Unmarshal(value.([]byte), &employee)
+	employee.TenantID = tenantID
+	return employee, err
+}
+
+// FindByIDWithView serves views of the whole record from the cache. Views
+// selecting fields or expanding relations are loaded from the repository.
+func (r *cachedEmployeeRepositoryImpl) FindByIDWithView(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error) {
+	if len(view.Fields) == 0 && len(view.Expand) == 0 {
+		return r.FindByID(ctx, id)
+	}
+	return r.EmployeeRepository.FindByIDWithView(ctx, id, view)
+}
+
+func (r *cachedEmployeeRepositoryImpl) Create(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	created, err := r.EmployeeRepository.Create(ctx, employee)
+	r.invalidate(ctx, created.ID)
+	return created, err
+}
+
+func (r *cachedEmployeeRepositoryImpl) Update(ctx context.Context, id uint, employee models.Employee) (models.Employee, error) {
+	updated, err := r.EmployeeRepository.Update(ctx, id, employee)
+	r.invalidate(ctx, id)
+	return updated, err
+}
+
+// Delete also drops the employee's direct reports, who move to its manager
+func (r *cachedEmployeeRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	ids := r.withDirectReports(ctx, id)
+	err := r.EmployeeRepository.Delete(ctx, id)
+	r.invalidate(ctx, ids...)
+	return err
+}
+
+func (r *cachedEmployeeRepositoryImpl) UpdateStatus(ctx context.Context, employee models.Employee, transition models.EmployeeStatusTransition) (models.Employee, error) {
+	updated, err := r.EmployeeRepository.UpdateStatus(ctx, employee, transition)
+	r.invalidate(ctx, employee.ID)
+	return updated, err
+}
+
+func (r *cachedEmployeeRepositoryImpl) UpdateBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	updated, err := r.EmployeeRepository.UpdateBatch(ctx, employees)
+	r.invalidate(ctx, employeeIDs(employees)...)
+	return updated, err
+}
+
+func (r *cachedEmployeeRepositoryImpl) DeleteBatch(ctx context.Context, ids []uint) error {
+	var affected []uint
+	for _, id := range ids {
+		affected = append(affected, r.withDirectReports(ctx, id)...)
+	}
+	err := r.EmployeeRepository.DeleteBatch(ctx, ids)
+	r.invalidate(ctx, affected...)
+	return err
+}
+
+func (r *cachedEmployeeRepositoryImpl) UpsertBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	saved, err := r.EmployeeRepository.UpsertBatch(ctx, employees)
+	// Saved holds the IDs of created employees, employees those of updates
+	// applied before a failure
+	r.invalidate(ctx, append(employeeIDs(employees), employeeIDs(saved)...)...)
+	return saved, err
+}
+
+func (r *cachedEmployeeRepositoryImpl) RemoveCustomField(ctx context.Context, name string) ([]uint, error) {
+	ids, err := r.EmployeeRepository.RemoveCustomField(ctx, name)
+	r.invalidate(ctx, ids...)
+	return ids, err
+}
+
+// CacheStats returns the hits and misses of lookups by ID, the misses waiting
+// on a shared load, and the size and evictions of the cache when it reports them
+func (r *cachedEmployeeRepositoryImpl) CacheStats() cache.Stats {
+	stats := cache.Stats{Hits: r.hits.Load(), Misses: r.misses.Load(), Waiting: r.loads.Waiting()}
+	if lru, ok := r.cache.(interface {
+		Len() int
+		Evictions() uint64
+	}); ok {
+		stats.Size = lru.Len()
+		stats.Evictions = lru.Evictions()
+	}
+	return stats
+}
+
+// store caches the encoded employee unless a write happened since its load
+// started. A write racing the store bumps the generation after it, so the
+// entry is dropped again.
+func (r *cachedEmployeeRepositoryImpl) store(key string, encoded []byte, generation uint64) {
+	if r.generation.Load() != generation {
+		return
+	}
+	if err := r.cache.Set(key, encoded, r.ttl); err != nil {
+		log.Printf("employee cache: writing %s: %v", key, err)
+		return
+	}
+	if r.generation.Load() != generation {
+		r.deleteKeys(key)
+	}
+}
+
+// invalidate drops the employees from the cache once the write is
+// committed. Dropping them earlier would let a lookup outside the unit of
+// work cache the committed record the write is about to replace.
+func (r *cachedEmployeeRepositoryImpl) invalidate(ctx context.Context, ids ...uint) {
+	tenantID, ok := tenant.FromContext(ctx)
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if ok && id != 0 {
+			keys = append(keys, employeeCacheKey(tenantID, id))
+		}
+	}
+	AfterCommit(ctx, func(context.Context) {
+		r.generation.Add(1)
+		r.deleteKeys(keys...)
+	})
+}
+
+func (r *cachedEmployeeRepositoryImpl) deleteKeys(keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := r.cache.Delete(keys...); err != nil {
+		// A stale entry is served until its TTL passes
+		log.Printf("employee cache: deleting %v: %v", keys, err)
+	}
+}
+
+// withDirectReports returns the employee's ID and those of its direct reports
+func (r *cachedEmployeeRepositoryImpl) withDirectReports(ctx context.Context, id uint) []uint {
+	ids := []uint{id}
+	reports, err := r.EmployeeRepository.FindDirectReports(ctx, id)
+	if err != nil {
+		log.Printf("employee cache: finding reports of %d: %v", id, err)
+	}
+	return append(ids, employeeIDs(reports)...)
+}
+
+func employeeIDs(employees []models.Employee) []uint {
+	ids := make([]uint, len(employees))
+	for i, employee := range employees {
+		ids[i] = employee.ID
+	}
+	return ids
+}
@@ -0,0 +1,253 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/cache"
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+type CachedEmployeeRepositoryTestSuite struct {
+	suite.Suite
+	ctrl  *gomock.Controller
+	inner *mocks.MockEmployeeRepository
+	lru   *cache.LRU
+	repo  CachedEmployeeRepository
+	ctx   context.Context
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.inner = mocks.NewMockEmployeeRepository(suite.ctrl)
+	suite.lru = cache.NewLRU(10)
+	suite.repo = NewCachedEmployeeRepository(suite.inner, suite.lru, WithEmployeeCacheTTL(time.Hour))
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestCachedEmployeeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedEmployeeRepositoryTestSuite))
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) employee(id uint, name string) models.Employee {
+	managerID := uint(3)
+	return models.Employee{ID: id, Name: name, Email: name + "@example.com", ManagerID: &managerID, Status: models.StatusActive}
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestFindByIDIsCached() {
+	alice := suite.employee(1, "alice")
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(alice, nil).Times(1)
+
+	for i := 0; i < 3; i++ {
+		employee, err := suite.repo.FindByID(suite.ctx, 1)
+		suite.NoError(err)
+		suite.Equal(alice.Name, employee.Name)
+		suite.Equal(uint(3), *employee.ManagerID)
+	}
+	// A whole-record view is the same lookup
+	_, err := suite.repo.FindByIDWithView(suite.ctx, 1, models.EmployeeView{})
+	suite.NoError(err)
+	suite.Equal(cache.Stats{Hits: 3, Misses: 1, Size: 1}, suite.repo.CacheStats())
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestCallersGetTheirOwnCopy() {
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(suite.employee(1, "alice"), nil)
+	employee, err := suite.repo.FindByID(suite.ctx, 1)
+	suite.NoError(err)
+	*employee.ManagerID = 9
+
+	employee, err = suite.repo.FindByID(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Equal(uint(3), *employee.ManagerID)
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestEntriesAreKeptPerTenant() {
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(suite.employee(1, "alice"), nil).Times(2)
+	_, err := suite.repo.FindByID(suite.ctx, 1)
+	suite.NoError(err)
+	// Another tenant never sees the first one's entry
+	_, err = suite.repo.FindByID(tenant.NewContext(context.Background(), "globex"), 1)
+	suite.NoError(err)
+	suite.Equal(2, suite.lru.Len())
+
+	// Without a tenant the cache is bypassed and the inner repository fails
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{}, tenant.ErrNoTenant)
+	_, err = suite.repo.FindByID(context.Background(), 1)
+	suite.ErrorIs(err, tenant.ErrNoTenant)
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestMissesAndSparseViewsAreNotCached() {
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(9)).Return(models.Employee{}, models.ErrEmployeeNotFound).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := suite.repo.FindByID(suite.ctx, 9)
+		suite.ErrorIs(err, models.ErrEmployeeNotFound)
+	}
+
+	view := models.EmployeeView{Fields: []string{"name"}}
+	suite.inner.EXPECT().FindByIDWithView(gomock.Any(), uint(1), view).Return(suite.employee(1, "alice"), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := suite.repo.FindByIDWithView(suite.ctx, 1, view)
+		suite.NoError(err)
+	}
+	suite.Equal(0, suite.lru.Len())
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestLookupsInAUnitOfWorkBypassTheCache() {
+	ctx := context.WithValue(suite.ctx, txKey{}, &unitOfWorkTx{db: &gorm.DB{}, afterCommit: new([]func())})
+	suite.inner.EXPECT().FindByID(ctx, uint(1)).Return(suite.employee(1, "alice"), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := suite.repo.FindByID(ctx, 1)
+		suite.NoError(err)
+	}
+	suite.Equal(0, suite.lru.Len())
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestWritesInvalidate() {
+	alice := suite.employee(1, "alice")
+	renamed := suite.employee(1, "alicia")
+	gomock.InOrder(
+		suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(alice, nil),
+		suite.inner.EXPECT().Update(gomock.Any(), uint(1), renamed).Return(renamed, nil),
+		suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(renamed, nil),
+		suite.inner.EXPECT().UpdateStatus(gomock.Any(), renamed, gomock.Any()).Return(renamed, nil),
+		suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(renamed, nil),
+	)
+
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.repo.Update(suite.ctx, 1, renamed)
+	employee, _ := suite.repo.FindByID(suite.ctx, 1)
+	suite.Equal("alicia", employee.Name)
+	suite.repo.UpdateStatus(suite.ctx, renamed, models.EmployeeStatusTransition{})
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.repo.FindByID(suite.ctx, 1)
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestDeleteInvalidatesDirectReports() {
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(suite.employee(1, "alice"), nil).Times(2)
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(2)).Return(suite.employee(2, "bob"), nil)
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(3)).Return(suite.employee(3, "carol"), nil).Times(2)
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.repo.FindByID(suite.ctx, 2)
+	suite.repo.FindByID(suite.ctx, 3)
+
+	// Alice reports to Carol, so deleting Carol changes Alice's manager
+	suite.inner.EXPECT().FindDirectReports(gomock.Any(), uint(3)).Return([]models.Employee{suite.employee(1, "alice")}, nil)
+	suite.inner.EXPECT().Delete(gomock.Any(), uint(3)).Return(nil)
+	suite.NoError(suite.repo.Delete(suite.ctx, 3))
+
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.repo.FindByID(suite.ctx, 2)
+	suite.repo.FindByID(suite.ctx, 3)
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestBatchWritesInvalidate() {
+	suite.inner.EXPECT().FindByID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id uint) (models.Employee, error) {
+		return suite.employee(id, "someone"), nil
+	}).Times(6)
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.repo.FindByID(suite.ctx, 2)
+
+	suite.inner.EXPECT().UpdateBatch(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+	suite.repo.UpdateBatch(suite.ctx, []models.Employee{{ID: 1}})
+	suite.inner.EXPECT().UpsertBatch(gomock.Any(), gomock.Any()).Return([]models.Employee{{ID: 2}}, nil)
+	suite.repo.UpsertBatch(suite.ctx, []models.Employee{{ID: 2}})
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.repo.FindByID(suite.ctx, 2)
+
+	suite.inner.EXPECT().FindDirectReports(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+	suite.inner.EXPECT().DeleteBatch(gomock.Any(), []uint{1, 2}).Return(nil)
+	suite.NoError(suite.repo.DeleteBatch(suite.ctx, []uint{1, 2}))
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.repo.FindByID(suite.ctx, 2)
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestConcurrentMissesLoadOnce() {
+	release := make(chan struct{})
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).DoAndReturn(func(context.Context, uint) (models.Employee, error) {
+		<-release
+		return suite.employee(1, "alice"), nil
+	}).Times(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			employee, err := suite.repo.FindByID(suite.ctx, 1)
+			suite.NoError(err)
+			suite.Equal("alice", employee.Name)
+		}()
+	}
+	// Let the callers pile up behind the first load
+	for suite.repo.CacheStats().Waiting < 9 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+	suite.Zero(suite.repo.CacheStats().Waiting)
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestLoadRacingAWriteIsNotCached() {
+	suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).DoAndReturn(func(context.Context, uint) (models.Employee, error) {
+		// The employee is updated while it is being read
+		suite.inner.EXPECT().Update(gomock.Any(), uint(1), gomock.Any()).Return(models.Employee{}, nil)
+		suite.repo.Update(suite.ctx, 1, models.Employee{})
+		return suite.employee(1, "alice"), nil
+	})
+	suite.repo.FindByID(suite.ctx, 1)
+	suite.Equal(0, suite.lru.Len())
+}
+
+func (suite *CachedEmployeeRepositoryTestSuite) TestReadBeforeTheCommitIsNotCachedAfterIt() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+	previous := db.DB
+	db.DB = database
+	defer func() { db.DB = previous }()
+
+	alice := suite.employee(1, "alice")
+	renamed := suite.employee(1, "alicia")
+	gomock.InOrder(
+		suite.inner.EXPECT().Update(gomock.Any(), uint(1), renamed).Return(renamed, nil),
+		// The concurrent lookup still reads the committed record
+		suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(alice, nil),
+		suite.inner.EXPECT().FindByID(gomock.Any(), uint(1)).Return(renamed, nil),
+	)
+
+	err = NewUnitOfWork().Do(suite.ctx, func(ctx context.Context) error {
+		if _, err := suite.repo.Update(ctx, 1, renamed); err != nil {
+			return err
+		}
+		// Another request reads the employee between the write and the commit
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			employee, err := suite.repo.FindByID(suite.ctx, 1)
+			suite.NoError(err)
+			suite.Equal("alice", employee.Name)
+		}()
+		wg.Wait()
+		return nil
+	})
+	suite.NoError(err)
+
+	employee, err := suite.repo.FindByID(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Equal("alicia", employee.Name)
+}
@@ -0,0 +1,13 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// CustomFieldRepository stores the custom employee fields of each tenant
+type CustomFieldRepository interface {
+	Repository[models.CustomField, uint]
+	FindAll(ctx context.Context) ([]models.CustomField, error)
+}
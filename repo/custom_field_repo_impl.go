@@ -0,0 +1,47 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+)
+
+// customFieldResource configures the generic repository of custom fields.
+// Updates keep the name and type, which stored values depend on.
+var customFieldResource = Resource[models.CustomField, uint]{
+	NotFound: models.ErrCustomFieldNotFound,
+	Filters:  models.CustomFieldFilters,
+	Merge: func(stored *models.CustomField, update models.CustomField) {
+		stored.Required = update.Required
+		stored.EnumValues = update.EnumValues
+		stored.Pattern = update.Pattern
+		stored.Description = update.Description
+	},
+}
+
+type customFieldRepositoryImpl struct {
+	Repository[models.CustomField, uint]
+}
+
+// NewCustomFieldRepository creates a new instance of CustomFieldRepository
+func NewCustomFieldRepository() CustomFieldRepository {
+	return &customFieldRepositoryImpl{Repository: NewRepository(customFieldResource)}
+}
+
+// FindAll returns every custom field of the tenant, by name
+func (r *customFieldRepositoryImpl) FindAll(ctx context.Context) ([]models.CustomField, error) {
+	var fields []models.CustomField
+	result := conn(ctx).Order("name").Find(&fields)
+	return fields, result.Error
+}
+
+func (r *customFieldRepositoryImpl) Create(ctx context.Context, field models.CustomField) (models.CustomField, error) {
+	created, err := r.Repository.Create(ctx, field)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return created, fmt.Errorf("%w: custom field %q already exists", models.ErrConflict, field.Name)
+	}
+	return created, err
+}
@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type CustomFieldRepositoryTestSuite struct {
+	suite.Suite
+	previous *gorm.DB
+	ctx      context.Context
+}
+
+func (suite *CustomFieldRepositoryTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{TranslateError: true})
+	suite.Require().NoError(err)
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	suite.Require().NoError(database.AutoMigrate(&models.Employee{}, &models.CustomField{}))
+	suite.Require().NoError(database.Use(tenant.NewPlugin(&models.Employee{}, &models.CustomField{})))
+	suite.previous, db.DB = db.DB, database
+
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+	for _, employee := range []models.Employee{
+		{Name: "Ann", CustomFields: models.CustomFieldValues{"shirt_size": "M", "badge_number": 42, "remote": true}},
+		{Name: "Bob", CustomFields: models.CustomFieldValues{"shirt_size": "L", "badge_number": 7, "remote": false}},
+		{Name: "Cid"},
+	} {
+		suite.Require().NoError(db.DB.WithContext(suite.ctx).Create(&employee).Error)
+	}
+}
+
+func (suite *CustomFieldRepositoryTestSuite) TearDownTest() {
+	db.DB = suite.previous
+}
+
+func TestCustomFieldRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomFieldRepositoryTestSuite))
+}
+
+func (suite *CustomFieldRepositoryTestSuite) TestCreateAndFindAll() {
+	fields := NewCustomFieldRepository()
+	for _, name := range []string{"shirt_size", "badge_number"} {
+		_, err := fields.Create(suite.ctx, models.CustomField{Name: name, Type: models.CustomFieldString})
+		suite.Require().NoError(err)
+	}
+	_, err := fields.Create(suite.ctx, models.CustomField{Name: "shirt_size", Type: models.CustomFieldString})
+	suite.ErrorIs(err, models.ErrConflict)
+	// Other tenants define their own fields
+	_, err = fields.Create(tenant.NewContext(context.Background(), "globex"), models.CustomField{Name: "shirt_size", Type: models.CustomFieldString})
+	suite.NoError(err)
+
+	all, err := fields.FindAll(suite.ctx)
+	suite.NoError(err)
+	suite.Require().Len(all, 2)
+	suite.Equal("badge_number", all[0].Name)
+
+	// Updates keep the name and type
+	updated, err := fields.Update(suite.ctx, all[0].ID, models.CustomField{Name: "renamed", Type: models.CustomFieldInteger, Required: true, Description: "Badge"})
+	suite.NoError(err)
+	suite.Equal("badge_number", updated.Name)
+	suite.Equal(models.CustomFieldString, updated.Type)
+	suite.True(updated.Required)
+}
+
+func (suite *CustomFieldRepositoryTestSuite) TestEmployeesFilterOnCustomFields() {
+	employees := NewEmployeeRepository()
+	names := func(values map[string]interface{}) []string {
+		found, total, err := employees.FindAll(suite.ctx, models.EmployeeFilter{
+			Pagination:   models.Pagination{Page: 1, PageSize: 10},
+			CustomFields: values,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(int64(len(found)), total)
+		var names []string
+		for _, employee := range found {
+			names = append(names, employee.Name)
+		}
+		return names
+	}
+
+	suite.Equal([]string{"Ann"}, names(map[string]interface{}{"shirt_size": "M"}))
+	suite.Equal([]string{"Bob"}, names(map[string]interface{}{"badge_number": int64(7)}))
+	suite.Equal([]string{"Bob"}, names(map[string]interface{}{"remote": false, "shirt_size": "L"}))
+	suite.Empty(names(map[string]interface{}{"remote": true, "shirt_size": "L"}))
+}
+
+func (suite *CustomFieldRepositoryTestSuite) TestRemoveCustomField() {
+	employees := NewEmployeeRepository()
+	ids, err := employees.RemoveCustomField(suite.ctx, "shirt_size")
+	suite.NoError(err)
+	suite.Equal([]uint{1, 2}, ids)
+
+	ann, err := employees.FindByID(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Equal(models.CustomFieldValues{"badge_number": float64(42), "remote": true}, ann.CustomFields)
+
+	ids, err = employees.RemoveCustomField(suite.ctx, "shirt_size")
+	suite.NoError(err)
+	suite.Empty(ids)
+}
@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+type DepartmentRepository interface {
+	FindAll(ctx context.Context) ([]models.Department, error)
+	FindByID(ctx context.Context, id uint) (models.Department, error)
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Department, error)
+	Create(ctx context.Context, department models.Department) (models.Department, error)
+	Update(ctx context.Context, id uint, department models.Department) (models.Department, error)
+	Delete(ctx context.Context, id uint) error
+}
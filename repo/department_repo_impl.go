@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+)
+
+type departmentRepositoryImpl struct{}
+
+func NewDepartmentRepository() DepartmentRepository {
+	return &departmentRepositoryImpl{}
+}
+
+func (r *departmentRepositoryImpl) FindAll(ctx context.Context) ([]models.Department, error) {
+	var departments []models.Department
+	result := conn(ctx).Order("id").Find(&departments)
+	return departments, result.Error
+}
+
+func (r *departmentRepositoryImpl) FindByID(ctx context.Context, id uint) (models.Department, error) {
+	var department models.Department
+	result := conn(ctx).First(&department, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return department, models.ErrDepartmentNotFound
+		}
+		return department, result.Error
+	}
+	return department, nil
+}
+
+// FindByIDs returns the departments whose ID is in the list, skipping missing IDs
+func (r *departmentRepositoryImpl) FindByIDs(ctx context.Context, ids []uint) ([]models.Department, error) {
+	var departments []models.Department
+	if len(ids) == 0 {
+		return departments, nil
+	}
+	result := conn(ctx).Where("id IN ?", ids).Order("id").Find(&departments)
+	return departments, result.Error
+}
+
+func (r *departmentRepositoryImpl) Create(ctx context.Context, department models.Department) (models.Department, error) {
+	result := conn(ctx).Create(&department)
+	return department, translateDepartmentError(result.Error, department.Name)
+}
+
+func (r *departmentRepositoryImpl) Update(ctx context.Context, id uint, department models.Department) (models.Department, error) {
+	existingDepartment, err := r.FindByID(ctx, id)
+	if err != nil {
+		return existingDepartment, err
+	}
+
+	existingDepartment.Name = department.Name
+	existingDepartment.Description = department.Description
+
+	result := conn(ctx).Save(&existingDepartment)
+	return existingDepartment, translateDepartmentError(result.Error, department.Name)
+}
+
+func (r *departmentRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	department, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var members int64
+	if err := conn(ctx).Model(&models.Employee{}).Where("department_id = ?", id).Count(&members).Error; err != nil {
+		return err
+	}
+	if members > 0 {
+		return fmt.Errorf("%w: department %d still has %d employees assigned", models.ErrConflict, id, members)
+	}
+
+	return conn(ctx).Delete(&department).Error
+}
+
+// translateDepartmentError reports a duplicate department name as a conflict
+func translateDepartmentError(err error, name string) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("%w: department %q already exists", models.ErrConflict, name)
+	}
+	return err
+}
@@ -1,13 +1,29 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/chinmay-sawant/gin-example/models"
 )
 
 type EmployeeRepository interface {
-	FindAll() ([]models.Employee, error)
-	FindByID(id uint) (models.Employee, error)
-	Create(employee models.Employee) (models.Employee, error)
-	Update(id uint, employee models.Employee) (models.Employee, error)
-	Delete(id uint) error
+	Repository[models.Employee, uint]
+	FindAll(ctx context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error)
+	FindByIDWithView(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error)
+	FindDirectReports(ctx context.Context, managerID uint) ([]models.Employee, error)
+	FindReportingChain(ctx context.Context, id uint) ([]models.Employee, error)
+	FindSubordinates(ctx context.Context, managerID uint) ([]models.Employee, error)
+	FindTopLevel(ctx context.Context) ([]models.Employee, error)
+	UpdateStatus(ctx context.Context, employee models.Employee, transition models.EmployeeStatusTransition) (models.Employee, error)
+	FindStatusHistory(ctx context.Context, id uint) ([]models.EmployeeStatusTransition, error)
+	CreateBatch(ctx context.Context, employees []models.Employee, batchSize int) ([]models.Employee, error)
+	UpdateBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error)
+	DeleteBatch(ctx context.Context, ids []uint) error
+	FindByEmails(ctx context.Context, emails []string) ([]models.Employee, error)
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Employee, error)
+	FindByManagerIDs(ctx context.Context, managerIDs []uint) ([]models.Employee, error)
+	FindSalaryHistory(ctx context.Context, employeeIDs []uint) ([]models.SalaryChange, error)
+	UpsertBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error)
+	FindInBatches(ctx context.Context, filter models.EmployeeFilter, batchSize int, fn func([]models.Employee) error) error
+	RemoveCustomField(ctx context.Context, name string) ([]uint, error)
 }
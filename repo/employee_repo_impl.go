@@ -1,71 +1,456 @@
 package repo
 
 import (
+	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 
-	"github.com/chinmay-sawant/gin-example/db"
 	"github.com/chinmay-sawant/gin-example/models"
 	"gorm.io/gorm"
 )
 
-type employeeRepositoryImpl struct{}
+// maxHierarchyDepth bounds the recursive hierarchy queries so that corrupt
+// data containing a reporting cycle cannot make them recurse forever
+const maxHierarchyDepth = 64
+
+// employeeResource configures the generic repository employees are read
+// and written through. Every write records its change in the outbox in the
+// same transaction.
+var employeeResource = Resource[models.Employee, uint]{
+	NotFound: models.ErrEmployeeNotFound,
+	Merge:    copyEditableFields,
+	AfterCreate: func(tx *gorm.DB, created models.Employee) error {
+		return recordChanges(tx, employeeCreated(created))
+	},
+	AfterUpdate: func(tx *gorm.DB, previous, updated models.Employee) error {
+		return recordChanges(tx, employeeUpdated(previous, updated))
+	},
+	Delete: deleteEmployee,
+}
+
+type employeeRepositoryImpl struct {
+	Repository[models.Employee, uint]
+}
 
 func NewEmployeeRepository() EmployeeRepository {
-	return &employeeRepositoryImpl{}
+	return &employeeRepositoryImpl{Repository: NewRepository(employeeResource)}
 }
 
-func (r *employeeRepositoryImpl) FindAll() ([]models.Employee, error) {
+func (r *employeeRepositoryImpl) FindAll(ctx context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
 	var employees []models.Employee
-	result := db.DB.Find(&employees)
-	return employees, result.Error
+	var total int64
+	if err := conn(ctx).Model(&models.Employee{}).Scopes(filterEmployees(filter)).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	result := conn(ctx).Scopes(filterEmployees(filter), paginate(filter.Pagination), selectView(filter.View)).Order("employees.id").Find(&employees)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return employees, total, expandEmployees(ctx, employees, filter.View)
+}
+
+// FindInBatches streams every employee matching the filter to fn, batchSize
+// rows at a time in ID order, without loading the whole result into memory.
+// Pagination in the filter is ignored.
+func (r *employeeRepositoryImpl) FindInBatches(ctx context.Context, filter models.EmployeeFilter, batchSize int, fn func([]models.Employee) error) error {
+	var batch []models.Employee
+	result := conn(ctx).Scopes(filterEmployees(filter)).FindInBatches(&batch, batchSize, func(*gorm.DB, int) error {
+		return fn(batch)
+	})
+	return result.Error
+}
+
+// RemoveCustomField drops the value of the custom field from every employee
+// holding one and returns the IDs of those employees
+func (r *employeeRepositoryImpl) RemoveCustomField(ctx context.Context, name string) ([]uint, error) {
+	var ids []uint
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Employee{}).Where("json_extract(employees.custom_fields, ?) IS NOT NULL", customFieldPath(name)).
+			Pluck("employees.id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		return tx.Model(&models.Employee{}).Where("employees.id IN ?", ids).
+			UpdateColumn("custom_fields", gorm.Expr("json_remove(custom_fields, ?)", customFieldPath(name))).Error
+	})
+	return ids, err
 }
 
-func (r *employeeRepositoryImpl) FindByID(id uint) (models.Employee, error) {
+// FindByIDWithView loads an employee with only the view's columns and embeds
+// the relations it expands
+func (r *employeeRepositoryImpl) FindByIDWithView(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error) {
 	var employee models.Employee
-	result := db.DB.First(&employee, id)
+	result := conn(ctx).Scopes(selectView(view)).First(&employee, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return employee, errors.New("employee not found")
+			return employee, models.ErrEmployeeNotFound
 		}
 		return employee, result.Error
 	}
-	return employee, nil
+	employees := []models.Employee{employee}
+	err := expandEmployees(ctx, employees, view)
+	return employees[0], err
 }
 
-func (r *employeeRepositoryImpl) Create(employee models.Employee) (models.Employee, error) {
-	result := db.DB.Create(&employee)
-	return employee, result.Error
+// CreateBatch inserts all employees in one transaction, batchSize rows per statement
+func (r *employeeRepositoryImpl) CreateBatch(ctx context.Context, employees []models.Employee, batchSize int) ([]models.Employee, error) {
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(&employees, batchSize).Error; err != nil {
+			return err
+		}
+		events := make([]models.OutboxEvent, len(employees))
+		for i, employee := range employees {
+			events[i] = employeeCreated(employee)
+		}
+		return recordChanges(tx, events...)
+	})
+	return employees, err
 }
 
-func (r *employeeRepositoryImpl) Update(id uint, employee models.Employee) (models.Employee, error) {
-	var existingEmployee models.Employee
-	result := db.DB.First(&existingEmployee, id)
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return existingEmployee, errors.New("employee not found")
+// UpdateBatch applies all updates in one transaction, failing on the first missing employee
+func (r *employeeRepositoryImpl) UpdateBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	updated := make([]models.Employee, 0, len(employees))
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, employee := range employees {
+			var existingEmployee models.Employee
+			if err := tx.First(&existingEmployee, employee.ID).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return fmt.Errorf("%w: %d", models.ErrEmployeeNotFound, employee.ID)
+				}
+				return err
+			}
+			previous := existingEmployee
+			copyEditableFields(&existingEmployee, employee)
+			if err := tx.Save(&existingEmployee).Error; err != nil {
+				return err
+			}
+			if err := recordChanges(tx, employeeUpdated(previous, existingEmployee)); err != nil {
+				return err
+			}
+			updated = append(updated, existingEmployee)
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// DeleteBatch deletes all employees in one transaction, failing on the first missing employee
+func (r *employeeRepositoryImpl) DeleteBatch(ctx context.Context, ids []uint) error {
+	return conn(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, id := range ids {
+			if err := deleteEmployee(tx, id); err != nil {
+				return err
+			}
 		}
-		return existingEmployee, result.Error
+		return nil
+	})
+}
+
+// FindByEmails returns the employees whose email is in the list
+func (r *employeeRepositoryImpl) FindByEmails(ctx context.Context, emails []string) ([]models.Employee, error) {
+	var employees []models.Employee
+	if len(emails) == 0 {
+		return employees, nil
+	}
+	result := conn(ctx).Where("email IN ?", emails).Order("id").Find(&employees)
+	return employees, result.Error
+}
+
+// FindByIDs returns the employees whose ID is in the list, skipping missing IDs
+func (r *employeeRepositoryImpl) FindByIDs(ctx context.Context, ids []uint) ([]models.Employee, error) {
+	var employees []models.Employee
+	if len(ids) == 0 {
+		return employees, nil
 	}
+	result := conn(ctx).Where("id IN ?", ids).Order("id").Find(&employees)
+	return employees, result.Error
+}
+
+// FindByManagerIDs returns the direct reports of all the managers, ordered by manager and ID
+func (r *employeeRepositoryImpl) FindByManagerIDs(ctx context.Context, managerIDs []uint) ([]models.Employee, error) {
+	var employees []models.Employee
+	if len(managerIDs) == 0 {
+		return employees, nil
+	}
+	result := conn(ctx).Where("manager_id IN ?", managerIDs).Order("manager_id, id").Find(&employees)
+	return employees, result.Error
+}
+
+// FindSalaryHistory returns the salary changes of all the employees, ordered
+// by employee and then oldest first
+func (r *employeeRepositoryImpl) FindSalaryHistory(ctx context.Context, employeeIDs []uint) ([]models.SalaryChange, error) {
+	var changes []models.SalaryChange
+	if len(employeeIDs) == 0 {
+		return changes, nil
+	}
+	result := conn(ctx).Where("employee_id IN ?", employeeIDs).Order("employee_id, id").Find(&changes)
+	return changes, result.Error
+}
 
+// UpsertBatch saves all employees in one transaction, creating those without
+// an ID and updating the rest
+func (r *employeeRepositoryImpl) UpsertBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	saved := make([]models.Employee, 0, len(employees))
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, employee := range employees {
+			if employee.ID == 0 {
+				if err := tx.Create(&employee).Error; err != nil {
+					return err
+				}
+				if err := recordChanges(tx, employeeCreated(employee)); err != nil {
+					return err
+				}
+				saved = append(saved, employee)
+				continue
+			}
+			var existingEmployee models.Employee
+			if err := tx.First(&existingEmployee, employee.ID).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return fmt.Errorf("%w: %d", models.ErrEmployeeNotFound, employee.ID)
+				}
+				return err
+			}
+			previous := existingEmployee
+			copyEditableFields(&existingEmployee, employee)
+			if err := tx.Save(&existingEmployee).Error; err != nil {
+				return err
+			}
+			if err := recordChanges(tx, employeeUpdated(previous, existingEmployee)); err != nil {
+				return err
+			}
+			saved = append(saved, existingEmployee)
+		}
+		return nil
+	})
+	return saved, err
+}
+
+// expandEmployees embeds the relations the view expands, with one query per
+// relation rather than one per employee
+func expandEmployees(ctx context.Context, employees []models.Employee, view models.EmployeeView) error {
+	if view.Expands(models.ExpandDepartment) {
+		var departments []models.Department
+		if ids := collectIDs(employees, func(e *models.Employee) *uint { return e.DepartmentID }); len(ids) > 0 {
+			if err := conn(ctx).Find(&departments, ids).Error; err != nil {
+				return err
+			}
+		}
+		byID := make(map[uint]*models.Department, len(departments))
+		for i := range departments {
+			byID[departments[i].ID] = &departments[i]
+		}
+		for i := range employees {
+			if employees[i].DepartmentID != nil {
+				employees[i].Department = byID[*employees[i].DepartmentID]
+			}
+		}
+	}
+	if view.Expands(models.ExpandManager) {
+		var managers []models.Employee
+		if ids := collectIDs(employees, func(e *models.Employee) *uint { return e.ManagerID }); len(ids) > 0 {
+			if err := conn(ctx).Find(&managers, ids).Error; err != nil {
+				return err
+			}
+		}
+		byID := make(map[uint]*models.Employee, len(managers))
+		for i := range managers {
+			byID[managers[i].ID] = &managers[i]
+		}
+		for i := range employees {
+			if employees[i].ManagerID != nil {
+				employees[i].Manager = byID[*employees[i].ManagerID]
+			}
+		}
+	}
+	if view.Expands(models.ExpandTags) {
+		ids := make([]uint, len(employees))
+		for i := range employees {
+			ids[i] = employees[i].ID
+		}
+		tags, err := findEmployeeTags(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for i := range employees {
+			// Empty rather than nil, so sparse responses list no tags instead of null
+			employees[i].Tags = append([]string{}, tags[employees[i].ID]...)
+		}
+	}
+	return nil
+}
+
+// collectIDs returns the distinct non-nil IDs picked from the employees
+func collectIDs(employees []models.Employee, pick func(*models.Employee) *uint) []uint {
+	seen := make(map[uint]bool)
+	var ids []uint
+	for i := range employees {
+		if id := pick(&employees[i]); id != nil && !seen[*id] {
+			seen[*id] = true
+			ids = append(ids, *id)
+		}
+	}
+	return ids
+}
+
+// recordChanges stores the outbox events of employee changes inside tx and
+// appends to the salary history of created employees and of updates that
+// change the salary or currency
+func recordChanges(tx *gorm.DB, events ...models.OutboxEvent) error {
+	if err := recordEvents(tx, events...); err != nil {
+		return err
+	}
+	var changes []models.SalaryChange
+	for _, event := range events {
+		employee := event.Employee
+		created := event.Type == models.EventEmployeeCreated
+		changed := event.Type == models.EventEmployeeUpdated && event.Previous != nil &&
+			(event.Previous.Salary != employee.Salary || event.Previous.Currency != employee.Currency)
+		if !created && !changed {
+			continue
+		}
+		changes = append(changes, models.SalaryChange{
+			EmployeeID: employee.ID,
+			Salary:     employee.Salary,
+			Currency:   employee.Currency,
+			ChangedAt:  event.OccurredAt,
+		})
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return tx.Create(&changes).Error
+}
+
+// copyEditableFields copies the fields an update may change; status and
+// termination details only change through UpdateStatus
+func copyEditableFields(existingEmployee *models.Employee, employee models.Employee) {
 	existingEmployee.Name = employee.Name
 	existingEmployee.Email = employee.Email
 	existingEmployee.Position = employee.Position
+	existingEmployee.PositionID = employee.PositionID
 	existingEmployee.Salary = employee.Salary
+	existingEmployee.Currency = employee.Currency
+	existingEmployee.SalaryOutOfBand = employee.SalaryOutOfBand
+	existingEmployee.DepartmentID = employee.DepartmentID
+	existingEmployee.ManagerID = employee.ManagerID
 	existingEmployee.JoinDate = employee.JoinDate
-
-	db.DB.Save(&existingEmployee)
-	return existingEmployee, nil
+	if employee.CustomFields != nil {
+		existingEmployee.CustomFields = employee.CustomFields
+	}
 }
 
-func (r *employeeRepositoryImpl) Delete(id uint) error {
+// deleteEmployee removes an employee and their tags inside tx, handing their
+// reports to their own manager so the reporting chain stays intact, and
+// records the deletion with the last stored record
+func deleteEmployee(tx *gorm.DB, id uint) error {
 	var employee models.Employee
-	result := db.DB.First(&employee, id)
+	result := tx.First(&employee, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return errors.New("employee not found")
+			return fmt.Errorf("%w: %d", models.ErrEmployeeNotFound, id)
 		}
 		return result.Error
 	}
-	db.DB.Delete(&employee)
-	return nil
+
+	if err := tx.Model(&models.Employee{}).Where("manager_id = ?", id).Update("manager_id", employee.ManagerID).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("employee_id = ?", id).Delete(&models.EmployeeTag{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Delete(&employee).Error; err != nil {
+		return err
+	}
+	return recordChanges(tx, employeeDeleted(employee))
+}
+
+func (r *employeeRepositoryImpl) FindDirectReports(ctx context.Context, managerID uint) ([]models.Employee, error) {
+	var employees []models.Employee
+	result := conn(ctx).Where("manager_id = ?", managerID).Order("id").Find(&employees)
+	return employees, result.Error
+}
+
+// FindReportingChain returns the managers above the employee, nearest first
+func (r *employeeRepositoryImpl) FindReportingChain(ctx context.Context, id uint) ([]models.Employee, error) {
+	tenantID, err := rawTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var employees []models.Employee
+	result := conn(ctx).Raw(`
+		WITH RECURSIVE chain (id, manager_id, depth) AS (
+			SELECT id, manager_id, 0 FROM employees WHERE id = @id AND tenant_id = @tenant
+			UNION ALL
+			SELECT e.id, e.manager_id, chain.depth + 1
+			FROM employees e JOIN chain ON e.id = chain.manager_id
+			WHERE chain.depth < @depth AND e.tenant_id = @tenant
+		)
+		SELECT employees.* FROM employees JOIN chain ON employees.id = chain.id
+		WHERE chain.depth > 0
+		ORDER BY chain.depth`, sql.Named("id", id), sql.Named("tenant", tenantID), sql.Named("depth", maxHierarchyDepth)).Scan(&employees)
+	return employees, result.Error
+}
+
+// FindSubordinates returns everyone below the manager, level by level
+func (r *employeeRepositoryImpl) FindSubordinates(ctx context.Context, managerID uint) ([]models.Employee, error) {
+	tenantID, err := rawTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var employees []models.Employee
+	result := conn(ctx).Raw(`
+		WITH RECURSIVE subtree (id, depth) AS (
+			SELECT id, 1 FROM employees WHERE manager_id = @manager AND tenant_id = @tenant
+			UNION ALL
+			SELECT e.id, subtree.depth + 1
+			FROM employees e JOIN subtree ON e.manager_id = subtree.id
+			WHERE subtree.depth < @depth AND e.tenant_id = @tenant
+		)
+		SELECT employees.* FROM employees JOIN subtree ON employees.id = subtree.id
+		ORDER BY subtree.depth, employees.id`, sql.Named("manager", managerID), sql.Named("tenant", tenantID), sql.Named("depth", maxHierarchyDepth)).Scan(&employees)
+	return employees, result.Error
+}
+
+func (r *employeeRepositoryImpl) FindTopLevel(ctx context.Context) ([]models.Employee, error) {
+	var employees []models.Employee
+	result := conn(ctx).Where("manager_id IS NULL").Order("id").Find(&employees)
+	return employees, result.Error
+}
+
+// UpdateStatus saves the employee's lifecycle fields and records the transition
+// and the change event atomically. It fails with ErrConflict when the stored
+// status is no longer the transition's FromStatus.
+func (r *employeeRepositoryImpl) UpdateStatus(ctx context.Context, employee models.Employee, transition models.EmployeeStatusTransition) (models.Employee, error) {
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous models.Employee
+		if err := tx.First(&previous, employee.ID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return models.ErrEmployeeNotFound
+			}
+			return err
+		}
+		// The status only changes from the one the transition was checked
+		// against, so of two concurrent transitions from it one fails
+		result := tx.Model(&employee).Where("status = ?", transition.FromStatus).
+			Select("status", "termination_date", "termination_reason").Updates(&employee)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: employee %d is no longer %s", models.ErrConflict, employee.ID, transition.FromStatus)
+		}
+		transition.EmployeeID = employee.ID
+		if err := tx.Create(&transition).Error; err != nil {
+			return err
+		}
+		return recordChanges(tx, employeeUpdated(previous, employee))
+	})
+	return employee, err
+}
+
+func (r *employeeRepositoryImpl) FindStatusHistory(ctx context.Context, id uint) ([]models.EmployeeStatusTransition, error) {
+	var transitions []models.EmployeeStatusTransition
+	result := conn(ctx).Where("employee_id = ?", id).Order("id").Find(&transitions)
+	return transitions, result.Error
 }
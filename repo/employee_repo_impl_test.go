@@ -0,0 +1,62 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type EmployeeRepositoryTestSuite struct {
+	suite.Suite
+	previous  *gorm.DB
+	ctx       context.Context
+	employees EmployeeRepository
+}
+
+func (suite *EmployeeRepositoryTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{TranslateError: true})
+	suite.Require().NoError(err)
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	scoped := []interface{}{&models.Employee{}, &models.EmployeeStatusTransition{}, &models.SalaryChange{}, &models.OutboxEvent{}}
+	suite.Require().NoError(database.AutoMigrate(scoped...))
+	suite.Require().NoError(database.Use(tenant.NewPlugin(scoped...)))
+	suite.previous, db.DB = db.DB, database
+
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+	suite.employees = NewEmployeeRepository()
+	suite.Require().NoError(db.DB.WithContext(suite.ctx).Create(&models.Employee{Name: "Ann", Status: models.StatusActive}).Error)
+}
+
+func (suite *EmployeeRepositoryTestSuite) TearDownTest() {
+	db.DB = suite.previous
+}
+
+func TestEmployeeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmployeeRepositoryTestSuite))
+}
+
+func (suite *EmployeeRepositoryTestSuite) TestUpdateStatusOnlyChangesTheCheckedStatus() {
+	// Two requests both read Ann as active and move her on
+	leave := models.Employee{ID: 1, Name: "Ann", Status: models.StatusOnLeave}
+	_, err := suite.employees.UpdateStatus(suite.ctx, leave, models.EmployeeStatusTransition{FromStatus: models.StatusActive, ToStatus: models.StatusOnLeave})
+	suite.NoError(err)
+
+	terminated := models.Employee{ID: 1, Name: "Ann", Status: models.StatusTerminated}
+	_, err = suite.employees.UpdateStatus(suite.ctx, terminated, models.EmployeeStatusTransition{FromStatus: models.StatusActive, ToStatus: models.StatusTerminated})
+	suite.ErrorIs(err, models.ErrConflict)
+
+	stored, err := suite.employees.FindByID(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Equal(models.StatusOnLeave, stored.Status)
+	history, err := suite.employees.FindStatusHistory(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Len(history, 1)
+}
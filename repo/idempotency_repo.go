@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+type IdempotencyRepository interface {
+	Create(ctx context.Context, record models.IdempotencyRecord) (models.IdempotencyRecord, error)
+	Find(ctx context.Context, scope, key string) (models.IdempotencyRecord, error)
+	Update(ctx context.Context, record models.IdempotencyRecord) error
+	Delete(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
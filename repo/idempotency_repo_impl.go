@@ -0,0 +1,51 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+)
+
+type idempotencyRepositoryImpl struct{}
+
+func NewIdempotencyRepository() IdempotencyRepository {
+	return &idempotencyRepositoryImpl{}
+}
+
+// Create stores the record, returning ErrConflict when the caller already
+// has a record with the same key. The unique index makes this the point
+// where concurrent requests with one key are told apart.
+func (r *idempotencyRepositoryImpl) Create(ctx context.Context, record models.IdempotencyRecord) (models.IdempotencyRecord, error) {
+	result := conn(ctx).Create(&record)
+	if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+		return record, fmt.Errorf("%w: idempotency key %q is already in use", models.ErrConflict, record.Key)
+	}
+	return record, result.Error
+}
+
+// Find returns the caller's record for the key, or gorm.ErrRecordNotFound.
+// The conditions are given as a struct so GORM quotes the key column, whose
+// name is reserved in MySQL.
+func (r *idempotencyRepositoryImpl) Find(ctx context.Context, scope, key string) (models.IdempotencyRecord, error) {
+	var record models.IdempotencyRecord
+	result := conn(ctx).Where(&models.IdempotencyRecord{Scope: scope, Key: key}).First(&record)
+	return record, result.Error
+}
+
+func (r *idempotencyRepositoryImpl) Update(ctx context.Context, record models.IdempotencyRecord) error {
+	return conn(ctx).Save(&record).Error
+}
+
+func (r *idempotencyRepositoryImpl) Delete(ctx context.Context, scope, key string) error {
+	return conn(ctx).Where(&models.IdempotencyRecord{Scope: scope, Key: key}).Delete(&models.IdempotencyRecord{}).Error
+}
+
+// DeleteExpired removes records that expired before now, returning how many were removed
+func (r *idempotencyRepositoryImpl) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := conn(ctx).Where("expires_at <= ?", now).Delete(&models.IdempotencyRecord{})
+	return result.RowsAffected, result.Error
+}
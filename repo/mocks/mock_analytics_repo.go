@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\analytics_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\analytics_repo.go -destination=repo\mocks\mock_analytics_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAnalyticsRepository is a mock of AnalyticsRepository interface.
+type MockAnalyticsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAnalyticsRepositoryMockRecorder is the mock recorder for MockAnalyticsRepository.
+type MockAnalyticsRepositoryMockRecorder struct {
+	mock *MockAnalyticsRepository
+}
+
+// NewMockAnalyticsRepository creates a new mock instance.
+func NewMockAnalyticsRepository(ctrl *gomock.Controller) *MockAnalyticsRepository {
+	mock := &MockAnalyticsRepository{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsRepository) EXPECT() *MockAnalyticsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountHeadcount mocks base method.
+func (m *MockAnalyticsRepository) CountHeadcount(ctx context.Context, filter models.AnalyticsFilter) ([]models.HeadcountGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountHeadcount", ctx, filter)
+	ret0, _ := ret[0].([]models.HeadcountGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountHeadcount indicates an expected call of CountHeadcount.
+func (mr *MockAnalyticsRepositoryMockRecorder) CountHeadcount(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountHeadcount", reflect.TypeOf((*MockAnalyticsRepository)(nil).CountHeadcount), ctx, filter)
+}
+
+// CountMovements mocks base method.
+func (m *MockAnalyticsRepository) CountMovements(ctx context.Context, filter models.MovementFilter) ([]models.MonthlyMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMovements", ctx, filter)
+	ret0, _ := ret[0].([]models.MonthlyMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMovements indicates an expected call of CountMovements.
+func (mr *MockAnalyticsRepositoryMockRecorder) CountMovements(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMovements", reflect.TypeOf((*MockAnalyticsRepository)(nil).CountMovements), ctx, filter)
+}
+
+// CountTenure mocks base method.
+func (m *MockAnalyticsRepository) CountTenure(ctx context.Context, filter models.AnalyticsFilter, asOf time.Time) ([]models.TenureBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTenure", ctx, filter, asOf)
+	ret0, _ := ret[0].([]models.TenureBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTenure indicates an expected call of CountTenure.
+func (mr *MockAnalyticsRepositoryMockRecorder) CountTenure(ctx, filter, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTenure", reflect.TypeOf((*MockAnalyticsRepository)(nil).CountTenure), ctx, filter, asOf)
+}
+
+// SalaryStats mocks base method.
+func (m *MockAnalyticsRepository) SalaryStats(ctx context.Context, filter models.AnalyticsFilter) ([]models.SalaryStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalaryStats", ctx, filter)
+	ret0, _ := ret[0].([]models.SalaryStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalaryStats indicates an expected call of SalaryStats.
+func (mr *MockAnalyticsRepositoryMockRecorder) SalaryStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalaryStats", reflect.TypeOf((*MockAnalyticsRepository)(nil).SalaryStats), ctx, filter)
+}
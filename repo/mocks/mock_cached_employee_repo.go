@@ -0,0 +1,401 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\cached_employee_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\cached_employee_repo.go -destination=repo\mocks\mock_cached_employee_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	cache "github.com/chinmay-sawant/gin-example/cache"
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCachedEmployeeRepository is a mock of CachedEmployeeRepository interface.
+type MockCachedEmployeeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCachedEmployeeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCachedEmployeeRepositoryMockRecorder is the mock recorder for MockCachedEmployeeRepository.
+type MockCachedEmployeeRepositoryMockRecorder struct {
+	mock *MockCachedEmployeeRepository
+}
+
+// NewMockCachedEmployeeRepository creates a new mock instance.
+func NewMockCachedEmployeeRepository(ctrl *gomock.Controller) *MockCachedEmployeeRepository {
+	mock := &MockCachedEmployeeRepository{ctrl: ctrl}
+	mock.recorder = &MockCachedEmployeeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCachedEmployeeRepository) EXPECT() *MockCachedEmployeeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CacheStats mocks base method.
+func (m *MockCachedEmployeeRepository) CacheStats() cache.Stats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats")
+	ret0, _ := ret[0].(cache.Stats)
+	return ret0
+}
+
+// CacheStats indicates an expected call of CacheStats.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) CacheStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).CacheStats))
+}
+
+// Create mocks base method.
+func (m *MockCachedEmployeeRepository) Create(ctx context.Context, entity models.Employee) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).Create), ctx, entity)
+}
+
+// CreateBatch mocks base method.
+func (m *MockCachedEmployeeRepository) CreateBatch(ctx context.Context, employees []models.Employee, batchSize int) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, employees, batchSize)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) CreateBatch(ctx, employees, batchSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).CreateBatch), ctx, employees, batchSize)
+}
+
+// Delete mocks base method.
+func (m *MockCachedEmployeeRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteBatch mocks base method.
+func (m *MockCachedEmployeeRepository) DeleteBatch(ctx context.Context, ids []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatch", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBatch indicates an expected call of DeleteBatch.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) DeleteBatch(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatch", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).DeleteBatch), ctx, ids)
+}
+
+// FindAll mocks base method.
+func (m *MockCachedEmployeeRepository) FindAll(ctx context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindByEmails mocks base method.
+func (m *MockCachedEmployeeRepository) FindByEmails(ctx context.Context, emails []string) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmails", ctx, emails)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmails indicates an expected call of FindByEmails.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindByEmails(ctx, emails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmails", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindByEmails), ctx, emails)
+}
+
+// FindByID mocks base method.
+func (m *MockCachedEmployeeRepository) FindByID(ctx context.Context, id uint) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByIDWithView mocks base method.
+func (m *MockCachedEmployeeRepository) FindByIDWithView(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDWithView", ctx, id, view)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDWithView indicates an expected call of FindByIDWithView.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindByIDWithView(ctx, id, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDWithView", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindByIDWithView), ctx, id, view)
+}
+
+// FindByIDs mocks base method.
+func (m *MockCachedEmployeeRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindByIDs), ctx, ids)
+}
+
+// FindByManagerIDs mocks base method.
+func (m *MockCachedEmployeeRepository) FindByManagerIDs(ctx context.Context, managerIDs []uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByManagerIDs", ctx, managerIDs)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByManagerIDs indicates an expected call of FindByManagerIDs.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindByManagerIDs(ctx, managerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByManagerIDs", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindByManagerIDs), ctx, managerIDs)
+}
+
+// FindDirectReports mocks base method.
+func (m *MockCachedEmployeeRepository) FindDirectReports(ctx context.Context, managerID uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDirectReports", ctx, managerID)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDirectReports indicates an expected call of FindDirectReports.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindDirectReports(ctx, managerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDirectReports", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindDirectReports), ctx, managerID)
+}
+
+// FindInBatches mocks base method.
+func (m *MockCachedEmployeeRepository) FindInBatches(ctx context.Context, filter models.EmployeeFilter, batchSize int, fn func([]models.Employee) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInBatches", ctx, filter, batchSize, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindInBatches indicates an expected call of FindInBatches.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindInBatches(ctx, filter, batchSize, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInBatches", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindInBatches), ctx, filter, batchSize, fn)
+}
+
+// FindReportingChain mocks base method.
+func (m *MockCachedEmployeeRepository) FindReportingChain(ctx context.Context, id uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReportingChain", ctx, id)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReportingChain indicates an expected call of FindReportingChain.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindReportingChain(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReportingChain", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindReportingChain), ctx, id)
+}
+
+// FindSalaryHistory mocks base method.
+func (m *MockCachedEmployeeRepository) FindSalaryHistory(ctx context.Context, employeeIDs []uint) ([]models.SalaryChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSalaryHistory", ctx, employeeIDs)
+	ret0, _ := ret[0].([]models.SalaryChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSalaryHistory indicates an expected call of FindSalaryHistory.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindSalaryHistory(ctx, employeeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSalaryHistory", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindSalaryHistory), ctx, employeeIDs)
+}
+
+// FindStatusHistory mocks base method.
+func (m *MockCachedEmployeeRepository) FindStatusHistory(ctx context.Context, id uint) ([]models.EmployeeStatusTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStatusHistory", ctx, id)
+	ret0, _ := ret[0].([]models.EmployeeStatusTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStatusHistory indicates an expected call of FindStatusHistory.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindStatusHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStatusHistory", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindStatusHistory), ctx, id)
+}
+
+// FindSubordinates mocks base method.
+func (m *MockCachedEmployeeRepository) FindSubordinates(ctx context.Context, managerID uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubordinates", ctx, managerID)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubordinates indicates an expected call of FindSubordinates.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindSubordinates(ctx, managerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubordinates", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindSubordinates), ctx, managerID)
+}
+
+// FindTopLevel mocks base method.
+func (m *MockCachedEmployeeRepository) FindTopLevel(ctx context.Context) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTopLevel", ctx)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTopLevel indicates an expected call of FindTopLevel.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) FindTopLevel(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTopLevel", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).FindTopLevel), ctx)
+}
+
+// List mocks base method.
+func (m *MockCachedEmployeeRepository) List(ctx context.Context, query models.ListQuery) ([]models.Employee, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).List), ctx, query)
+}
+
+// RemoveCustomField mocks base method.
+func (m *MockCachedEmployeeRepository) RemoveCustomField(ctx context.Context, name string) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCustomField", ctx, name)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCustomField indicates an expected call of RemoveCustomField.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) RemoveCustomField(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCustomField", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).RemoveCustomField), ctx, name)
+}
+
+// Update mocks base method.
+func (m *MockCachedEmployeeRepository) Update(ctx context.Context, id uint, entity models.Employee) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) Update(ctx, id, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).Update), ctx, id, entity)
+}
+
+// UpdateBatch mocks base method.
+func (m *MockCachedEmployeeRepository) UpdateBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBatch", ctx, employees)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBatch indicates an expected call of UpdateBatch.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) UpdateBatch(ctx, employees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBatch", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).UpdateBatch), ctx, employees)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockCachedEmployeeRepository) UpdateStatus(ctx context.Context, employee models.Employee, transition models.EmployeeStatusTransition) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, employee, transition)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) UpdateStatus(ctx, employee, transition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).UpdateStatus), ctx, employee, transition)
+}
+
+// UpsertBatch mocks base method.
+func (m *MockCachedEmployeeRepository) UpsertBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBatch", ctx, employees)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBatch indicates an expected call of UpsertBatch.
+func (mr *MockCachedEmployeeRepositoryMockRecorder) UpsertBatch(ctx, employees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBatch", reflect.TypeOf((*MockCachedEmployeeRepository)(nil).UpsertBatch), ctx, employees)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\custom_field_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\custom_field_repo.go -destination=repo\mocks\mock_custom_field_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldRepository is a mock of CustomFieldRepository interface.
+type MockCustomFieldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldRepositoryMockRecorder is the mock recorder for MockCustomFieldRepository.
+type MockCustomFieldRepositoryMockRecorder struct {
+	mock *MockCustomFieldRepository
+}
+
+// NewMockCustomFieldRepository creates a new mock instance.
+func NewMockCustomFieldRepository(ctrl *gomock.Controller) *MockCustomFieldRepository {
+	mock := &MockCustomFieldRepository{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldRepository) EXPECT() *MockCustomFieldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCustomFieldRepository) Create(ctx context.Context, entity models.CustomField) (models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomFieldRepositoryMockRecorder) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomFieldRepository)(nil).Create), ctx, entity)
+}
+
+// Delete mocks base method.
+func (m *MockCustomFieldRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCustomFieldRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCustomFieldRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockCustomFieldRepository) FindAll(ctx context.Context) ([]models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCustomFieldRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCustomFieldRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockCustomFieldRepository) FindByID(ctx context.Context, id uint) (models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCustomFieldRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCustomFieldRepository)(nil).FindByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockCustomFieldRepository) List(ctx context.Context, query models.ListQuery) ([]models.CustomField, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]models.CustomField)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomFieldRepositoryMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomFieldRepository)(nil).List), ctx, query)
+}
+
+// Update mocks base method.
+func (m *MockCustomFieldRepository) Update(ctx context.Context, id uint, entity models.CustomField) (models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
+	ret0, _ := ret[0].(models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomFieldRepositoryMockRecorder) Update(ctx, id, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomFieldRepository)(nil).Update), ctx, id, entity)
+}
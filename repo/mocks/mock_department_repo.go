@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\department_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\department_repo.go -destination=repo\mocks\mock_department_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDepartmentRepository is a mock of DepartmentRepository interface.
+type MockDepartmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepositoryMockRecorder is the mock recorder for MockDepartmentRepository.
+type MockDepartmentRepositoryMockRecorder struct {
+	mock *MockDepartmentRepository
+}
+
+// NewMockDepartmentRepository creates a new mock instance.
+func NewMockDepartmentRepository(ctrl *gomock.Controller) *MockDepartmentRepository {
+	mock := &MockDepartmentRepository{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepository) EXPECT() *MockDepartmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDepartmentRepository) Create(ctx context.Context, department models.Department) (models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, department)
+	ret0, _ := ret[0].(models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDepartmentRepositoryMockRecorder) Create(ctx, department any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDepartmentRepository)(nil).Create), ctx, department)
+}
+
+// Delete mocks base method.
+func (m *MockDepartmentRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDepartmentRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDepartmentRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockDepartmentRepository) FindAll(ctx context.Context) ([]models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDepartmentRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDepartmentRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockDepartmentRepository) FindByID(ctx context.Context, id uint) (models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockDepartmentRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDepartmentRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByIDs mocks base method.
+func (m *MockDepartmentRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockDepartmentRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockDepartmentRepository)(nil).FindByIDs), ctx, ids)
+}
+
+// Update mocks base method.
+func (m *MockDepartmentRepository) Update(ctx context.Context, id uint, department models.Department) (models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, department)
+	ret0, _ := ret[0].(models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDepartmentRepositoryMockRecorder) Update(ctx, id, department any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDepartmentRepository)(nil).Update), ctx, id, department)
+}
@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	models "github.com/chinmay-sawant/gin-example/models"
@@ -41,75 +42,345 @@ func (m *MockEmployeeRepository) EXPECT() *MockEmployeeRepositoryMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockEmployeeRepository) Create(employee models.Employee) (models.Employee, error) {
+func (m *MockEmployeeRepository) Create(ctx context.Context, entity models.Employee) (models.Employee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", employee)
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
 	ret0, _ := ret[0].(models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockEmployeeRepositoryMockRecorder) Create(employee any) *gomock.Call {
+func (mr *MockEmployeeRepositoryMockRecorder) Create(ctx, entity any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmployeeRepository)(nil).Create), employee)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmployeeRepository)(nil).Create), ctx, entity)
+}
+
+// CreateBatch mocks base method.
+func (m *MockEmployeeRepository) CreateBatch(ctx context.Context, employees []models.Employee, batchSize int) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, employees, batchSize)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockEmployeeRepositoryMockRecorder) CreateBatch(ctx, employees, batchSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockEmployeeRepository)(nil).CreateBatch), ctx, employees, batchSize)
 }
 
 // Delete mocks base method.
-func (m *MockEmployeeRepository) Delete(id uint) error {
+func (m *MockEmployeeRepository) Delete(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockEmployeeRepositoryMockRecorder) Delete(id any) *gomock.Call {
+func (mr *MockEmployeeRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmployeeRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteBatch mocks base method.
+func (m *MockEmployeeRepository) DeleteBatch(ctx context.Context, ids []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatch", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBatch indicates an expected call of DeleteBatch.
+func (mr *MockEmployeeRepositoryMockRecorder) DeleteBatch(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmployeeRepository)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatch", reflect.TypeOf((*MockEmployeeRepository)(nil).DeleteBatch), ctx, ids)
 }
 
 // FindAll mocks base method.
-func (m *MockEmployeeRepository) FindAll() ([]models.Employee, error) {
+func (m *MockEmployeeRepository) FindAll(ctx context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll")
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockEmployeeRepositoryMockRecorder) FindAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockEmployeeRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindByEmails mocks base method.
+func (m *MockEmployeeRepository) FindByEmails(ctx context.Context, emails []string) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmails", ctx, emails)
 	ret0, _ := ret[0].([]models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAll indicates an expected call of FindAll.
-func (mr *MockEmployeeRepositoryMockRecorder) FindAll() *gomock.Call {
+// FindByEmails indicates an expected call of FindByEmails.
+func (mr *MockEmployeeRepositoryMockRecorder) FindByEmails(ctx, emails any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockEmployeeRepository)(nil).FindAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmails", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByEmails), ctx, emails)
 }
 
 // FindByID mocks base method.
-func (m *MockEmployeeRepository) FindByID(id uint) (models.Employee, error) {
+func (m *MockEmployeeRepository) FindByID(ctx context.Context, id uint) (models.Employee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", id)
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
 	ret0, _ := ret[0].(models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockEmployeeRepositoryMockRecorder) FindByID(id any) *gomock.Call {
+func (mr *MockEmployeeRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByIDWithView mocks base method.
+func (m *MockEmployeeRepository) FindByIDWithView(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDWithView", ctx, id, view)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDWithView indicates an expected call of FindByIDWithView.
+func (mr *MockEmployeeRepositoryMockRecorder) FindByIDWithView(ctx, id, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDWithView", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByIDWithView), ctx, id, view)
+}
+
+// FindByIDs mocks base method.
+func (m *MockEmployeeRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockEmployeeRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByIDs), ctx, ids)
+}
+
+// FindByManagerIDs mocks base method.
+func (m *MockEmployeeRepository) FindByManagerIDs(ctx context.Context, managerIDs []uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByManagerIDs", ctx, managerIDs)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByManagerIDs indicates an expected call of FindByManagerIDs.
+func (mr *MockEmployeeRepositoryMockRecorder) FindByManagerIDs(ctx, managerIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByManagerIDs", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByManagerIDs), ctx, managerIDs)
+}
+
+// FindDirectReports mocks base method.
+func (m *MockEmployeeRepository) FindDirectReports(ctx context.Context, managerID uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDirectReports", ctx, managerID)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDirectReports indicates an expected call of FindDirectReports.
+func (mr *MockEmployeeRepositoryMockRecorder) FindDirectReports(ctx, managerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDirectReports", reflect.TypeOf((*MockEmployeeRepository)(nil).FindDirectReports), ctx, managerID)
+}
+
+// FindInBatches mocks base method.
+func (m *MockEmployeeRepository) FindInBatches(ctx context.Context, filter models.EmployeeFilter, batchSize int, fn func([]models.Employee) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInBatches", ctx, filter, batchSize, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindInBatches indicates an expected call of FindInBatches.
+func (mr *MockEmployeeRepositoryMockRecorder) FindInBatches(ctx, filter, batchSize, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInBatches", reflect.TypeOf((*MockEmployeeRepository)(nil).FindInBatches), ctx, filter, batchSize, fn)
+}
+
+// FindReportingChain mocks base method.
+func (m *MockEmployeeRepository) FindReportingChain(ctx context.Context, id uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReportingChain", ctx, id)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReportingChain indicates an expected call of FindReportingChain.
+func (mr *MockEmployeeRepositoryMockRecorder) FindReportingChain(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReportingChain", reflect.TypeOf((*MockEmployeeRepository)(nil).FindReportingChain), ctx, id)
+}
+
+// FindSalaryHistory mocks base method.
+func (m *MockEmployeeRepository) FindSalaryHistory(ctx context.Context, employeeIDs []uint) ([]models.SalaryChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSalaryHistory", ctx, employeeIDs)
+	ret0, _ := ret[0].([]models.SalaryChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSalaryHistory indicates an expected call of FindSalaryHistory.
+func (mr *MockEmployeeRepositoryMockRecorder) FindSalaryHistory(ctx, employeeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSalaryHistory", reflect.TypeOf((*MockEmployeeRepository)(nil).FindSalaryHistory), ctx, employeeIDs)
+}
+
+// FindStatusHistory mocks base method.
+func (m *MockEmployeeRepository) FindStatusHistory(ctx context.Context, id uint) ([]models.EmployeeStatusTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStatusHistory", ctx, id)
+	ret0, _ := ret[0].([]models.EmployeeStatusTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStatusHistory indicates an expected call of FindStatusHistory.
+func (mr *MockEmployeeRepositoryMockRecorder) FindStatusHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStatusHistory", reflect.TypeOf((*MockEmployeeRepository)(nil).FindStatusHistory), ctx, id)
+}
+
+// FindSubordinates mocks base method.
+func (m *MockEmployeeRepository) FindSubordinates(ctx context.Context, managerID uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubordinates", ctx, managerID)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubordinates indicates an expected call of FindSubordinates.
+func (mr *MockEmployeeRepositoryMockRecorder) FindSubordinates(ctx, managerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubordinates", reflect.TypeOf((*MockEmployeeRepository)(nil).FindSubordinates), ctx, managerID)
+}
+
+// FindTopLevel mocks base method.
+func (m *MockEmployeeRepository) FindTopLevel(ctx context.Context) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTopLevel", ctx)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTopLevel indicates an expected call of FindTopLevel.
+func (mr *MockEmployeeRepositoryMockRecorder) FindTopLevel(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTopLevel", reflect.TypeOf((*MockEmployeeRepository)(nil).FindTopLevel), ctx)
+}
+
+// List mocks base method.
+func (m *MockEmployeeRepository) List(ctx context.Context, query models.ListQuery) ([]models.Employee, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockEmployeeRepositoryMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEmployeeRepository)(nil).List), ctx, query)
+}
+
+// RemoveCustomField mocks base method.
+func (m *MockEmployeeRepository) RemoveCustomField(ctx context.Context, name string) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCustomField", ctx, name)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCustomField indicates an expected call of RemoveCustomField.
+func (mr *MockEmployeeRepositoryMockRecorder) RemoveCustomField(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCustomField", reflect.TypeOf((*MockEmployeeRepository)(nil).RemoveCustomField), ctx, name)
 }
 
 // Update mocks base method.
-func (m *MockEmployeeRepository) Update(id uint, employee models.Employee) (models.Employee, error) {
+func (m *MockEmployeeRepository) Update(ctx context.Context, id uint, entity models.Employee) (models.Employee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", id, employee)
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
 	ret0, _ := ret[0].(models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockEmployeeRepositoryMockRecorder) Update(id, employee any) *gomock.Call {
+func (mr *MockEmployeeRepositoryMockRecorder) Update(ctx, id, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEmployeeRepository)(nil).Update), ctx, id, entity)
+}
+
+// UpdateBatch mocks base method.
+func (m *MockEmployeeRepository) UpdateBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBatch", ctx, employees)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBatch indicates an expected call of UpdateBatch.
+func (mr *MockEmployeeRepositoryMockRecorder) UpdateBatch(ctx, employees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBatch", reflect.TypeOf((*MockEmployeeRepository)(nil).UpdateBatch), ctx, employees)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockEmployeeRepository) UpdateStatus(ctx context.Context, employee models.Employee, transition models.EmployeeStatusTransition) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, employee, transition)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockEmployeeRepositoryMockRecorder) UpdateStatus(ctx, employee, transition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockEmployeeRepository)(nil).UpdateStatus), ctx, employee, transition)
+}
+
+// UpsertBatch mocks base method.
+func (m *MockEmployeeRepository) UpsertBatch(ctx context.Context, employees []models.Employee) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBatch", ctx, employees)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBatch indicates an expected call of UpsertBatch.
+func (mr *MockEmployeeRepositoryMockRecorder) UpsertBatch(ctx, employees any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEmployeeRepository)(nil).Update), id, employee)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBatch", reflect.TypeOf((*MockEmployeeRepository)(nil).UpsertBatch), ctx, employees)
 }
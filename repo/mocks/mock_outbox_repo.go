@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\outbox_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\outbox_repo.go -destination=repo\mocks\mock_outbox_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteThrough mocks base method.
+func (m *MockOutboxRepository) DeleteThrough(ctx context.Context, lastEventID uint64, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteThrough", ctx, lastEventID, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteThrough indicates an expected call of DeleteThrough.
+func (mr *MockOutboxRepositoryMockRecorder) DeleteThrough(ctx, lastEventID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteThrough", reflect.TypeOf((*MockOutboxRepository)(nil).DeleteThrough), ctx, lastEventID, before)
+}
+
+// FindAfter mocks base method.
+func (m *MockOutboxRepository) FindAfter(ctx context.Context, afterID uint64, limit int) ([]models.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]models.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAfter indicates an expected call of FindAfter.
+func (mr *MockOutboxRepositoryMockRecorder) FindAfter(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAfter", reflect.TypeOf((*MockOutboxRepository)(nil).FindAfter), ctx, afterID, limit)
+}
+
+// FindCursors mocks base method.
+func (m *MockOutboxRepository) FindCursors(ctx context.Context) (map[string]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCursors", ctx)
+	ret0, _ := ret[0].(map[string]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCursors indicates an expected call of FindCursors.
+func (mr *MockOutboxRepositoryMockRecorder) FindCursors(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCursors", reflect.TypeOf((*MockOutboxRepository)(nil).FindCursors), ctx)
+}
+
+// SaveCursor mocks base method.
+func (m *MockOutboxRepository) SaveCursor(ctx context.Context, sink string, lastEventID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCursor", ctx, sink, lastEventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCursor indicates an expected call of SaveCursor.
+func (mr *MockOutboxRepositoryMockRecorder) SaveCursor(ctx, sink, lastEventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCursor", reflect.TypeOf((*MockOutboxRepository)(nil).SaveCursor), ctx, sink, lastEventID)
+}
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\position_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\position_repo.go -destination=repo\mocks\mock_position_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPositionRepository is a mock of PositionRepository interface.
+type MockPositionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPositionRepositoryMockRecorder is the mock recorder for MockPositionRepository.
+type MockPositionRepositoryMockRecorder struct {
+	mock *MockPositionRepository
+}
+
+// NewMockPositionRepository creates a new mock instance.
+func NewMockPositionRepository(ctrl *gomock.Controller) *MockPositionRepository {
+	mock := &MockPositionRepository{ctrl: ctrl}
+	mock.recorder = &MockPositionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPositionRepository) EXPECT() *MockPositionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPositionRepository) Create(ctx context.Context, position models.Position) (models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, position)
+	ret0, _ := ret[0].(models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPositionRepositoryMockRecorder) Create(ctx, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPositionRepository)(nil).Create), ctx, position)
+}
+
+// CreateSalaryOverride mocks base method.
+func (m *MockPositionRepository) CreateSalaryOverride(ctx context.Context, override models.SalaryBandOverride) (models.SalaryBandOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSalaryOverride", ctx, override)
+	ret0, _ := ret[0].(models.SalaryBandOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSalaryOverride indicates an expected call of CreateSalaryOverride.
+func (mr *MockPositionRepositoryMockRecorder) CreateSalaryOverride(ctx, override any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSalaryOverride", reflect.TypeOf((*MockPositionRepository)(nil).CreateSalaryOverride), ctx, override)
+}
+
+// Delete mocks base method.
+func (m *MockPositionRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPositionRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPositionRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockPositionRepository) FindAll(ctx context.Context) ([]models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPositionRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPositionRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockPositionRepository) FindByID(ctx context.Context, id uint) (models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPositionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPositionRepository)(nil).FindByID), ctx, id)
+}
+
+// FindSalaryOverrides mocks base method.
+func (m *MockPositionRepository) FindSalaryOverrides(ctx context.Context, employeeID uint) ([]models.SalaryBandOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSalaryOverrides", ctx, employeeID)
+	ret0, _ := ret[0].([]models.SalaryBandOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSalaryOverrides indicates an expected call of FindSalaryOverrides.
+func (mr *MockPositionRepositoryMockRecorder) FindSalaryOverrides(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSalaryOverrides", reflect.TypeOf((*MockPositionRepository)(nil).FindSalaryOverrides), ctx, employeeID)
+}
+
+// Update mocks base method.
+func (m *MockPositionRepository) Update(ctx context.Context, id uint, position models.Position) (models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, position)
+	ret0, _ := ret[0].(models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPositionRepositoryMockRecorder) Update(ctx, id, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPositionRepository)(nil).Update), ctx, id, position)
+}
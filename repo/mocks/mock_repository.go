@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\repository.go -destination=repo\mocks\mock_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository[T any, ID comparable] struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder[T, ID]
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder[T any, ID comparable] struct {
+	mock *MockRepository[T, ID]
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository[T any, ID comparable](ctrl *gomock.Controller) *MockRepository[T, ID] {
+	mock := &MockRepository[T, ID]{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder[T, ID]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository[T, ID]) EXPECT() *MockRepositoryMockRecorder[T, ID] {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository[T, ID]) Create(ctx context.Context, entity T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder[T, ID]) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository[T, ID])(nil).Create), ctx, entity)
+}
+
+// Delete mocks base method.
+func (m *MockRepository[T, ID]) Delete(ctx context.Context, id ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder[T, ID]) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository[T, ID])(nil).Delete), ctx, id)
+}
+
+// FindByID mocks base method.
+func (m *MockRepository[T, ID]) FindByID(ctx context.Context, id ID) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRepositoryMockRecorder[T, ID]) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRepository[T, ID])(nil).FindByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository[T, ID]) List(ctx context.Context, query models.ListQuery) ([]T, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder[T, ID]) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository[T, ID])(nil).List), ctx, query)
+}
+
+// Update mocks base method.
+func (m *MockRepository[T, ID]) Update(ctx context.Context, id ID, entity T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder[T, ID]) Update(ctx, id, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository[T, ID])(nil).Update), ctx, id, entity)
+}
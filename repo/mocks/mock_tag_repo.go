@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\tag_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\tag_repo.go -destination=repo\mocks\mock_tag_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddToEmployee mocks base method.
+func (m *MockTagRepository) AddToEmployee(ctx context.Context, employeeID uint, names []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToEmployee", ctx, employeeID, names)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddToEmployee indicates an expected call of AddToEmployee.
+func (mr *MockTagRepositoryMockRecorder) AddToEmployee(ctx, employeeID, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToEmployee", reflect.TypeOf((*MockTagRepository)(nil).AddToEmployee), ctx, employeeID, names)
+}
+
+// FindAll mocks base method.
+func (m *MockTagRepository) FindAll(ctx context.Context) ([]models.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]models.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTagRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTagRepository)(nil).FindAll), ctx)
+}
+
+// FindByEmployeeID mocks base method.
+func (m *MockTagRepository) FindByEmployeeID(ctx context.Context, employeeID uint) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmployeeID", ctx, employeeID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmployeeID indicates an expected call of FindByEmployeeID.
+func (mr *MockTagRepositoryMockRecorder) FindByEmployeeID(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmployeeID", reflect.TypeOf((*MockTagRepository)(nil).FindByEmployeeID), ctx, employeeID)
+}
+
+// RemoveFromEmployee mocks base method.
+func (m *MockTagRepository) RemoveFromEmployee(ctx context.Context, employeeID uint, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromEmployee", ctx, employeeID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromEmployee indicates an expected call of RemoveFromEmployee.
+func (mr *MockTagRepositoryMockRecorder) RemoveFromEmployee(ctx, employeeID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromEmployee", reflect.TypeOf((*MockTagRepository)(nil).RemoveFromEmployee), ctx, employeeID, name)
+}
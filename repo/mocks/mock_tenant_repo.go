@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\tenant_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\tenant_repo.go -destination=repo\mocks\mock_tenant_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantRepository is a mock of TenantRepository interface.
+type MockTenantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantRepositoryMockRecorder is the mock recorder for MockTenantRepository.
+type MockTenantRepositoryMockRecorder struct {
+	mock *MockTenantRepository
+}
+
+// NewMockTenantRepository creates a new mock instance.
+func NewMockTenantRepository(ctrl *gomock.Controller) *MockTenantRepository {
+	mock := &MockTenantRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantRepository) EXPECT() *MockTenantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTenantRepository) Create(ctx context.Context, tenant models.Tenant) (models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tenant)
+	ret0, _ := ret[0].(models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTenantRepositoryMockRecorder) Create(ctx, tenant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTenantRepository)(nil).Create), ctx, tenant)
+}
+
+// Delete mocks base method.
+func (m *MockTenantRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTenantRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTenantRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockTenantRepository) FindAll(ctx context.Context) ([]models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTenantRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTenantRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockTenantRepository) FindByID(ctx context.Context, id string) (models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTenantRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTenantRepository)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockTenantRepository) Update(ctx context.Context, id string, tenant models.Tenant) (models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, tenant)
+	ret0, _ := ret[0].(models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTenantRepositoryMockRecorder) Update(ctx, id, tenant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTenantRepository)(nil).Update), ctx, id, tenant)
+}
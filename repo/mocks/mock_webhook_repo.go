@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo\webhook_repo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repo\webhook_repo.go -destination=repo\mocks\mock_webhook_repo.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookRepository) Create(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, webhook)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookRepositoryMockRecorder) Create(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookRepository)(nil).Create), ctx, webhook)
+}
+
+// CreateDeliveries mocks base method.
+func (m *MockWebhookRepository) CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeliveries indicates an expected call of CreateDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) CreateDeliveries(ctx, deliveries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDeliveries), ctx, deliveries)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockWebhookRepository) FindAll(ctx context.Context) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockWebhookRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookRepository)(nil).FindAll), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockWebhookRepository) FindByID(ctx context.Context, id uint) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWebhookRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookRepository)(nil).FindByID), ctx, id)
+}
+
+// FindDeadDeliveries mocks base method.
+func (m *MockWebhookRepository) FindDeadDeliveries(ctx context.Context, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeadDeliveries", ctx, p)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindDeadDeliveries indicates an expected call of FindDeadDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) FindDeadDeliveries(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeadDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).FindDeadDeliveries), ctx, p)
+}
+
+// FindDeliveries mocks base method.
+func (m *MockWebhookRepository) FindDeliveries(ctx context.Context, webhookID uint, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveries", ctx, webhookID, p)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindDeliveries indicates an expected call of FindDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) FindDeliveries(ctx, webhookID, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).FindDeliveries), ctx, webhookID, p)
+}
+
+// FindDeliveryByID mocks base method.
+func (m *MockWebhookRepository) FindDeliveryByID(ctx context.Context, id uint) (models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveryByID", ctx, id)
+	ret0, _ := ret[0].(models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeliveryByID indicates an expected call of FindDeliveryByID.
+func (mr *MockWebhookRepositoryMockRecorder) FindDeliveryByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveryByID", reflect.TypeOf((*MockWebhookRepository)(nil).FindDeliveryByID), ctx, id)
+}
+
+// FindDueDeliveries mocks base method.
+func (m *MockWebhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueDeliveries", ctx, now, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueDeliveries indicates an expected call of FindDueDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) FindDueDeliveries(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).FindDueDeliveries), ctx, now, limit)
+}
+
+// Update mocks base method.
+func (m *MockWebhookRepository) Update(ctx context.Context, id uint, webhook models.Webhook) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, webhook)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookRepositoryMockRecorder) Update(ctx, id, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookRepository)(nil).Update), ctx, id, webhook)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockWebhookRepository) UpdateDelivery(ctx context.Context, delivery models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateDelivery), ctx, delivery)
+}
@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+type OutboxRepository interface {
+	FindAfter(ctx context.Context, afterID uint64, limit int) ([]models.OutboxEvent, error)
+	FindCursors(ctx context.Context) (map[string]uint64, error)
+	SaveCursor(ctx context.Context, sink string, lastEventID uint64) error
+	DeleteThrough(ctx context.Context, lastEventID uint64, before time.Time) (int64, error)
+}
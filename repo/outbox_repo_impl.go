@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxRepositoryImpl struct{}
+
+func NewOutboxRepository() OutboxRepository {
+	return &outboxRepositoryImpl{}
+}
+
+// FindAfter returns stored events after the given ID, oldest first. IDs are
+// assigned in commit order because SQLite serialises writers.
+func (r *outboxRepositoryImpl) FindAfter(ctx context.Context, afterID uint64, limit int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	result := conn(ctx).Where("id > ?", afterID).Order("id").Limit(limit).Find(&events)
+	return events, result.Error
+}
+
+// FindCursors returns the last accepted event ID of every sink that has accepted one
+func (r *outboxRepositoryImpl) FindCursors(ctx context.Context) (map[string]uint64, error) {
+	var cursors []models.OutboxCursor
+	if err := conn(ctx).Find(&cursors).Error; err != nil {
+		return nil, err
+	}
+	bySink := make(map[string]uint64, len(cursors))
+	for _, cursor := range cursors {
+		bySink[cursor.Sink] = cursor.LastEventID
+	}
+	return bySink, nil
+}
+
+func (r *outboxRepositoryImpl) SaveCursor(ctx context.Context, sink string, lastEventID uint64) error {
+	cursor := models.OutboxCursor{Sink: sink, LastEventID: lastEventID}
+	return conn(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "sink"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_event_id", "updated_at"}),
+	}).Create(&cursor).Error
+}
+
+// DeleteThrough removes events up to the given ID that occurred before the
+// cutoff, returning how many were removed
+func (r *outboxRepositoryImpl) DeleteThrough(ctx context.Context, lastEventID uint64, before time.Time) (int64, error) {
+	result := conn(ctx).Where("id <= ? AND occurred_at < ?", lastEventID, before).Delete(&models.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// recordEvents stores employee events in the outbox inside tx, so they are
+// committed or rolled back together with the change they describe
+func recordEvents(tx *gorm.DB, events ...models.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	for i := range events {
+		events[i].EmployeeID = events[i].Employee.ID
+		events[i].OccurredAt = now
+	}
+	return tx.Create(&events).Error
+}
+
+func employeeCreated(employee models.Employee) models.OutboxEvent {
+	return models.OutboxEvent{Type: models.EventEmployeeCreated, Employee: employee}
+}
+
+func employeeUpdated(previous, employee models.Employee) models.OutboxEvent {
+	return models.OutboxEvent{Type: models.EventEmployeeUpdated, Employee: employee, Previous: &previous}
+}
+
+func employeeDeleted(employee models.Employee) models.OutboxEvent {
+	return models.OutboxEvent{Type: models.EventEmployeeDeleted, Employee: employee}
+}
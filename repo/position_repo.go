@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+type PositionRepository interface {
+	FindAll(ctx context.Context) ([]models.Position, error)
+	FindByID(ctx context.Context, id uint) (models.Position, error)
+	Create(ctx context.Context, position models.Position) (models.Position, error)
+	Update(ctx context.Context, id uint, position models.Position) (models.Position, error)
+	Delete(ctx context.Context, id uint) error
+	CreateSalaryOverride(ctx context.Context, override models.SalaryBandOverride) (models.SalaryBandOverride, error)
+	FindSalaryOverrides(ctx context.Context, employeeID uint) ([]models.SalaryBandOverride, error)
+}
@@ -0,0 +1,97 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+)
+
+type positionRepositoryImpl struct{}
+
+func NewPositionRepository() PositionRepository {
+	return &positionRepositoryImpl{}
+}
+
+func (r *positionRepositoryImpl) FindAll(ctx context.Context) ([]models.Position, error) {
+	var positions []models.Position
+	result := conn(ctx).Preload("SalaryBands").Order("family, title, level").Find(&positions)
+	return positions, result.Error
+}
+
+func (r *positionRepositoryImpl) FindByID(ctx context.Context, id uint) (models.Position, error) {
+	var position models.Position
+	result := conn(ctx).Preload("SalaryBands").First(&position, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return position, models.ErrPositionNotFound
+		}
+		return position, result.Error
+	}
+	return position, nil
+}
+
+func (r *positionRepositoryImpl) Create(ctx context.Context, position models.Position) (models.Position, error) {
+	result := conn(ctx).Create(&position)
+	return position, translatePositionError(result.Error, position)
+}
+
+// Update replaces the position's details and its full set of salary bands
+func (r *positionRepositoryImpl) Update(ctx context.Context, id uint, position models.Position) (models.Position, error) {
+	existingPosition, err := r.FindByID(ctx, id)
+	if err != nil {
+		return existingPosition, err
+	}
+
+	existingPosition.Title = position.Title
+	existingPosition.Level = position.Level
+	existingPosition.Family = position.Family
+	existingPosition.StrictBand = position.StrictBand
+	existingPosition.SalaryBands = position.SalaryBands
+
+	err = conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("position_id = ?", id).Delete(&models.SalaryBand{}).Error; err != nil {
+			return err
+		}
+		return tx.Save(&existingPosition).Error
+	})
+	return existingPosition, translatePositionError(err, existingPosition)
+}
+
+func (r *positionRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	position, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var holders int64
+	if err := conn(ctx).Model(&models.Employee{}).Where("position_id = ?", id).Count(&holders).Error; err != nil {
+		return err
+	}
+	if holders > 0 {
+		return fmt.Errorf("%w: position %d is still held by %d employees", models.ErrConflict, id, holders)
+	}
+
+	return conn(ctx).Select("SalaryBands").Delete(&position).Error
+}
+
+func (r *positionRepositoryImpl) CreateSalaryOverride(ctx context.Context, override models.SalaryBandOverride) (models.SalaryBandOverride, error) {
+	result := conn(ctx).Create(&override)
+	return override, result.Error
+}
+
+func (r *positionRepositoryImpl) FindSalaryOverrides(ctx context.Context, employeeID uint) ([]models.SalaryBandOverride, error) {
+	var overrides []models.SalaryBandOverride
+	result := conn(ctx).Where("employee_id = ?", employeeID).Order("id").Find(&overrides)
+	return overrides, result.Error
+}
+
+// translatePositionError reports a duplicate title/level or currency band as a conflict
+func translatePositionError(err error, position models.Position) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("%w: position %q at level %q already exists or repeats a currency band", models.ErrConflict, position.Title, position.Level)
+	}
+	return err
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// Repository is the data access every resource has: a filtered page of
+// records, and reading, creating, updating and deleting one by its ID
+type Repository[T any, ID comparable] interface {
+	List(ctx context.Context, query models.ListQuery) ([]T, int64, error)
+	FindByID(ctx context.Context, id ID) (T, error)
+	Create(ctx context.Context, entity T) (T, error)
+	Update(ctx context.Context, id ID, entity T) (T, error)
+	Delete(ctx context.Context, id ID) error
+}
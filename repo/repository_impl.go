@@ -0,0 +1,168 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Resource configures the generic repository of a GORM model
+type Resource[T any, ID comparable] struct {
+	// NotFound is returned for IDs without a record
+	NotFound error
+	// Filters are the list filters the resource accepts; others are ignored
+	Filters []models.FilterField
+	// Merge copies the fields an update may change onto the stored record.
+	// Without it an update replaces every column but the primary key, the
+	// creation time and the tenant.
+	Merge func(stored *T, update T)
+	// AfterCreate and AfterUpdate run inside the transaction of the write,
+	// so what they store is only kept with it
+	AfterCreate func(tx *gorm.DB, created T) error
+	AfterUpdate func(tx *gorm.DB, previous, updated T) error
+	// Delete replaces the default delete inside its transaction, for
+	// resources that change related records when one is deleted
+	Delete func(tx *gorm.DB, id ID) error
+}
+
+// gormRepository implements Repository for any GORM model
+type gormRepository[T any, ID comparable] struct {
+	resource Resource[T, ID]
+}
+
+// NewRepository creates the generic repository of a GORM model
+func NewRepository[T any, ID comparable](resource Resource[T, ID]) Repository[T, ID] {
+	return &gormRepository[T, ID]{resource: resource}
+}
+
+// primaryKey is the primary key column of the statement's model
+var primaryKey = clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey}
+
+// List returns one page of the records matching the query filters, in primary key order, and the total match count
+func (r *gormRepository[T, ID]) List(ctx context.Context, query models.ListQuery) ([]T, int64, error) {
+	var entities []T
+	var total int64
+	if err := conn(ctx).Model(new(T)).Scopes(r.filter(query.Filters)).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	result := conn(ctx).Scopes(r.filter(query.Filters), paginate(query.Pagination)).
+		Order(clause.OrderByColumn{Column: primaryKey}).Find(&entities)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return entities, total, nil
+}
+
+func (r *gormRepository[T, ID]) FindByID(ctx context.Context, id ID) (T, error) {
+	return r.find(conn(ctx), id)
+}
+
+func (r *gormRepository[T, ID]) Create(ctx context.Context, entity T) (T, error) {
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&entity).Error; err != nil {
+			return err
+		}
+		if r.resource.AfterCreate == nil {
+			return nil
+		}
+		return r.resource.AfterCreate(tx, entity)
+	})
+	return entity, err
+}
+
+// Update reads and saves the record in one transaction, so a concurrent
+// update cannot slip in between
+func (r *gormRepository[T, ID]) Update(ctx context.Context, id ID, entity T) (T, error) {
+	var updated T
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		stored, err := r.find(tx, id)
+		if err != nil {
+			return err
+		}
+		previous := stored
+		if r.resource.Merge != nil {
+			r.resource.Merge(&stored, entity)
+			if err := tx.Save(&stored).Error; err != nil {
+				return err
+			}
+		} else {
+			fixed, err := fixedColumns(tx, &stored)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&stored).Select("*").Omit(fixed...).Updates(&entity).Error; err != nil {
+				return err
+			}
+			if stored, err = r.find(tx, id); err != nil {
+				return err
+			}
+		}
+		updated = stored
+		if r.resource.AfterUpdate == nil {
+			return nil
+		}
+		return r.resource.AfterUpdate(tx, previous, stored)
+	})
+	return updated, err
+}
+
+func (r *gormRepository[T, ID]) Delete(ctx context.Context, id ID) error {
+	return conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if r.resource.Delete != nil {
+			return r.resource.Delete(tx, id)
+		}
+		entity, err := r.find(tx, id)
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&entity).Error
+	})
+}
+
+// find reads the record with the ID inside tx
+func (r *gormRepository[T, ID]) find(tx *gorm.DB, id ID) (T, error) {
+	var entity T
+	err := tx.Where(clause.Eq{Column: primaryKey, Value: id}).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) && r.resource.NotFound != nil {
+		return entity, r.resource.NotFound
+	}
+	return entity, err
+}
+
+// filter narrows a list to the rows matching the resource filters given
+func (r *gormRepository[T, ID]) filter(values map[string]string) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		for _, field := range r.resource.Filters {
+			value, ok := values[field.Name]
+			if !ok {
+				continue
+			}
+			column := clause.Column{Table: clause.CurrentTable, Name: field.ColumnName()}
+			if field.Partial {
+				tx = tx.Where(clause.Like{Column: column, Value: "%" + value + "%"})
+			} else {
+				tx = tx.Where(clause.Eq{Column: column, Value: value})
+			}
+		}
+		return tx
+	}
+}
+
+// fixedColumns returns the columns of the model an update without Merge
+// keeps: its primary key, creation time and tenant
+func fixedColumns(tx *gorm.DB, model interface{}) ([]string, error) {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(model); err != nil {
+		return nil, err
+	}
+	fixed := append([]string{}, stmt.Schema.PrimaryFieldDBNames...)
+	for _, name := range []string{"CreatedAt", "TenantID"} {
+		if field := stmt.Schema.LookUpField(name); field != nil {
+			fixed = append(fixed, field.DBName)
+		}
+	}
+	return fixed, nil
+}
@@ -0,0 +1,164 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type gadget struct {
+	ID        uint
+	TenantID  string
+	Name      string
+	Color     string
+	CreatedAt time.Time
+}
+
+var errGadgetNotFound = errors.New("gadget not found")
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	previous *gorm.DB
+	ctx      context.Context
+	resource Resource[gadget, uint]
+}
+
+func (suite *RepositoryTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+	// Every connection to :memory: opens its own empty database
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	suite.Require().NoError(database.AutoMigrate(&gadget{}))
+	suite.Require().NoError(database.Use(tenant.NewPlugin(&gadget{})))
+	suite.previous, db.DB = db.DB, database
+
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+	suite.resource = Resource[gadget, uint]{
+		NotFound: errGadgetNotFound,
+		Filters: []models.FilterField{
+			{Name: "name", Partial: true},
+			{Name: "colour", Column: "color"},
+		},
+	}
+	for _, g := range []gadget{{Name: "red widget", Color: "red"}, {Name: "blue widget", Color: "blue"}, {Name: "red sprocket", Color: "red"}} {
+		suite.Require().NoError(db.DB.WithContext(suite.ctx).Create(&g).Error)
+	}
+}
+
+func (suite *RepositoryTestSuite) TearDownTest() {
+	db.DB = suite.previous
+}
+
+func TestRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RepositoryTestSuite))
+}
+
+func (suite *RepositoryTestSuite) TestListFiltersAndPages() {
+	repository := NewRepository(suite.resource)
+
+	gadgets, total, err := repository.List(suite.ctx, models.ListQuery{
+		Pagination: models.Pagination{Page: 1, PageSize: 1},
+		Filters:    map[string]string{"name": "widget", "colour": "red", "unknown": "x"},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(1), total)
+	suite.Require().Len(gadgets, 1)
+	suite.Equal("red widget", gadgets[0].Name)
+
+	gadgets, total, err = repository.List(suite.ctx, models.ListQuery{
+		Pagination: models.Pagination{Page: 2, PageSize: 2},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(3), total)
+	suite.Require().Len(gadgets, 1)
+	suite.Equal(uint(3), gadgets[0].ID)
+}
+
+func (suite *RepositoryTestSuite) TestFindByID() {
+	repository := NewRepository(suite.resource)
+
+	found, err := repository.FindByID(suite.ctx, 2)
+	suite.NoError(err)
+	suite.Equal("blue widget", found.Name)
+
+	_, err = repository.FindByID(suite.ctx, 9)
+	suite.ErrorIs(err, errGadgetNotFound)
+	// Records of other tenants are not found either
+	_, err = repository.FindByID(tenant.NewContext(context.Background(), "globex"), 2)
+	suite.ErrorIs(err, errGadgetNotFound)
+}
+
+func (suite *RepositoryTestSuite) TestUpdateReplacesAllButFixedColumns() {
+	repository := NewRepository(suite.resource)
+	stored, err := repository.FindByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	updated, err := repository.Update(suite.ctx, 1, gadget{ID: 7, Name: "green widget"})
+	suite.NoError(err)
+	suite.Equal(uint(1), updated.ID)
+	suite.Equal("green widget", updated.Name)
+	suite.Empty(updated.Color)
+	suite.Equal("acme", updated.TenantID)
+	suite.True(stored.CreatedAt.Equal(updated.CreatedAt))
+
+	_, err = repository.Update(suite.ctx, 9, gadget{Name: "ghost"})
+	suite.ErrorIs(err, errGadgetNotFound)
+}
+
+func (suite *RepositoryTestSuite) TestUpdateWithMerge() {
+	suite.resource.Merge = func(stored *gadget, update gadget) {
+		stored.Name = update.Name
+	}
+	var previous, saved gadget
+	suite.resource.AfterUpdate = func(_ *gorm.DB, p, u gadget) error {
+		previous, saved = p, u
+		return nil
+	}
+	repository := NewRepository(suite.resource)
+
+	updated, err := repository.Update(suite.ctx, 1, gadget{Name: "green widget"})
+	suite.NoError(err)
+	suite.Equal("red", updated.Color)
+	suite.Equal("red widget", previous.Name)
+	suite.Equal(updated, saved)
+}
+
+func (suite *RepositoryTestSuite) TestHooksFailingRollBackTheWrite() {
+	suite.resource.AfterCreate = func(*gorm.DB, gadget) error {
+		return errors.New("outbox is full")
+	}
+	repository := NewRepository(suite.resource)
+
+	_, err := repository.Create(suite.ctx, gadget{Name: "lost"})
+	suite.Error(err)
+	_, total, err := repository.List(suite.ctx, models.ListQuery{Pagination: models.Pagination{Page: 1, PageSize: 10}})
+	suite.NoError(err)
+	suite.Equal(int64(3), total)
+}
+
+func (suite *RepositoryTestSuite) TestDelete() {
+	repository := NewRepository(suite.resource)
+
+	suite.NoError(repository.Delete(suite.ctx, 2))
+	_, err := repository.FindByID(suite.ctx, 2)
+	suite.ErrorIs(err, errGadgetNotFound)
+	suite.ErrorIs(repository.Delete(suite.ctx, 2), errGadgetNotFound)
+
+	var deleted []uint
+	suite.resource.Delete = func(tx *gorm.DB, id uint) error {
+		deleted = append(deleted, id)
+		return tx.Delete(&gadget{}, id).Error
+	}
+	suite.NoError(NewRepository(suite.resource).Delete(suite.ctx, 3))
+	suite.Equal([]uint{3}, deleted)
+}
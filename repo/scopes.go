@@ -0,0 +1,97 @@
+package repo
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"gorm.io/gorm"
+)
+
+// paginate limits a query to the requested page
+func paginate(p models.Pagination) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Offset(p.Offset()).Limit(p.PageSize)
+	}
+}
+
+// filterEmployees applies the list filters shared by every employee listing
+func filterEmployees(filter models.EmployeeFilter) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if filter.Name != "" {
+			tx = tx.Where("employees.name LIKE ?", "%"+filter.Name+"%")
+		}
+		if filter.Email != "" {
+			tx = tx.Where("employees.email = ?", filter.Email)
+		}
+		if filter.Position != "" {
+			tx = tx.Where("employees.position = ?", filter.Position)
+		}
+		if filter.DepartmentID != nil {
+			tx = tx.Where("employees.department_id = ?", *filter.DepartmentID)
+		}
+		if filter.Status != "" {
+			tx = tx.Where("employees.status = ?", filter.Status)
+		}
+		if filter.ManagerID != nil {
+			tx = tx.Where("employees.manager_id = ?", *filter.ManagerID)
+		}
+		for _, name := range slices.Sorted(maps.Keys(filter.CustomFields)) {
+			tx = tx.Where("json_extract(employees.custom_fields, ?) = ?", customFieldPath(name), filter.CustomFields[name])
+		}
+		if tags := filter.Tags.All; len(tags) > 0 {
+			// Tag names are distinct, so carrying as many of them as asked for means carrying all
+			tx = tx.Where("employees.id IN (?)", taggedEmployees(tx, tags).Group("employee_tags.employee_id").Having("COUNT(*) = ?", len(tags)))
+		}
+		if tags := filter.Tags.Any; len(tags) > 0 {
+			tx = tx.Where("employees.id IN (?)", taggedEmployees(tx, tags))
+		}
+		if tags := filter.Tags.None; len(tags) > 0 {
+			tx = tx.Where("employees.id NOT IN (?)", taggedEmployees(tx, tags))
+		}
+		return tx
+	}
+}
+
+// selectView loads only the columns an employee view needs
+func selectView(view models.EmployeeView) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		columns := view.Columns()
+		if columns == nil {
+			return tx
+		}
+		qualified := make([]string, len(columns))
+		for i, column := range columns {
+			qualified[i] = "employees." + column
+		}
+		return tx.Select(qualified)
+	}
+}
+
+// conn returns the database for statements made on behalf of ctx, which
+// carries the tenant the statements are scoped to and, within a unit of
+// work, the transaction they join
+func conn(ctx context.Context) *gorm.DB {
+	if uow, ok := ctx.Value(txKey{}).(*unitOfWorkTx); ok {
+		return uow.db.WithContext(ctx)
+	}
+	return db.DB.WithContext(ctx)
+}
+
+// rawTenant returns the tenant raw SQL has to filter on itself, since GORM
+// cannot add the condition to it
+func rawTenant(ctx context.Context) (string, error) {
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return "", tenant.ErrNoTenant
+	}
+	return id, nil
+}
+
+// customFieldPath is the JSON path of a custom field value in the custom_fields column
+func customFieldPath(name string) string {
+	return `$."` + name + `"`
+}
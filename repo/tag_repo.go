@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// TagRepository stores the tags of each tenant and the employees carrying them
+type TagRepository interface {
+	FindAll(ctx context.Context) ([]models.TagCount, error)
+	FindByEmployeeID(ctx context.Context, employeeID uint) ([]string, error)
+	AddToEmployee(ctx context.Context, employeeID uint, names []string) error
+	RemoveFromEmployee(ctx context.Context, employeeID uint, name string) error
+}
@@ -0,0 +1,103 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tagRepositoryImpl struct{}
+
+// NewTagRepository creates a new instance of TagRepository
+func NewTagRepository() TagRepository {
+	return &tagRepositoryImpl{}
+}
+
+// FindAll returns the tags employees carry with their employee counts, by
+// name. Tags no employee carries any more are left out.
+func (r *tagRepositoryImpl) FindAll(ctx context.Context) ([]models.TagCount, error) {
+	counts := []models.TagCount{}
+	result := conn(ctx).Model(&models.EmployeeTag{}).
+		Select("tags.name AS name, COUNT(*) AS count").
+		Joins("JOIN tags ON tags.id = employee_tags.tag_id").
+		Group("tags.name").Order("tags.name").
+		Find(&counts)
+	return counts, result.Error
+}
+
+// FindByEmployeeID returns the tags of the employee, by name
+func (r *tagRepositoryImpl) FindByEmployeeID(ctx context.Context, employeeID uint) ([]string, error) {
+	tags, err := findEmployeeTags(ctx, []uint{employeeID})
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{}, tags[employeeID]...), nil
+}
+
+// AddToEmployee gives the employee the tags, creating those the tenant does
+// not have yet. Tags the employee already carries are skipped.
+func (r *tagRepositoryImpl) AddToEmployee(ctx context.Context, employeeID uint, names []string) error {
+	return conn(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, name := range names {
+			tag := models.Tag{Name: name}
+			if err := tx.Where("name = ?", name).FirstOrCreate(&tag).Error; err != nil {
+				return err
+			}
+			link := models.EmployeeTag{EmployeeID: employeeID, TagID: tag.ID}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&link).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveFromEmployee takes the tag away from the employee
+func (r *tagRepositoryImpl) RemoveFromEmployee(ctx context.Context, employeeID uint, name string) error {
+	tagIDs := conn(ctx).Model(&models.Tag{}).Select("id").Where("name = ?", name)
+	result := conn(ctx).Where("employee_id = ? AND tag_id IN (?)", employeeID, tagIDs).Delete(&models.EmployeeTag{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: employee %d has no tag %q", models.ErrTagNotFound, employeeID, name)
+	}
+	return nil
+}
+
+// findEmployeeTags returns the tag names of the employees by employee ID,
+// each list ordered by name
+func findEmployeeTags(ctx context.Context, employeeIDs []uint) (map[uint][]string, error) {
+	tags := make(map[uint][]string)
+	if len(employeeIDs) == 0 {
+		return tags, nil
+	}
+	var rows []struct {
+		EmployeeID uint
+		Name       string
+	}
+	result := conn(ctx).Model(&models.EmployeeTag{}).
+		Select("employee_tags.employee_id, tags.name").
+		Joins("JOIN tags ON tags.id = employee_tags.tag_id").
+		Where("employee_tags.employee_id IN ?", employeeIDs).
+		Order("tags.name").
+		Find(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	for _, row := range rows {
+		tags[row.EmployeeID] = append(tags[row.EmployeeID], row.Name)
+	}
+	return tags, nil
+}
+
+// taggedEmployees selects the IDs of the employees carrying any of the tags
+func taggedEmployees(tx *gorm.DB, names []string) *gorm.DB {
+	return tx.Session(&gorm.Session{NewDB: true}).Model(&models.EmployeeTag{}).
+		Select("employee_tags.employee_id").
+		Joins("JOIN tags ON tags.id = employee_tags.tag_id").
+		Where("tags.name IN ?", names)
+}
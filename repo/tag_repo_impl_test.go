@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TagRepositoryTestSuite struct {
+	suite.Suite
+	previous *gorm.DB
+	ctx      context.Context
+	tags     TagRepository
+}
+
+func (suite *TagRepositoryTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{TranslateError: true})
+	suite.Require().NoError(err)
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	scoped := []interface{}{&models.Employee{}, &models.Tag{}, &models.EmployeeTag{}, &models.SalaryChange{}, &models.OutboxEvent{}}
+	suite.Require().NoError(database.AutoMigrate(scoped...))
+	suite.Require().NoError(database.Use(tenant.NewPlugin(scoped...)))
+	suite.previous, db.DB = db.DB, database
+
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+	suite.tags = NewTagRepository()
+	// Ann is on call and remote, Bob is on call and Cid has no tags
+	for _, employee := range []models.Employee{{Name: "Ann"}, {Name: "Bob"}, {Name: "Cid"}} {
+		suite.Require().NoError(db.DB.WithContext(suite.ctx).Create(&employee).Error)
+	}
+	suite.Require().NoError(suite.tags.AddToEmployee(suite.ctx, 1, []string{"oncall", "remote"}))
+	suite.Require().NoError(suite.tags.AddToEmployee(suite.ctx, 2, []string{"oncall"}))
+}
+
+func (suite *TagRepositoryTestSuite) TearDownTest() {
+	db.DB = suite.previous
+}
+
+func TestTagRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TagRepositoryTestSuite))
+}
+
+func (suite *TagRepositoryTestSuite) TestAddAndRemove() {
+	// Adding a tag twice keeps one
+	suite.NoError(suite.tags.AddToEmployee(suite.ctx, 2, []string{"oncall", "mentor"}))
+	tags, err := suite.tags.FindByEmployeeID(suite.ctx, 2)
+	suite.NoError(err)
+	suite.Equal([]string{"mentor", "oncall"}, tags)
+
+	suite.NoError(suite.tags.RemoveFromEmployee(suite.ctx, 2, "oncall"))
+	suite.ErrorIs(suite.tags.RemoveFromEmployee(suite.ctx, 2, "oncall"), models.ErrTagNotFound)
+	suite.ErrorIs(suite.tags.RemoveFromEmployee(suite.ctx, 2, "unknown"), models.ErrTagNotFound)
+
+	tags, err = suite.tags.FindByEmployeeID(suite.ctx, 3)
+	suite.NoError(err)
+	suite.Empty(tags)
+}
+
+func (suite *TagRepositoryTestSuite) TestFindAllCountsEmployees() {
+	counts, err := suite.tags.FindAll(suite.ctx)
+	suite.NoError(err)
+	suite.Equal([]models.TagCount{{Name: "oncall", Count: 2}, {Name: "remote", Count: 1}}, counts)
+
+	// Tags no employee carries are left out
+	suite.NoError(suite.tags.RemoveFromEmployee(suite.ctx, 1, "remote"))
+	counts, err = suite.tags.FindAll(suite.ctx)
+	suite.NoError(err)
+	suite.Equal([]models.TagCount{{Name: "oncall", Count: 2}}, counts)
+
+	// Other tenants have their own tags
+	globex := tenant.NewContext(context.Background(), "globex")
+	counts, err = suite.tags.FindAll(globex)
+	suite.NoError(err)
+	suite.Empty(counts)
+}
+
+func (suite *TagRepositoryTestSuite) TestEmployeesFilterOnTags() {
+	employees := NewEmployeeRepository()
+	names := func(query models.TagQuery) []string {
+		found, total, err := employees.FindAll(suite.ctx, models.EmployeeFilter{
+			Pagination: models.Pagination{Page: 1, PageSize: 10},
+			Tags:       query,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(int64(len(found)), total)
+		var names []string
+		for _, employee := range found {
+			names = append(names, employee.Name)
+		}
+		return names
+	}
+
+	suite.Equal([]string{"Ann", "Bob"}, names(models.TagQuery{All: []string{"oncall"}}))
+	suite.Equal([]string{"Ann"}, names(models.TagQuery{All: []string{"oncall", "remote"}}))
+	suite.Equal([]string{"Ann", "Bob"}, names(models.TagQuery{Any: []string{"remote", "oncall"}}))
+	suite.Equal([]string{"Bob", "Cid"}, names(models.TagQuery{None: []string{"remote"}}))
+	suite.Equal([]string{"Bob"}, names(models.TagQuery{All: []string{"oncall"}, None: []string{"remote"}}))
+	suite.Empty(names(models.TagQuery{All: []string{"oncall", "mentor"}}))
+}
+
+func (suite *TagRepositoryTestSuite) TestExpandAndDeleteEmployees() {
+	employees := NewEmployeeRepository()
+	view := models.EmployeeView{Fields: []string{"name"}, Expand: []string{models.ExpandTags}}
+	found, _, err := employees.FindAll(suite.ctx, models.EmployeeFilter{Pagination: models.Pagination{Page: 1, PageSize: 10}, View: view})
+	suite.NoError(err)
+	suite.Require().Len(found, 3)
+	suite.Equal([]string{"oncall", "remote"}, found[0].Tags)
+	suite.Equal([]string{}, found[2].Tags)
+
+	// Deleting an employee removes their tags
+	suite.NoError(employees.Delete(suite.ctx, 1))
+	counts, err := suite.tags.FindAll(suite.ctx)
+	suite.NoError(err)
+	suite.Equal([]models.TagCount{{Name: "oncall", Count: 1}}, counts)
+}
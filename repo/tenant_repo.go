@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+type TenantRepository interface {
+	FindAll(ctx context.Context) ([]models.Tenant, error)
+	FindByID(ctx context.Context, id string) (models.Tenant, error)
+	Create(ctx context.Context, tenant models.Tenant) (models.Tenant, error)
+	Update(ctx context.Context, id string, tenant models.Tenant) (models.Tenant, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"gorm.io/gorm"
+)
+
+// tenantRepositoryImpl stores the tenants themselves, which are not scoped
+// to a tenant
+type tenantRepositoryImpl struct{}
+
+func NewTenantRepository() TenantRepository {
+	return &tenantRepositoryImpl{}
+}
+
+func (r *tenantRepositoryImpl) FindAll(ctx context.Context) ([]models.Tenant, error) {
+	var tenants []models.Tenant
+	result := conn(ctx).Order("id").Find(&tenants)
+	return tenants, result.Error
+}
+
+func (r *tenantRepositoryImpl) FindByID(ctx context.Context, id string) (models.Tenant, error) {
+	var t models.Tenant
+	result := conn(ctx).Where("id = ?", id).First(&t)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return t, fmt.Errorf("%w: %q", models.ErrTenantNotFound, id)
+	}
+	return t, result.Error
+}
+
+func (r *tenantRepositoryImpl) Create(ctx context.Context, t models.Tenant) (models.Tenant, error) {
+	result := conn(ctx).Create(&t)
+	if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+		return t, fmt.Errorf("%w: tenant %q already exists", models.ErrConflict, t.ID)
+	}
+	return t, result.Error
+}
+
+func (r *tenantRepositoryImpl) Update(ctx context.Context, id string, t models.Tenant) (models.Tenant, error) {
+	existing, err := r.FindByID(ctx, id)
+	if err != nil {
+		return existing, err
+	}
+	existing.Name = t.Name
+	existing.Currency = t.Currency
+	existing.StrictSalaryBands = t.StrictSalaryBands
+	result := conn(ctx).Save(&existing)
+	return existing, result.Error
+}
+
+// Delete removes a tenant that no longer has employees
+func (r *tenantRepositoryImpl) Delete(ctx context.Context, id string) error {
+	t, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var members int64
+	if err := conn(tenant.NewContext(ctx, id)).Model(&models.Employee{}).Count(&members).Error; err != nil {
+		return err
+	}
+	if members > 0 {
+		return fmt.Errorf("%w: tenant %q still has %d employees", models.ErrConflict, id, members)
+	}
+
+	return conn(ctx).Delete(&t).Error
+}
@@ -0,0 +1,13 @@
+package repo
+
+import "context"
+
+// UnitOfWork runs several repository calls as one database transaction.
+// Every repository call made with the context passed to fn joins it.
+type UnitOfWork interface {
+	// Do commits the transaction when fn returns nil and rolls it back when
+	// fn returns an error or panics. Called within another unit of work, it
+	// runs fn in a savepoint, so only fn's own changes are rolled back, and
+	// the outer unit of work decides whether they are committed.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
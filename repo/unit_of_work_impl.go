@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txKey is the context key of the transaction of a unit of work
+type txKey struct{}
+
+// unitOfWorkTx is the transaction a unit of work passes on in its context
+type unitOfWorkTx struct {
+	db *gorm.DB
+	// afterCommit is shared with nested units of work and run by the
+	// outermost one once it has committed
+	afterCommit *[]func()
+}
+
+// unitOfWorkImpl implements UnitOfWork with GORM transactions
+type unitOfWorkImpl struct{}
+
+// NewUnitOfWork creates a new instance of UnitOfWork
+func NewUnitOfWork() UnitOfWork {
+	return &unitOfWorkImpl{}
+}
+
+// Do runs fn in a transaction, or in a savepoint of the transaction ctx
+// already carries. GORM rolls back on errors and panics, re-raising the panic.
+// The functions registered with AfterCommit within fn run once the outermost
+// transaction has committed, and are dropped when fn's changes are rolled back.
+func (u *unitOfWorkImpl) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	outer, nested := ctx.Value(txKey{}).(*unitOfWorkTx)
+	afterCommit := new([]func())
+	if nested {
+		afterCommit = outer.afterCommit
+	}
+	registered := len(*afterCommit)
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, &unitOfWorkTx{db: tx, afterCommit: afterCommit}))
+	})
+	if err != nil {
+		*afterCommit = (*afterCommit)[:registered]
+		return err
+	}
+	if !nested {
+		for _, callback := range *afterCommit {
+			callback()
+		}
+	}
+	return nil
+}
+
+// InTransaction reports whether ctx carries the transaction of a unit of work
+func InTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*unitOfWorkTx)
+	return ok
+}
+
+// AfterCommit runs fn once the unit of work ctx belongs to has committed, or
+// right away when ctx is outside any unit of work. fn never runs when the
+// changes made with ctx are rolled back. It is passed ctx without the
+// transaction, which is over by then.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	uow, ok := ctx.Value(txKey{}).(*unitOfWorkTx)
+	if !ok {
+		fn(ctx)
+		return
+	}
+	*uow.afterCommit = append(*uow.afterCommit, func() {
+		fn(context.WithValue(ctx, txKey{}, nil))
+	})
+}
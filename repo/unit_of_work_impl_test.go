@@ -0,0 +1,160 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type UnitOfWorkTestSuite struct {
+	suite.Suite
+	previous   *gorm.DB
+	ctx        context.Context
+	gadgets    Repository[gadget, uint]
+	unitOfWork UnitOfWork
+}
+
+func (suite *UnitOfWorkTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+	// A single connection also makes any statement that does not join the
+	// transaction block instead of passing unnoticed
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	suite.Require().NoError(database.AutoMigrate(&gadget{}))
+	suite.Require().NoError(database.Use(tenant.NewPlugin(&gadget{})))
+	suite.previous, db.DB = db.DB, database
+
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+	suite.gadgets = NewRepository(Resource[gadget, uint]{NotFound: errGadgetNotFound})
+	suite.unitOfWork = NewUnitOfWork()
+}
+
+func (suite *UnitOfWorkTestSuite) TearDownTest() {
+	db.DB = suite.previous
+}
+
+func TestUnitOfWorkTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitOfWorkTestSuite))
+}
+
+// names returns the names of the stored gadgets
+func (suite *UnitOfWorkTestSuite) names() []string {
+	var names []string
+	suite.Require().NoError(db.DB.WithContext(suite.ctx).Model(&gadget{}).Order("id").Pluck("name", &names).Error)
+	return names
+}
+
+func (suite *UnitOfWorkTestSuite) TestCommitsRepositoryCalls() {
+	err := suite.unitOfWork.Do(suite.ctx, func(ctx context.Context) error {
+		suite.True(InTransaction(ctx))
+		created, err := suite.gadgets.Create(ctx, gadget{Name: "widget"})
+		if err != nil {
+			return err
+		}
+		_, err = suite.gadgets.Update(ctx, created.ID, gadget{Name: "sprocket"})
+		return err
+	})
+	suite.NoError(err)
+	suite.False(InTransaction(suite.ctx))
+	suite.Equal([]string{"sprocket"}, suite.names())
+}
+
+func (suite *UnitOfWorkTestSuite) TestRollsBackOnError() {
+	failure := errors.New("second step failed")
+	err := suite.unitOfWork.Do(suite.ctx, func(ctx context.Context) error {
+		if _, err := suite.gadgets.Create(ctx, gadget{Name: "widget"}); err != nil {
+			return err
+		}
+		return failure
+	})
+	suite.ErrorIs(err, failure)
+	suite.Empty(suite.names())
+}
+
+func (suite *UnitOfWorkTestSuite) TestRollsBackOnPanic() {
+	suite.PanicsWithValue("boom", func() {
+		_ = suite.unitOfWork.Do(suite.ctx, func(ctx context.Context) error {
+			_, _ = suite.gadgets.Create(ctx, gadget{Name: "widget"})
+			panic("boom")
+		})
+	})
+	suite.Empty(suite.names())
+}
+
+func (suite *UnitOfWorkTestSuite) TestNestedUnitsUseSavepoints() {
+	err := suite.unitOfWork.Do(suite.ctx, func(ctx context.Context) error {
+		if _, err := suite.gadgets.Create(ctx, gadget{Name: "kept"}); err != nil {
+			return err
+		}
+		nested := suite.unitOfWork.Do(ctx, func(ctx context.Context) error {
+			if _, err := suite.gadgets.Create(ctx, gadget{Name: "discarded"}); err != nil {
+				return err
+			}
+			return errors.New("nested step failed")
+		})
+		suite.Error(nested)
+		_, err := suite.gadgets.Create(ctx, gadget{Name: "also kept"})
+		return err
+	})
+	suite.NoError(err)
+	suite.Equal([]string{"kept", "also kept"}, suite.names())
+}
+
+func (suite *UnitOfWorkTestSuite) TestKeepsTheTenantOfEachCall() {
+	err := suite.unitOfWork.Do(suite.ctx, func(ctx context.Context) error {
+		_, err := suite.gadgets.Create(tenant.NewContext(ctx, "globex"), gadget{Name: "foreign"})
+		return err
+	})
+	suite.NoError(err)
+	suite.Empty(suite.names())
+
+	var stored gadget
+	suite.Require().NoError(db.DB.WithContext(tenant.NewContext(context.Background(), "globex")).First(&stored).Error)
+	suite.Equal("globex", stored.TenantID)
+}
+
+func (suite *UnitOfWorkTestSuite) TestAfterCommitRunsOnceTheOutermostUnitCommits() {
+	var ran []string
+	AfterCommit(suite.ctx, func(context.Context) { ran = append(ran, "outside") })
+	suite.Equal([]string{"outside"}, ran)
+
+	err := suite.unitOfWork.Do(suite.ctx, func(ctx context.Context) error {
+		AfterCommit(ctx, func(ctx context.Context) {
+			ran = append(ran, "outer")
+			// The transaction is over, so callbacks use the database directly
+			suite.False(InTransaction(ctx))
+			suite.Equal([]string{"widget"}, suite.names())
+		})
+		if _, err := suite.gadgets.Create(ctx, gadget{Name: "widget"}); err != nil {
+			return err
+		}
+		suite.NoError(suite.unitOfWork.Do(ctx, func(ctx context.Context) error {
+			AfterCommit(ctx, func(context.Context) { ran = append(ran, "savepoint") })
+			return nil
+		}))
+		suite.Error(suite.unitOfWork.Do(ctx, func(ctx context.Context) error {
+			AfterCommit(ctx, func(context.Context) { ran = append(ran, "rolled back savepoint") })
+			return errors.New("nested step failed")
+		}))
+		// A committed savepoint is not committed yet
+		suite.Equal([]string{"outside"}, ran)
+		return nil
+	})
+	suite.NoError(err)
+	suite.Equal([]string{"outside", "outer", "savepoint"}, ran)
+
+	err = suite.unitOfWork.Do(suite.ctx, func(ctx context.Context) error {
+		AfterCommit(ctx, func(context.Context) { ran = append(ran, "rolled back") })
+		return errors.New("failed")
+	})
+	suite.Error(err)
+	suite.Equal([]string{"outside", "outer", "savepoint"}, ran)
+}
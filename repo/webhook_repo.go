@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+type WebhookRepository interface {
+	FindAll(ctx context.Context) ([]models.Webhook, error)
+	FindByID(ctx context.Context, id uint) (models.Webhook, error)
+	Create(ctx context.Context, webhook models.Webhook) (models.Webhook, error)
+	Update(ctx context.Context, id uint, webhook models.Webhook) (models.Webhook, error)
+	Delete(ctx context.Context, id uint) error
+	CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error)
+	FindDeliveryByID(ctx context.Context, id uint) (models.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery models.WebhookDelivery) error
+	FindDeliveries(ctx context.Context, webhookID uint, p models.Pagination) ([]models.WebhookDelivery, int64, error)
+	FindDeadDeliveries(ctx context.Context, p models.Pagination) ([]models.WebhookDelivery, int64, error)
+}
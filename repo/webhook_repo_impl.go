@@ -0,0 +1,131 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type webhookRepositoryImpl struct{}
+
+func NewWebhookRepository() WebhookRepository {
+	return &webhookRepositoryImpl{}
+}
+
+func (r *webhookRepositoryImpl) FindAll(ctx context.Context) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	result := conn(ctx).Order("id").Find(&webhooks)
+	return webhooks, result.Error
+}
+
+func (r *webhookRepositoryImpl) FindByID(ctx context.Context, id uint) (models.Webhook, error) {
+	var webhook models.Webhook
+	result := conn(ctx).First(&webhook, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return webhook, models.ErrWebhookNotFound
+		}
+		return webhook, result.Error
+	}
+	return webhook, nil
+}
+
+func (r *webhookRepositoryImpl) Create(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	result := conn(ctx).Create(&webhook)
+	return webhook, result.Error
+}
+
+func (r *webhookRepositoryImpl) Update(ctx context.Context, id uint, webhook models.Webhook) (models.Webhook, error) {
+	existingWebhook, err := r.FindByID(ctx, id)
+	if err != nil {
+		return existingWebhook, err
+	}
+
+	existingWebhook.URL = webhook.URL
+	existingWebhook.EventTypes = webhook.EventTypes
+	existingWebhook.Secret = webhook.Secret
+	existingWebhook.Disabled = webhook.Disabled
+
+	result := conn(ctx).Save(&existingWebhook)
+	return existingWebhook, result.Error
+}
+
+// Delete removes the webhook together with its delivery log and queued deliveries
+func (r *webhookRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	webhook, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", id).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&webhook).Error
+	})
+}
+
+// CreateDeliveries queues the deliveries, skipping any already queued for the
+// same webhook, event and type
+func (r *webhookRepositoryImpl) CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return conn(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+// FindDueDeliveries returns pending deliveries of enabled webhooks whose next
+// attempt is due, oldest first. Deliveries of disabled webhooks stay pending
+// without holding up the batch, and are due again once the webhook is enabled.
+func (r *webhookRepositoryImpl) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	enabled := conn(ctx).Model(&models.Webhook{}).Select("id").Where("disabled = ?", false)
+	result := conn(ctx).Where("status = ? AND next_attempt_at <= ?", models.DeliveryPending, now).
+		Where("webhook_id IN (?)", enabled).
+		Order("next_attempt_at, id").Limit(limit).Find(&deliveries)
+	return deliveries, result.Error
+}
+
+func (r *webhookRepositoryImpl) FindDeliveryByID(ctx context.Context, id uint) (models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	result := conn(ctx).First(&delivery, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return delivery, models.ErrDeliveryNotFound
+		}
+		return delivery, result.Error
+	}
+	return delivery, nil
+}
+
+func (r *webhookRepositoryImpl) UpdateDelivery(ctx context.Context, delivery models.WebhookDelivery) error {
+	return conn(ctx).Save(&delivery).Error
+}
+
+// FindDeliveries returns a page of the webhook's delivery log, newest first
+func (r *webhookRepositoryImpl) FindDeliveries(ctx context.Context, webhookID uint, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	return findDeliveries(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("webhook_id = ?", webhookID)
+	}, p)
+}
+
+// FindDeadDeliveries returns a page of the dead-letter list, newest first
+func (r *webhookRepositoryImpl) FindDeadDeliveries(ctx context.Context, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	return findDeliveries(ctx, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("status = ?", models.DeliveryDead)
+	}, p)
+}
+
+// findDeliveries counts the deliveries the scope selects and loads one page of them
+func findDeliveries(ctx context.Context, scope func(*gorm.DB) *gorm.DB, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	var deliveries []models.WebhookDelivery
+	var total int64
+	if err := conn(ctx).Model(&models.WebhookDelivery{}).Scopes(scope).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	result := conn(ctx).Scopes(scope, paginate(p)).Order("id DESC").Find(&deliveries)
+	return deliveries, total, result.Error
+}
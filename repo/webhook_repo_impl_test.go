@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type WebhookRepositoryTestSuite struct {
+	suite.Suite
+	previous *gorm.DB
+	ctx      context.Context
+	webhooks WebhookRepository
+	now      time.Time
+}
+
+func (suite *WebhookRepositoryTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{TranslateError: true})
+	suite.Require().NoError(err)
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	scoped := []interface{}{&models.Webhook{}, &models.WebhookDelivery{}}
+	suite.Require().NoError(database.AutoMigrate(scoped...))
+	suite.Require().NoError(database.Use(tenant.NewPlugin(scoped...)))
+	suite.previous, db.DB = db.DB, database
+
+	suite.ctx = tenant.NewContext(context.Background(), "acme")
+	suite.webhooks = NewWebhookRepository()
+	suite.now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (suite *WebhookRepositoryTestSuite) TearDownTest() {
+	db.DB = suite.previous
+}
+
+func TestWebhookRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookRepositoryTestSuite))
+}
+
+func (suite *WebhookRepositoryTestSuite) createWebhook(disabled bool) models.Webhook {
+	webhook, err := suite.webhooks.Create(suite.ctx, models.Webhook{
+		URL:        "https://example.com/hook",
+		EventTypes: []models.EmployeeEventType{models.EventEmployeeCreated},
+	})
+	suite.Require().NoError(err)
+	if disabled {
+		webhook.Disabled = true
+		webhook, err = suite.webhooks.Update(suite.ctx, webhook.ID, webhook)
+		suite.Require().NoError(err)
+	}
+	return webhook
+}
+
+func (suite *WebhookRepositoryTestSuite) TestFindDueDeliveriesSkipsDisabledAndDeletedWebhooks() {
+	const limit = 3
+	disabled := suite.createWebhook(true)
+	deleted := suite.createWebhook(false)
+	enabled := suite.createWebhook(false)
+
+	// More than a batch of deliveries of the disabled and the deleted webhook
+	// are due before the one of the enabled webhook
+	var deliveries []models.WebhookDelivery
+	for i := 0; i < limit+1; i++ {
+		for _, webhook := range []models.Webhook{disabled, deleted} {
+			deliveries = append(deliveries, models.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				EventID:       uint64(i + 1),
+				EventType:     models.EventEmployeeCreated,
+				Status:        models.DeliveryPending,
+				NextAttemptAt: suite.now.Add(-time.Hour),
+			})
+		}
+	}
+	deliveries = append(deliveries, models.WebhookDelivery{
+		WebhookID:     enabled.ID,
+		EventID:       1,
+		EventType:     models.EventEmployeeCreated,
+		Status:        models.DeliveryPending,
+		NextAttemptAt: suite.now.Add(-time.Minute),
+	})
+	suite.Require().NoError(suite.webhooks.CreateDeliveries(suite.ctx, deliveries))
+	// Deleting the webhook directly leaves its deliveries behind
+	suite.Require().NoError(db.DB.WithContext(suite.ctx).Delete(&models.Webhook{}, deleted.ID).Error)
+
+	due, err := suite.webhooks.FindDueDeliveries(suite.ctx, suite.now, limit)
+	suite.NoError(err)
+	suite.Require().Len(due, 1)
+	suite.Equal(enabled.ID, due[0].WebhookID)
+
+	// The disabled webhook's deliveries are due again once it is enabled
+	disabled.Disabled = false
+	_, err = suite.webhooks.Update(suite.ctx, disabled.ID, disabled)
+	suite.Require().NoError(err)
+	due, err = suite.webhooks.FindDueDeliveries(suite.ctx, suite.now, limit)
+	suite.NoError(err)
+	suite.Len(due, limit)
+	for _, delivery := range due {
+		suite.Equal(disabled.ID, delivery.WebhookID)
+	}
+}
@@ -0,0 +1,346 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/controllers"
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/scim"
+	"github.com/chinmay-sawant/gin-example/service"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConformanceTestSuite struct {
+	suite.Suite
+	baseURL string
+	token   string
+	server  *httptest.Server
+	client  *http.Client
+}
+
+func (suite *ConformanceTestSuite) SetupSuite() {
+	suite.client = &http.Client{Timeout: 10 * time.Second}
+	suite.baseURL = strings.TrimSuffix(os.Getenv("SCIM_BASE_URL"), "/")
+	suite.token = os.Getenv("SCIM_TOKEN")
+	if suite.baseURL != "" {
+		return
+	}
+
+	// Run the real stack on the in-memory database, with authentication disabled
+	db.ConnectDatabase()
+	departmentRepo := repo.NewDepartmentRepository()
+	employeeService := service.NewEmployeeService(repo.NewEmployeeRepository(), departmentRepo, repo.NewPositionRepository())
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	tenantService := service.NewTenantService(repo.NewTenantRepository())
+	controllers.NewSCIMController(service.NewSCIMService(employeeService, departmentRepo)).
+		RegisterRoutes(router.Group("/scim/v2", auth.Middleware(nil), tenant.Middleware(tenantService.GetTenantByID, "")))
+	suite.server = httptest.NewServer(router)
+	suite.baseURL = suite.server.URL + "/scim/v2"
+}
+
+func (suite *ConformanceTestSuite) TearDownSuite() {
+	if suite.server != nil {
+		suite.server.Close()
+	}
+}
+
+func TestConformanceTestSuite(t *testing.T) {
+	suite.Run(t, new(ConformanceTestSuite))
+}
+
+// response is a decoded SCIM response
+type response struct {
+	status int
+	header http.Header
+	body   map[string]interface{}
+}
+
+// do sends a SCIM request and decodes the response body
+func (suite *ConformanceTestSuite) do(method, path string, body interface{}) response {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		suite.Require().NoError(err)
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, suite.baseURL+path, reader)
+	suite.Require().NoError(err)
+	req.Header.Set("Accept", scim.ContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", scim.ContentType)
+	}
+	if suite.token != "" {
+		req.Header.Set("Authorization", "Bearer "+suite.token)
+	}
+	res, err := suite.client.Do(req)
+	suite.Require().NoError(err)
+	defer res.Body.Close()
+
+	r := response{status: res.StatusCode, header: res.Header}
+	data, err := io.ReadAll(res.Body)
+	suite.Require().NoError(err)
+	if len(data) > 0 {
+		suite.Require().NoError(json.Unmarshal(data, &r.body), "body is JSON: %s", data)
+		suite.Equal(scim.ContentType, res.Header.Get("Content-Type"), "%s %s", method, path)
+	}
+	return r
+}
+
+// assertError checks that a response is a SCIM error with the given status and type
+func (suite *ConformanceTestSuite) assertError(r response, status int, scimType string) {
+	suite.Equal(status, r.status, "%v", r.body)
+	suite.Equal([]interface{}{scim.ErrorSchema}, r.body["schemas"])
+	suite.Equal(fmt.Sprint(status), r.body["status"], "status is a string")
+	if scimType != "" {
+		suite.Equal(scimType, r.body["scimType"])
+	}
+}
+
+// newUser returns a user with a userName no other test uses
+func (suite *ConformanceTestSuite) newUser(givenName string) map[string]interface{} {
+	userName := fmt.Sprintf("%s.%d@conformance.example", strings.ToLower(givenName), time.Now().UnixNano())
+	return map[string]interface{}{
+		"schemas":                 []string{scim.UserSchema, scim.EnterpriseUserSchema},
+		"userName":                userName,
+		"name":                    map[string]interface{}{"givenName": givenName, "familyName": "Tester"},
+		"emails":                  []interface{}{map[string]interface{}{"value": userName, "type": "work", "primary": true}},
+		"title":                   "Conformance Tester",
+		"active":                  true,
+		scim.EnterpriseUserSchema: map[string]interface{}{"department": "Engineering"},
+	}
+}
+
+// create provisions a user and removes it when the test ends
+func (suite *ConformanceTestSuite) create(user map[string]interface{}) map[string]interface{} {
+	r := suite.do("POST", "/Users", user)
+	suite.Require().Equal(http.StatusCreated, r.status, "%v", r.body)
+	id, _ := r.body["id"].(string)
+	suite.Require().NotEmpty(id, "created users have an id")
+	suite.T().Cleanup(func() { suite.do("DELETE", "/Users/"+id, nil) })
+	return r.body
+}
+
+func (suite *ConformanceTestSuite) patch(id string, operations ...map[string]interface{}) response {
+	return suite.do("PATCH", "/Users/"+id, map[string]interface{}{
+		"schemas":    []string{scim.PatchOpSchema},
+		"Operations": operations,
+	})
+}
+
+func (suite *ConformanceTestSuite) TestServiceProviderConfig() {
+	r := suite.do("GET", "/ServiceProviderConfig", nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	suite.Equal([]interface{}{scim.ServiceProviderConfigSchema}, r.body["schemas"])
+	for _, feature := range []string{"patch", "bulk", "filter", "changePassword", "sort", "etag"} {
+		suite.Contains(r.body[feature], "supported", feature)
+	}
+	suite.Equal(true, r.body["patch"].(map[string]interface{})["supported"])
+	suite.Equal(true, r.body["filter"].(map[string]interface{})["supported"])
+	suite.NotEmpty(r.body["authenticationSchemes"])
+}
+
+func (suite *ConformanceTestSuite) TestResourceTypesAndSchemas() {
+	r := suite.do("GET", "/ResourceTypes", nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	suite.Equal([]interface{}{scim.ListResponseSchema}, r.body["schemas"])
+	resources := r.body["Resources"].([]interface{})
+	suite.Require().Len(resources, 1)
+	user := resources[0].(map[string]interface{})
+	suite.Equal("/Users", user["endpoint"])
+	suite.Equal(scim.UserSchema, user["schema"])
+	suite.Contains(fmt.Sprint(user["schemaExtensions"]), scim.EnterpriseUserSchema)
+
+	r = suite.do("GET", "/Schemas", nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	var ids []interface{}
+	for _, schema := range r.body["Resources"].([]interface{}) {
+		ids = append(ids, schema.(map[string]interface{})["id"])
+	}
+	suite.ElementsMatch([]interface{}{scim.UserSchema, scim.EnterpriseUserSchema}, ids)
+
+	r = suite.do("GET", "/Schemas/"+scim.UserSchema, nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	suite.Contains(fmt.Sprint(r.body["attributes"]), "userName")
+	suite.assertError(suite.do("GET", "/Schemas/urn:example:unknown", nil), http.StatusNotFound, "")
+}
+
+func (suite *ConformanceTestSuite) TestCreateUser() {
+	user := suite.newUser("Create")
+	user["externalId"] = "idp-create"
+	user[scim.EnterpriseUserSchema] = map[string]interface{}{"department": "Engineering", "manager": map[string]interface{}{"value": "1"}}
+	r := suite.do("POST", "/Users", user)
+	suite.Require().Equal(http.StatusCreated, r.status, "%v", r.body)
+	suite.T().Cleanup(func() { suite.do("DELETE", fmt.Sprintf("/Users/%s", r.body["id"]), nil) })
+
+	suite.Equal(user["userName"], r.body["userName"])
+	suite.Equal(true, r.body["active"])
+	suite.Equal("Conformance Tester", r.body["title"])
+	suite.Equal("Create Tester", r.body["displayName"])
+	meta := r.body["meta"].(map[string]interface{})
+	suite.Equal("User", meta["resourceType"])
+	suite.Equal(meta["location"], r.header.Get("Location"), "Location matches meta.location")
+	suite.True(strings.HasSuffix(meta["location"].(string), "/Users/"+r.body["id"].(string)))
+	suite.NotEmpty(meta["created"])
+	enterprise := r.body[scim.EnterpriseUserSchema].(map[string]interface{})
+	suite.Equal("Engineering", enterprise["department"])
+	suite.Equal("1", enterprise["manager"].(map[string]interface{})["value"])
+}
+
+func (suite *ConformanceTestSuite) TestCreateUserErrors() {
+	created := suite.create(suite.newUser("Unique"))
+
+	duplicate := suite.newUser("Unique")
+	duplicate["userName"] = strings.ToUpper(created["userName"].(string))
+	duplicate["emails"] = nil
+	suite.assertError(suite.do("POST", "/Users", duplicate), http.StatusConflict, scim.ErrTypeUniqueness)
+
+	missingSchema := suite.newUser("Schema")
+	missingSchema["schemas"] = []string{}
+	suite.assertError(suite.do("POST", "/Users", missingSchema), http.StatusBadRequest, scim.ErrTypeInvalidValue)
+
+	unknownDepartment := suite.newUser("Department")
+	unknownDepartment[scim.EnterpriseUserSchema] = map[string]interface{}{"department": "No Such Department"}
+	suite.assertError(suite.do("POST", "/Users", unknownDepartment), http.StatusBadRequest, scim.ErrTypeInvalidValue)
+}
+
+func (suite *ConformanceTestSuite) TestGetUser() {
+	created := suite.create(suite.newUser("Get"))
+
+	r := suite.do("GET", "/Users/"+created["id"].(string), nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	suite.Equal(created, r.body)
+
+	suite.assertError(suite.do("GET", "/Users/999999", nil), http.StatusNotFound, "")
+	suite.assertError(suite.do("GET", "/Users/not-a-user", nil), http.StatusNotFound, "")
+}
+
+func (suite *ConformanceTestSuite) TestFilterUsers() {
+	created := suite.create(suite.newUser("Filter"))
+	userName := created["userName"].(string)
+
+	for _, filter := range []string{
+		fmt.Sprintf(`userName eq "%s"`, userName),
+		fmt.Sprintf(`userName eq "%s"`, strings.ToUpper(userName)),
+		fmt.Sprintf(`emails[type eq "work" and value eq "%s"]`, userName),
+		fmt.Sprintf(`userName eq "%s" and active eq true and title sw "conformance"`, userName),
+		fmt.Sprintf(`userName eq "%s" and not (urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "Design")`, userName),
+	} {
+		r := suite.do("GET", "/Users?filter="+url.QueryEscape(filter), nil)
+		suite.Require().Equal(http.StatusOK, r.status, filter)
+		suite.Equal([]interface{}{scim.ListResponseSchema}, r.body["schemas"])
+		suite.Equal(1.0, r.body["totalResults"], filter)
+		suite.Equal(created["id"], r.body["Resources"].([]interface{})[0].(map[string]interface{})["id"], filter)
+	}
+
+	r := suite.do("GET", "/Users?filter="+url.QueryEscape(`userName eq "nobody@conformance.example"`), nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	suite.Equal(0.0, r.body["totalResults"])
+	suite.Equal([]interface{}{}, r.body["Resources"], "an empty result still has a Resources array")
+
+	suite.assertError(suite.do("GET", "/Users?filter="+url.QueryEscape(`userName xx "a"`), nil), http.StatusBadRequest, scim.ErrTypeInvalidFilter)
+}
+
+func (suite *ConformanceTestSuite) TestPaging() {
+	suite.create(suite.newUser("PageOne"))
+	suite.create(suite.newUser("PageTwo"))
+	filter := url.QueryEscape(`userName ew "@conformance.example" and name.givenName sw "Page"`)
+
+	r := suite.do("GET", "/Users?count=1&startIndex=2&filter="+filter, nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	suite.Equal(2.0, r.body["totalResults"])
+	suite.Equal(2.0, r.body["startIndex"])
+	suite.Equal(1.0, r.body["itemsPerPage"])
+	suite.Len(r.body["Resources"], 1)
+
+	// A start index below 1 is 1 and a count of 0 only returns the total
+	r = suite.do("GET", "/Users?count=0&startIndex=0&filter="+filter, nil)
+	suite.Require().Equal(http.StatusOK, r.status)
+	suite.Equal(2.0, r.body["totalResults"])
+	suite.Equal(1.0, r.body["startIndex"])
+	suite.Len(r.body["Resources"], 0)
+}
+
+func (suite *ConformanceTestSuite) TestReplaceUser() {
+	created := suite.create(suite.newUser("Replace"))
+	id := created["id"].(string)
+
+	replacement := suite.newUser("Replaced")
+	replacement["title"] = "Senior Conformance Tester"
+	replacement[scim.EnterpriseUserSchema] = map[string]interface{}{"department": "Design"}
+	r := suite.do("PUT", "/Users/"+id, replacement)
+	suite.Require().Equal(http.StatusOK, r.status, "%v", r.body)
+	suite.Equal(replacement["userName"], r.body["userName"])
+	suite.Equal("Replaced Tester", r.body["displayName"])
+	suite.Equal("Senior Conformance Tester", r.body["title"])
+	suite.Equal("Design", r.body[scim.EnterpriseUserSchema].(map[string]interface{})["department"])
+
+	suite.assertError(suite.do("PUT", "/Users/999999", replacement), http.StatusNotFound, "")
+}
+
+func (suite *ConformanceTestSuite) TestPatchUser() {
+	created := suite.create(suite.newUser("Patch"))
+	id := created["id"].(string)
+	newEmail := "patched." + created["userName"].(string)
+
+	r := suite.patch(id,
+		map[string]interface{}{"op": "Replace", "path": "name.familyName", "value": "Patched"},
+		map[string]interface{}{"op": "replace", "path": `emails[type eq "work"].value`, "value": newEmail},
+		map[string]interface{}{"op": "add", "value": map[string]interface{}{"title": "Patched Title"}},
+		map[string]interface{}{"op": "replace", "path": scim.EnterpriseUserSchema + ":manager", "value": "1"},
+	)
+	suite.Require().Equal(http.StatusOK, r.status, "%v", r.body)
+	suite.Equal("Patch Patched", r.body["displayName"])
+	suite.Equal(newEmail, r.body["userName"])
+	suite.Equal("Patched Title", r.body["title"])
+	suite.Equal("1", r.body[scim.EnterpriseUserSchema].(map[string]interface{})["manager"].(map[string]interface{})["value"])
+
+	r = suite.patch(id, map[string]interface{}{"op": "remove", "path": scim.EnterpriseUserSchema + ":manager"})
+	suite.Require().Equal(http.StatusOK, r.status, "%v", r.body)
+	suite.NotContains(r.body[scim.EnterpriseUserSchema], "manager")
+
+	suite.assertError(suite.patch(id, map[string]interface{}{"op": "remove"}), http.StatusBadRequest, scim.ErrTypeNoTarget)
+	suite.assertError(suite.patch(id, map[string]interface{}{"op": "replace", "path": "emails[type", "value": "x"}), http.StatusBadRequest, scim.ErrTypeInvalidPath)
+	suite.assertError(suite.patch("999999", map[string]interface{}{"op": "replace", "path": "title", "value": "x"}), http.StatusNotFound, "")
+}
+
+func (suite *ConformanceTestSuite) TestDeactivateUser() {
+	created := suite.create(suite.newUser("Deactivate"))
+	id := created["id"].(string)
+
+	// Identity providers send booleans as strings too
+	r := suite.patch(id, map[string]interface{}{"op": "Replace", "value": map[string]interface{}{"active": "False"}})
+	suite.Require().Equal(http.StatusOK, r.status, "%v", r.body)
+	suite.Equal(false, r.body["active"])
+
+	r = suite.do("GET", "/Users?filter="+url.QueryEscape(fmt.Sprintf(`userName eq "%s" and active eq false`, created["userName"])), nil)
+	suite.Equal(1.0, r.body["totalResults"])
+
+	// Deactivation terminates the employee, which cannot be undone
+	suite.assertError(suite.patch(id, map[string]interface{}{"op": "replace", "path": "active", "value": true}),
+		http.StatusBadRequest, scim.ErrTypeMutability)
+}
+
+func (suite *ConformanceTestSuite) TestDeleteUser() {
+	created := suite.create(suite.newUser("Delete"))
+	id := created["id"].(string)
+
+	r := suite.do("DELETE", "/Users/"+id, nil)
+	suite.Equal(http.StatusNoContent, r.status)
+	suite.assertError(suite.do("GET", "/Users/"+id, nil), http.StatusNotFound, "")
+	suite.assertError(suite.do("DELETE", "/Users/"+id, nil), http.StatusNotFound, "")
+}
@@ -0,0 +1,15 @@
+// Package conformance holds a SCIM 2.0 conformance suite for the /scim/v2
+// endpoint. It exercises the protocol the way identity providers do:
+// discovery, creating, filtering, paging, replacing, patching and deleting
+// users, and the error responses for each.
+//
+// By default the suite starts the API in process on an in-memory database:
+//
+//	go test ./scim/conformance -v
+//
+// Set SCIM_BASE_URL (and SCIM_TOKEN, a bearer token with the users:provision
+// permission, when authentication is enabled) to run it against a running
+// server instead:
+//
+//	SCIM_BASE_URL=http://localhost:8080/scim/v2 go test ./scim/conformance -v
+package conformance
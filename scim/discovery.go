@@ -0,0 +1,184 @@
+package scim
+
+// The discovery documents describe what this service provider supports
+// (RFC 7643 sections 5 to 7). They take the base URL of the SCIM endpoint,
+// such as "https://hr.example.com/scim/v2", to fill in resource locations.
+
+// Supported is a feature flag in the ServiceProviderConfig
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+// FilterSupport describes filtering in the ServiceProviderConfig
+type FilterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// BulkSupport describes bulk operations in the ServiceProviderConfig
+type BulkSupport struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+// AuthenticationScheme describes how clients authenticate
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+// ServiceProviderConfig describes the SCIM features the service supports
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	DocumentationURI      string                 `json:"documentationUri,omitempty"`
+	Patch                 Supported              `json:"patch"`
+	Bulk                  BulkSupport            `json:"bulk"`
+	Filter                FilterSupport          `json:"filter"`
+	ChangePassword        Supported              `json:"changePassword"`
+	Sort                  Supported              `json:"sort"`
+	ETag                  Supported              `json:"etag"`
+	AuthenticationSchemes []AuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  Meta                   `json:"meta"`
+}
+
+// NewServiceProviderConfig returns the service provider configuration
+func NewServiceProviderConfig(baseURL string) ServiceProviderConfig {
+	return ServiceProviderConfig{
+		Schemas: []string{ServiceProviderConfigSchema},
+		Patch:   Supported{Supported: true},
+		Filter:  FilterSupport{Supported: true, MaxResults: MaxCount},
+		AuthenticationSchemes: []AuthenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "HS256 JWT bearer token whose roles grant the users:provision permission",
+			Primary:     true,
+		}},
+		Meta: Meta{ResourceType: "ServiceProviderConfig", Location: baseURL + "/ServiceProviderConfig"},
+	}
+}
+
+// SchemaExtension names an extension schema of a resource type
+type SchemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+// ResourceType describes an endpoint and the schemas of its resources
+type ResourceType struct {
+	Schemas          []string          `json:"schemas"`
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Endpoint         string            `json:"endpoint"`
+	Description      string            `json:"description"`
+	Schema           string            `json:"schema"`
+	SchemaExtensions []SchemaExtension `json:"schemaExtensions"`
+	Meta             Meta              `json:"meta"`
+}
+
+// NewResourceTypes returns the resource types the service exposes
+func NewResourceTypes(baseURL string) []ResourceType {
+	return []ResourceType{{
+		Schemas:          []string{ResourceTypeSchema},
+		ID:               "User",
+		Name:             "User",
+		Endpoint:         "/Users",
+		Description:      "Employees, provisioned as users",
+		Schema:           UserSchema,
+		SchemaExtensions: []SchemaExtension{{Schema: EnterpriseUserSchema, Required: false}},
+		Meta:             Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/User"},
+	}}
+}
+
+// Attribute describes one attribute of a schema
+type Attribute struct {
+	Name          string      `json:"name"`
+	Type          string      `json:"type"`
+	MultiValued   bool        `json:"multiValued"`
+	Description   string      `json:"description"`
+	Required      bool        `json:"required"`
+	CaseExact     bool        `json:"caseExact"`
+	Mutability    string      `json:"mutability"`
+	Returned      string      `json:"returned"`
+	Uniqueness    string      `json:"uniqueness"`
+	SubAttributes []Attribute `json:"subAttributes,omitempty"`
+}
+
+// Schema describes the attributes of a resource or extension
+type Schema struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Attributes  []Attribute `json:"attributes"`
+	Meta        Meta        `json:"meta"`
+}
+
+// attribute returns a single-valued, optional, read-write string attribute
+func attribute(name, description string) Attribute {
+	return Attribute{Name: name, Type: "string", Description: description,
+		Mutability: "readWrite", Returned: "default", Uniqueness: "none"}
+}
+
+// NewSchemas returns the schemas of the attributes the service supports.
+// Attributes of the core schema that employees have no field for are left
+// out, so clients know they are not stored.
+func NewSchemas(baseURL string) []Schema {
+	userName := attribute("userName", "The employee's email address, which identifies them to the service provider")
+	userName.Required, userName.Uniqueness = true, "server"
+
+	active := attribute("active", "Whether the employee is active. Setting it to false terminates the employee.")
+	active.Type = "boolean"
+
+	email := attribute("emails", "The employee's email address. Only one, the primary work address, is stored.")
+	email.Type, email.MultiValued = "complex", true
+	email.SubAttributes = []Attribute{
+		attribute("value", "Email address"),
+		attribute("type", "Always \"work\""),
+		{Name: "primary", Type: "boolean", Description: "Always true", Mutability: "readWrite", Returned: "default", Uniqueness: "none"},
+	}
+
+	name := attribute("name", "The components of the employee's name")
+	name.Type = "complex"
+	name.SubAttributes = []Attribute{
+		attribute("formatted", "The full name"),
+		attribute("givenName", "The part of the name before the first space"),
+		attribute("familyName", "The rest of the name"),
+	}
+
+	id := attribute("id", "The employee ID")
+	id.CaseExact, id.Mutability, id.Returned, id.Uniqueness = true, "readOnly", "always", "server"
+
+	employeeNumber := attribute("employeeNumber", "The employee ID")
+	employeeNumber.Mutability = "readOnly"
+
+	manager := attribute("manager", "The employee's manager")
+	manager.Type = "complex"
+	manager.SubAttributes = []Attribute{
+		attribute("value", "The ID of the manager"),
+		{Name: "$ref", Type: "reference", Description: "The URI of the manager", Mutability: "readOnly", Returned: "default", Uniqueness: "none"},
+		{Name: "displayName", Type: "string", Description: "The name of the manager", Mutability: "readOnly", Returned: "default", Uniqueness: "none"},
+	}
+
+	return []Schema{
+		{
+			Schemas:     []string{SchemaSchema},
+			ID:          UserSchema,
+			Name:        "User",
+			Description: "User account",
+			Attributes: []Attribute{id, userName, name, attribute("displayName", "The employee's name"),
+				attribute("title", "The employee's position"), active, email},
+			Meta: Meta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + UserSchema},
+		},
+		{
+			Schemas:     []string{SchemaSchema},
+			ID:          EnterpriseUserSchema,
+			Name:        "EnterpriseUser",
+			Description: "Enterprise user",
+			Attributes:  []Attribute{employeeNumber, attribute("department", "The name of the employee's department"), manager},
+			Meta:        Meta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + EnterpriseUserSchema},
+		},
+	}
+}
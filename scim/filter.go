@@ -0,0 +1,520 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filter is a parsed filter expression (RFC 7644 section 3.4.2.2). Filters
+// are evaluated against resources in their JSON form, so attribute names are
+// matched case-insensitively and multi-valued attributes match when any of
+// their values does.
+type Filter interface {
+	Matches(resource map[string]interface{}) bool
+}
+
+// AttrPath names an attribute, optionally qualified by its schema URN and
+// narrowed to a sub-attribute, as in "name.givenName" or
+// "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager.value"
+type AttrPath struct {
+	Schema string
+	Name   string
+	Sub    string
+}
+
+func (p AttrPath) String() string {
+	s := p.Name
+	if p.Sub != "" {
+		s += "." + p.Sub
+	}
+	if p.Schema != "" {
+		s = p.Schema + ":" + s
+	}
+	return s
+}
+
+// ParseFilter parses a filter expression such as
+// `userName eq "alice@example.com" and not (title pr)`
+func ParseFilter(expression string) (Filter, error) {
+	p, err := newParser(expression, ErrTypeInvalidFilter)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.peek().text)
+	}
+	return filter, nil
+}
+
+type andFilter struct{ left, right Filter }
+
+func (f andFilter) Matches(r map[string]interface{}) bool {
+	return f.left.Matches(r) && f.right.Matches(r)
+}
+
+type orFilter struct{ left, right Filter }
+
+func (f orFilter) Matches(r map[string]interface{}) bool {
+	return f.left.Matches(r) || f.right.Matches(r)
+}
+
+type notFilter struct{ inner Filter }
+
+func (f notFilter) Matches(r map[string]interface{}) bool { return !f.inner.Matches(r) }
+
+// presentFilter matches resources where the attribute has a non-empty value
+type presentFilter struct{ path AttrPath }
+
+func (f presentFilter) Matches(r map[string]interface{}) bool {
+	for _, value := range resolve(r, f.path) {
+		if !isEmpty(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// valuePathFilter matches resources where an element of a multi-valued
+// attribute matches the inner filter, as in `emails[type eq "work"]`
+type valuePathFilter struct {
+	path  AttrPath
+	inner Filter
+}
+
+func (f valuePathFilter) Matches(r map[string]interface{}) bool {
+	for _, element := range resolve(r, f.path) {
+		if m, ok := element.(map[string]interface{}); ok && f.inner.Matches(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareFilter compares an attribute with a literal
+type compareFilter struct {
+	path  AttrPath
+	op    string
+	value interface{}
+}
+
+func (f compareFilter) Matches(r map[string]interface{}) bool {
+	values := resolve(r, f.path)
+	if f.op == "ne" {
+		return !compareFilter{path: f.path, op: "eq", value: f.value}.Matches(r)
+	}
+	if f.value == nil && f.op == "eq" {
+		return !presentFilter{path: f.path}.Matches(r)
+	}
+	for _, value := range values {
+		if compare(value, f.op, f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+// compare applies a comparison operator. Strings compare case-insensitively,
+// since none of the exposed attributes are case exact, and strings that are
+// both timestamps compare as times.
+func compare(actual interface{}, op string, expected interface{}) bool {
+	switch want := expected.(type) {
+	case string:
+		got, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		a, b := strings.ToLower(got), strings.ToLower(want)
+		switch op {
+		case "eq":
+			return a == b
+		case "co":
+			return strings.Contains(a, b)
+		case "sw":
+			return strings.HasPrefix(a, b)
+		case "ew":
+			return strings.HasSuffix(a, b)
+		}
+		if at, err := time.Parse(time.RFC3339Nano, got); err == nil {
+			if bt, err := time.Parse(time.RFC3339Nano, want); err == nil {
+				return ordered(op, at.Compare(bt))
+			}
+		}
+		return ordered(op, strings.Compare(a, b))
+	case float64:
+		got, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		switch {
+		case got < want:
+			return ordered(op, -1)
+		case got > want:
+			return ordered(op, 1)
+		default:
+			return ordered(op, 0)
+		}
+	case bool:
+		got, ok := actual.(bool)
+		return ok && op == "eq" && got == want
+	}
+	return false
+}
+
+// ordered reports whether a three-way comparison result satisfies op
+func ordered(op string, cmp int) bool {
+	switch op {
+	case "eq":
+		return cmp == 0
+	case "gt":
+		return cmp > 0
+	case "ge":
+		return cmp >= 0
+	case "lt":
+		return cmp < 0
+	case "le":
+		return cmp <= 0
+	}
+	return false
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// resolve returns the values an attribute path selects. Multi-valued
+// attributes are flattened, so "emails.value" yields every address.
+func resolve(resource map[string]interface{}, path AttrPath) []interface{} {
+	root := resource
+	if path.Schema != "" && !strings.EqualFold(path.Schema, UserSchema) {
+		extension, ok := lookup(resource, path.Schema).(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		root = extension
+	}
+	values := flatten(lookup(root, path.Name))
+	if path.Sub == "" {
+		return values
+	}
+	var subValues []interface{}
+	for _, value := range values {
+		if m, ok := value.(map[string]interface{}); ok {
+			subValues = append(subValues, flatten(lookup(m, path.Sub))...)
+		}
+	}
+	return subValues
+}
+
+func flatten(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	}
+	return []interface{}{value}
+}
+
+// lookupKey returns the key of m that matches name case-insensitively
+func lookupKey(m map[string]interface{}, name string) (string, bool) {
+	if _, ok := m[name]; ok {
+		return name, true
+	}
+	for key := range m {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return name, false
+}
+
+func lookup(m map[string]interface{}, name string) interface{} {
+	key, ok := lookupKey(m, name)
+	if !ok {
+		return nil
+	}
+	return m[key]
+}
+
+// token kinds produced by the lexer
+const (
+	tokenWord = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+type token struct {
+	kind int
+	text string
+}
+
+// parser is a recursive descent parser over the tokens of a filter or a
+// PATCH path. errType is the SCIM error type reported for syntax errors.
+type parser struct {
+	input   string
+	tokens  []token
+	pos     int
+	errType string
+}
+
+func newParser(input, errType string) (*parser, error) {
+	p := &parser{input: input, errType: errType}
+	for i := 0; i < len(input); {
+		switch c := input[i]; c {
+		case ' ', '\t', '\n', '\r':
+			i++
+		case '(':
+			p.tokens = append(p.tokens, token{tokenOpenParen, "("})
+			i++
+		case ')':
+			p.tokens = append(p.tokens, token{tokenCloseParen, ")"})
+			i++
+		case '[':
+			p.tokens = append(p.tokens, token{tokenOpenBracket, "["})
+			i++
+		case ']':
+			p.tokens = append(p.tokens, token{tokenCloseBracket, "]"})
+			i++
+		case '"':
+			end := i + 1
+			for ; end < len(input) && input[end] != '"'; end++ {
+				if input[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(input) {
+				return nil, p.errorf("unterminated string")
+			}
+			var s string
+			if err := json.Unmarshal([]byte(input[i:end+1]), &s); err != nil {
+				return nil, p.errorf("invalid string %s", input[i:end+1])
+			}
+			p.tokens = append(p.tokens, token{tokenString, s})
+			i = end + 1
+		default:
+			end := i
+			for ; end < len(input) && !strings.ContainsRune(" \t\n\r()[]\"", rune(input[end])); end++ {
+			}
+			p.tokens = append(p.tokens, token{tokenWord, input[i:end]})
+			i = end
+		}
+	}
+	return p, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return BadRequest(p.errType, fmt.Sprintf("%q: %s", p.input, fmt.Sprintf(format, args...)))
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{kind: -1, text: "end of input"}
+	}
+	return p.tokens[p.pos]
+}
+
+// peekWord reports whether the next token is the given keyword
+func (p *parser) peekWord(word string) bool {
+	next := p.peek()
+	return next.kind == tokenWord && strings.EqualFold(next.text, word)
+}
+
+func (p *parser) expect(kind int, text string) error {
+	if p.peek().kind != kind {
+		return p.errorf("expected %q but found %q", text, p.peek().text)
+	}
+	p.pos++
+	return nil
+}
+
+// parseOr parses the lowest precedence level: FILTER ("or" FILTER)*
+func (p *parser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekWord("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekWord("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+// parseUnary parses "not" (FILTER), a parenthesized filter or an attribute expression
+func (p *parser) parseUnary() (Filter, error) {
+	negate := false
+	if p.peekWord("not") {
+		p.pos++
+		negate = true
+		if p.peek().kind != tokenOpenParen {
+			return nil, p.errorf("expected \"(\" after not")
+		}
+	}
+	if p.peek().kind == tokenOpenParen {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenCloseParen, ")"); err != nil {
+			return nil, err
+		}
+		if negate {
+			return notFilter{inner}, nil
+		}
+		return inner, nil
+	}
+	return p.parseAttrExp()
+}
+
+// parseAttrExp parses `attrPath pr`, `attrPath op value` or `attrPath[valFilter]`
+func (p *parser) parseAttrExp() (Filter, error) {
+	path, err := p.parseAttrPath()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind == tokenOpenBracket {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenCloseBracket, "]"); err != nil {
+			return nil, err
+		}
+		return valuePathFilter{path: path, inner: inner}, nil
+	}
+
+	next := p.peek()
+	if next.kind != tokenWord {
+		return nil, p.errorf("expected an operator after %s", path)
+	}
+	p.pos++
+	op := strings.ToLower(next.text)
+	switch op {
+	case "pr":
+		return presentFilter{path: path}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, p.errorf("unknown operator %q", next.text)
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "co", "sw", "ew":
+		if _, ok := value.(string); !ok {
+			return nil, p.errorf("%s needs a string value", op)
+		}
+	case "gt", "ge", "lt", "le":
+		if _, ok := value.(bool); ok || value == nil {
+			return nil, p.errorf("%s needs a string or number value", op)
+		}
+	}
+	return compareFilter{path: path, op: op, value: value}, nil
+}
+
+// parseValue parses a comparison literal: a string, a number, true, false or null
+func (p *parser) parseValue() (interface{}, error) {
+	next := p.peek()
+	p.pos++
+	switch next.kind {
+	case tokenString:
+		return next.text, nil
+	case tokenWord:
+		switch strings.ToLower(next.text) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		if n, err := strconv.ParseFloat(next.text, 64); err == nil {
+			return n, nil
+		}
+	}
+	return nil, p.errorf("invalid value %q", next.text)
+}
+
+func (p *parser) parseAttrPath() (AttrPath, error) {
+	next := p.peek()
+	if next.kind != tokenWord {
+		return AttrPath{}, p.errorf("expected an attribute name but found %q", next.text)
+	}
+	p.pos++
+	path, ok := splitAttrPath(next.text)
+	if !ok {
+		return AttrPath{}, p.errorf("invalid attribute %q", next.text)
+	}
+	return path, nil
+}
+
+// splitAttrPath splits an attribute path into its schema, name and sub-attribute
+func splitAttrPath(text string) (AttrPath, bool) {
+	var path AttrPath
+	if len(text) > 4 && strings.EqualFold(text[:4], "urn:") {
+		i := strings.LastIndex(text, ":")
+		schema := text[:i]
+		switch {
+		case strings.EqualFold(schema, UserSchema):
+			path.Schema = UserSchema
+		case strings.EqualFold(schema, EnterpriseUserSchema):
+			path.Schema = EnterpriseUserSchema
+		default:
+			return path, false
+		}
+		text = text[i+1:]
+	}
+	path.Name, path.Sub, _ = strings.Cut(text, ".")
+	if path.Name == "" || strings.Contains(path.Sub, ".") || (path.Sub == "" && strings.HasSuffix(text, ".")) {
+		return path, false
+	}
+	return path, true
+}
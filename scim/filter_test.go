@@ -0,0 +1,98 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FilterTestSuite struct {
+	suite.Suite
+	user map[string]interface{}
+}
+
+func (suite *FilterTestSuite) SetupTest() {
+	active := true
+	user, err := ToMap(User{
+		Schemas:  []string{UserSchema, EnterpriseUserSchema},
+		ID:       "7",
+		UserName: "Alice@Example.com",
+		Name:     &Name{Formatted: "Alice Smith", GivenName: "Alice", FamilyName: "Smith"},
+		Title:    "Developer",
+		Active:   &active,
+		Emails:   []Email{{Value: "alice@example.com", Type: "work", Primary: true}},
+		Enterprise: &EnterpriseUser{EmployeeNumber: "7", Department: "Engineering",
+			Manager: &Manager{Value: "3"}},
+	})
+	suite.Require().NoError(err)
+	user["meta"] = map[string]interface{}{"lastModified": "2024-05-01T12:00:00Z"}
+	suite.user = user
+}
+
+func TestFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
+
+func (suite *FilterTestSuite) matches(expression string) bool {
+	filter, err := ParseFilter(expression)
+	suite.Require().NoError(err, expression)
+	return filter.Matches(suite.user)
+}
+
+func (suite *FilterTestSuite) TestOperators() {
+	for expression, want := range map[string]bool{
+		`userName eq "alice@example.com"`:                     true,
+		`USERNAME Eq "ALICE@EXAMPLE.COM"`:                     true,
+		`userName ne "alice@example.com"`:                     false,
+		`name.familyName co "mit"`:                            true,
+		`title sw "dev"`:                                      true,
+		`title ew "per"`:                                      true,
+		`title ew "dev"`:                                      false,
+		`title pr`:                                            true,
+		`externalId pr`:                                       false,
+		`active eq true`:                                      true,
+		`active eq false`:                                     false,
+		`externalId eq null`:                                  true,
+		`meta.lastModified gt "2024-05-01T11:00:00+00:00"`:    true,
+		`meta.lastModified le "2024-05-01T10:00:00Z"`:         false,
+		`emails.value eq "alice@example.com"`:                 true,
+		`emails[type eq "work" and primary eq true]`:          true,
+		`emails[type eq "home"]`:                              false,
+		`urn:ietf:params:scim:schemas:core:2.0:User:title pr`: true,
+		`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager.value eq "3"`:  true,
+		`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "Sales"`: false,
+	} {
+		suite.Equal(want, suite.matches(expression), expression)
+	}
+}
+
+func (suite *FilterTestSuite) TestLogicalPrecedence() {
+	// and binds tighter than or, and not needs parentheses
+	suite.True(suite.matches(`title eq "x" and title eq "y" or userName pr`))
+	suite.False(suite.matches(`title eq "x" and (title eq "y" or userName pr)`))
+	suite.True(suite.matches(`not (title eq "x") and active eq true`))
+	suite.False(suite.matches(`not (userName pr or title eq "x")`))
+}
+
+func (suite *FilterTestSuite) TestInvalidFilters() {
+	for _, expression := range []string{
+		``,
+		`userName`,
+		`userName eq`,
+		`userName like "a"`,
+		`userName eq "unterminated`,
+		`(userName pr`,
+		`not userName pr`,
+		`title co 3`,
+		`active gt true`,
+		`userName pr extra`,
+		`urn:example:unknown:title pr`,
+	} {
+		_, err := ParseFilter(expression)
+		var scimErr *Error
+		if suite.ErrorAs(err, &scimErr, expression) {
+			suite.Equal(ErrTypeInvalidFilter, scimErr.ScimType)
+			suite.Equal(400, scimErr.StatusCode())
+		}
+	}
+}
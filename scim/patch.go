@@ -0,0 +1,322 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PatchRequest is a PATCH request body (RFC 7644 section 3.5.2)
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is one add, remove or replace operation. Op is matched
+// case-insensitively because some identity providers send "Replace".
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Path is a parsed PATCH path: an attribute, optionally narrowed to the
+// elements of a multi-valued attribute that match a filter and to one of
+// their sub-attributes, as in `emails[type eq "work"].value`
+type Path struct {
+	Attr   AttrPath
+	Filter Filter
+	Sub    string
+}
+
+// ParsePath parses a PATCH operation path
+func ParsePath(text string) (Path, error) {
+	p, err := newParser(text, ErrTypeInvalidPath)
+	if err != nil {
+		return Path{}, err
+	}
+	attr, err := p.parseAttrPath()
+	if err != nil {
+		return Path{}, err
+	}
+	path := Path{Attr: attr}
+	if p.peek().kind == tokenOpenBracket {
+		if attr.Sub != "" {
+			return Path{}, p.errorf("a value filter cannot follow a sub-attribute")
+		}
+		p.pos++
+		if path.Filter, err = p.parseOr(); err != nil {
+			return Path{}, err
+		}
+		if err := p.expect(tokenCloseBracket, "]"); err != nil {
+			return Path{}, err
+		}
+		if next := p.peek(); next.kind == tokenWord && strings.HasPrefix(next.text, ".") && len(next.text) > 1 {
+			path.Sub = next.text[1:]
+			p.pos++
+		}
+	}
+	if !p.done() {
+		return Path{}, p.errorf("unexpected %q", p.peek().text)
+	}
+	return path, nil
+}
+
+// Validate checks the message schema and the operations of the request
+func (r PatchRequest) Validate() error {
+	if !containsFold(r.Schemas, PatchOpSchema) {
+		return BadRequest(ErrTypeInvalidSyntax, "PATCH requests must use the "+PatchOpSchema+" schema")
+	}
+	if len(r.Operations) == 0 {
+		return BadRequest(ErrTypeInvalidSyntax, "PATCH requests need at least one operation")
+	}
+	for _, op := range r.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				if _, ok := op.Value.(map[string]interface{}); !ok {
+					return BadRequest(ErrTypeInvalidValue, op.Op+" without a path needs an object value")
+				}
+			}
+		case "remove":
+			if op.Path == "" {
+				return BadRequest(ErrTypeNoTarget, "remove needs a path")
+			}
+		default:
+			return BadRequest(ErrTypeInvalidSyntax, fmt.Sprintf("unknown operation %q", op.Op))
+		}
+	}
+	return nil
+}
+
+// Apply runs the operations in order against a resource in its JSON form.
+// The request must have been validated.
+func (r PatchRequest) Apply(resource map[string]interface{}) error {
+	for _, op := range r.Operations {
+		name := strings.ToLower(op.Op)
+		if op.Path != "" {
+			path, err := ParsePath(op.Path)
+			if err != nil {
+				return err
+			}
+			if err := applyPath(resource, name, path, op.Value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Without a path each member of the value is applied as if it were
+		// the target, and extension objects are applied member by member
+		for key, value := range op.Value.(map[string]interface{}) {
+			if extension, ok := value.(map[string]interface{}); ok && strings.EqualFold(key, EnterpriseUserSchema) {
+				for subKey, subValue := range extension {
+					path := Path{Attr: AttrPath{Schema: EnterpriseUserSchema, Name: subKey}}
+					if err := applyPath(resource, name, path, subValue); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			attr, ok := splitAttrPath(key)
+			if !ok {
+				return BadRequest(ErrTypeInvalidPath, fmt.Sprintf("invalid attribute %q", key))
+			}
+			if err := applyPath(resource, name, Path{Attr: attr}, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyPath applies one operation to the attribute a path selects
+func applyPath(resource map[string]interface{}, op string, path Path, value interface{}) error {
+	container := resource
+	if path.Attr.Schema != "" && !strings.EqualFold(path.Attr.Schema, UserSchema) {
+		key, _ := lookupKey(resource, path.Attr.Schema)
+		extension, ok := resource[key].(map[string]interface{})
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+			extension = map[string]interface{}{}
+			resource[key] = extension
+		}
+		container = extension
+	}
+	key, _ := lookupKey(container, path.Attr.Name)
+	value = coerce(path.Attr.Name, value)
+
+	if path.Filter != nil {
+		return applyFiltered(container, key, op, path, value)
+	}
+	if path.Attr.Sub != "" {
+		switch current := container[key].(type) {
+		case []interface{}:
+			// A sub-attribute of a multi-valued attribute targets every element
+			for _, element := range current {
+				if m, ok := element.(map[string]interface{}); ok {
+					setMember(m, path.Attr.Sub, op, value)
+				}
+			}
+		case map[string]interface{}:
+			setMember(current, path.Attr.Sub, op, value)
+		default:
+			if op != "remove" {
+				container[key] = map[string]interface{}{path.Attr.Sub: value}
+			}
+		}
+		return nil
+	}
+
+	if current, ok := container[key].([]interface{}); ok && op == "add" {
+		container[key] = append(current, flatten(value)...)
+		return nil
+	}
+	setMember(container, key, op, value)
+	return nil
+}
+
+// applyFiltered applies an operation to the elements of a multi-valued
+// attribute that match the path's filter
+func applyFiltered(container map[string]interface{}, key, op string, path Path, value interface{}) error {
+	elements, _ := container[key].([]interface{})
+	matched := false
+	kept := elements[:0:0]
+	for _, element := range elements {
+		m, ok := element.(map[string]interface{})
+		if !ok || !path.Filter.Matches(m) {
+			kept = append(kept, element)
+			continue
+		}
+		matched = true
+		switch {
+		case op == "remove" && path.Sub == "":
+			continue
+		case path.Sub != "":
+			setMember(m, path.Sub, op, value)
+		default:
+			replacement, ok := value.(map[string]interface{})
+			if !ok {
+				return BadRequest(ErrTypeInvalidValue, fmt.Sprintf("%s needs an object value", path.Attr))
+			}
+			if op == "replace" {
+				m = map[string]interface{}{}
+			}
+			for k, v := range replacement {
+				m[k] = v
+			}
+		}
+		kept = append(kept, m)
+	}
+	if matched || op == "remove" {
+		container[key] = kept
+		return nil
+	}
+
+	// Identity providers set `emails[type eq "work"].value` on users that
+	// have no such email yet, so a missing element is created from the
+	// filter's equality tests where that is unambiguous
+	element, ok := elementFromFilter(path.Filter)
+	if !ok {
+		return BadRequest(ErrTypeNoTarget, fmt.Sprintf("no %s element matches the filter", path.Attr))
+	}
+	if path.Sub != "" {
+		element[path.Sub] = value
+	} else if replacement, ok := value.(map[string]interface{}); ok {
+		for k, v := range replacement {
+			element[k] = v
+		}
+	}
+	container[key] = append(kept, element)
+	return nil
+}
+
+// elementFromFilter builds the element a filter of anded equality tests describes
+func elementFromFilter(filter Filter) (map[string]interface{}, bool) {
+	switch f := filter.(type) {
+	case compareFilter:
+		if f.op != "eq" || f.path.Sub != "" || f.path.Schema != "" {
+			return nil, false
+		}
+		return map[string]interface{}{f.path.Name: f.value}, true
+	case andFilter:
+		left, ok := elementFromFilter(f.left)
+		if !ok {
+			return nil, false
+		}
+		right, ok := elementFromFilter(f.right)
+		if !ok {
+			return nil, false
+		}
+		for k, v := range right {
+			left[k] = v
+		}
+		return left, true
+	}
+	return nil, false
+}
+
+// setMember sets, merges or removes one member of an object
+func setMember(m map[string]interface{}, name, op string, value interface{}) {
+	key, _ := lookupKey(m, name)
+	if op == "remove" {
+		delete(m, key)
+		return
+	}
+	current, isObject := m[key].(map[string]interface{})
+	replacement, replacingObject := value.(map[string]interface{})
+	if op == "add" && isObject && replacingObject {
+		for k, v := range replacement {
+			current[k] = v
+		}
+		return
+	}
+	m[key] = value
+}
+
+// coerce converts the string booleans some identity providers send for the
+// active attribute ("False") into booleans
+func coerce(name string, value interface{}) interface{} {
+	if s, ok := value.(string); ok && strings.EqualFold(name, "active") {
+		switch strings.ToLower(s) {
+		case "true":
+			return true
+		case "false":
+			return false
+		}
+	}
+	return value
+}
+
+func containsFold(values []string, want string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToMap returns a resource in its JSON form, for filtering and patching
+func ToMap(resource interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
+
+// FromMap decodes a resource from its JSON form
+func FromMap(m map[string]interface{}, resource interface{}) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, resource); err != nil {
+		return BadRequest(ErrTypeInvalidValue, err.Error())
+	}
+	return nil
+}
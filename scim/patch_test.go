@@ -0,0 +1,126 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PatchTestSuite struct {
+	suite.Suite
+	user map[string]interface{}
+}
+
+func (suite *PatchTestSuite) SetupTest() {
+	active := true
+	user, err := ToMap(User{
+		Schemas:    []string{UserSchema, EnterpriseUserSchema},
+		ID:         "7",
+		UserName:   "alice@example.com",
+		Name:       &Name{Formatted: "Alice Smith", GivenName: "Alice", FamilyName: "Smith"},
+		Title:      "Developer",
+		Active:     &active,
+		Emails:     []Email{{Value: "alice@example.com", Type: "work", Primary: true}},
+		Enterprise: &EnterpriseUser{EmployeeNumber: "7", Department: "Engineering", Manager: &Manager{Value: "3"}},
+	})
+	suite.Require().NoError(err)
+	suite.user = user
+}
+
+func TestPatchTestSuite(t *testing.T) {
+	suite.Run(t, new(PatchTestSuite))
+}
+
+// apply decodes and applies a PATCH body and returns the patched user
+func (suite *PatchTestSuite) apply(body string) (User, error) {
+	var request PatchRequest
+	suite.Require().NoError(json.Unmarshal([]byte(body), &request))
+	if err := request.Validate(); err != nil {
+		return User{}, err
+	}
+	if err := request.Apply(suite.user); err != nil {
+		return User{}, err
+	}
+	var user User
+	suite.Require().NoError(FromMap(suite.user, &user))
+	return user, nil
+}
+
+func (suite *PatchTestSuite) TestReplaceWithPaths() {
+	user, err := suite.apply(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[
+		{"op":"Replace","path":"name.familyName","value":"Jones"},
+		{"op":"replace","path":"emails[type eq \"work\"].value","value":"alice.jones@example.com"},
+		{"op":"replace","path":"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager","value":"4"},
+		{"op":"replace","path":"ACTIVE","value":"False"}
+	]}`)
+	suite.Require().NoError(err)
+	suite.Equal("Jones", user.Name.FamilyName)
+	suite.Equal("alice.jones@example.com", user.PrimaryEmail())
+	suite.Equal("4", user.Enterprise.Manager.Value)
+	suite.False(user.IsActive())
+}
+
+func (suite *PatchTestSuite) TestReplaceWithoutPath() {
+	user, err := suite.apply(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[
+		{"op":"replace","value":{"title":"Lead","name.givenName":"Ali",
+			"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User":{"department":"Design"}}}
+	]}`)
+	suite.Require().NoError(err)
+	suite.Equal("Lead", user.Title)
+	suite.Equal("Ali", user.Name.GivenName)
+	suite.Equal("Design", user.Enterprise.Department)
+	suite.Equal("3", user.Enterprise.Manager.Value, "members the value leaves out are kept")
+}
+
+func (suite *PatchTestSuite) TestAddAndRemove() {
+	user, err := suite.apply(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[
+		{"op":"add","path":"emails","value":[{"value":"a@home.example","type":"home"}]},
+		{"op":"remove","path":"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager"},
+		{"op":"remove","path":"title"}
+	]}`)
+	suite.Require().NoError(err)
+	suite.Len(user.Emails, 2)
+	suite.Nil(user.Enterprise.Manager)
+	suite.Empty(user.Title)
+
+	user, err = suite.apply(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[
+		{"op":"remove","path":"emails[type eq \"home\"]"}
+	]}`)
+	suite.Require().NoError(err)
+	suite.Equal([]Email{{Value: "alice@example.com", Type: "work", Primary: true}}, user.Emails)
+}
+
+func (suite *PatchTestSuite) TestFilteredPathCreatesMissingElement() {
+	delete(suite.user, "emails")
+	user, err := suite.apply(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[
+		{"op":"replace","path":"emails[type eq \"work\"].value","value":"new@example.com"}
+	]}`)
+	suite.Require().NoError(err)
+	suite.Equal([]Email{{Value: "new@example.com", Type: "work"}}, user.Emails)
+
+	_, err = suite.apply(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[
+		{"op":"replace","path":"emails[value co \"other\"].type","value":"home"}
+	]}`)
+	var scimErr *Error
+	suite.Require().ErrorAs(err, &scimErr)
+	suite.Equal(ErrTypeNoTarget, scimErr.ScimType)
+}
+
+func (suite *PatchTestSuite) TestInvalidRequests() {
+	for body, scimType := range map[string]string{
+		`{"schemas":["urn:example"],"Operations":[{"op":"remove","path":"title"}]}`:                                              ErrTypeInvalidSyntax,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[]}`:                                          ErrTypeInvalidSyntax,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"move","path":"title"}]}`:              ErrTypeInvalidSyntax,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"remove"}]}`:                           ErrTypeNoTarget,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","value":"x"}]}`:                  ErrTypeInvalidValue,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"emails[","value":"x"}]}`: ErrTypeInvalidPath,
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"name..x","value":"x"}]}`: ErrTypeInvalidPath,
+	} {
+		_, err := suite.apply(body)
+		var scimErr *Error
+		if suite.ErrorAs(err, &scimErr, body) {
+			suite.Equal(scimType, scimErr.ScimType, body)
+		}
+	}
+}
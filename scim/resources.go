@@ -0,0 +1,203 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Schema and message URNs defined by RFC 7643 and RFC 7644
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	EnterpriseUserSchema        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SchemaSchema                = "urn:ietf:params:scim:schemas:core:2.0:Schema"
+	ResourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// ContentType is the media type of every SCIM request and response
+const ContentType = "application/scim+json"
+
+// User is the SCIM User resource with the enterprise extension
+type User struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	UserName    string          `json:"userName"`
+	Name        *Name           `json:"name,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Title       string          `json:"title,omitempty"`
+	Active      *bool           `json:"active,omitempty"`
+	Emails      []Email         `json:"emails,omitempty"`
+	Enterprise  *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta        *Meta           `json:"meta,omitempty"`
+}
+
+// Name holds the components of a user's name
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// Email is one of a user's email addresses
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// EnterpriseUser holds the enterprise extension attributes
+type EnterpriseUser struct {
+	EmployeeNumber string   `json:"employeeNumber,omitempty"`
+	Department     string   `json:"department,omitempty"`
+	Manager        *Manager `json:"manager,omitempty"`
+}
+
+// Manager references the user's manager. Identity providers send it either
+// as an object or as the bare ID, so both are accepted.
+type Manager struct {
+	Value       string `json:"value,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// UnmarshalJSON accepts a manager object or a bare manager ID
+func (m *Manager) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*m = Manager{Value: id}
+		return nil
+	}
+	type manager Manager
+	return json.Unmarshal(data, (*manager)(m))
+}
+
+// Meta holds the resource metadata
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// PrimaryEmail returns the primary email address, or the first one when none is marked primary
+func (u User) PrimaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// IsActive reports the active attribute, which defaults to true
+func (u User) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// ListResponse is a page of query results. StartIndex is 1-based.
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// NewListResponse wraps a page of resources
+func NewListResponse(resources interface{}, count, total, startIndex int) ListResponse {
+	return ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: count,
+		Resources:    resources,
+	}
+}
+
+// ListQuery holds the query parameters of a list request
+type ListQuery struct {
+	Filter     string `form:"filter"`
+	StartIndex int    `form:"startIndex"`
+	Count      *int   `form:"count"`
+}
+
+const (
+	// DefaultCount is the page size of list requests without a count
+	DefaultCount = 100
+	// MaxCount is the largest page a list request returns
+	MaxCount = 200
+)
+
+// Normalize applies the RFC 7644 rules for out of range paging parameters:
+// a start index below 1 is 1 and a negative count is 0
+func (q *ListQuery) Normalize() {
+	if q.StartIndex < 1 {
+		q.StartIndex = 1
+	}
+	switch {
+	case q.Count == nil:
+		count := DefaultCount
+		q.Count = &count
+	case *q.Count < 0:
+		count := 0
+		q.Count = &count
+	case *q.Count > MaxCount:
+		count := MaxCount
+		q.Count = &count
+	}
+}
+
+// SCIM error types used in the scimType member of error responses
+const (
+	ErrTypeInvalidFilter = "invalidFilter"
+	ErrTypeInvalidPath   = "invalidPath"
+	ErrTypeInvalidSyntax = "invalidSyntax"
+	ErrTypeInvalidValue  = "invalidValue"
+	ErrTypeMutability    = "mutability"
+	ErrTypeNoTarget      = "noTarget"
+	ErrTypeUniqueness    = "uniqueness"
+)
+
+// Error is a SCIM error response. It also implements error, so parsers can
+// return it and handlers can send it as is.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// NewError returns an error response with the given HTTP status
+func NewError(status int, scimType, detail string) *Error {
+	return &Error{Schemas: []string{ErrorSchema}, Status: strconv.Itoa(status), ScimType: scimType, Detail: detail}
+}
+
+// BadRequest returns a 400 error of the given SCIM error type
+func BadRequest(scimType, detail string) *Error {
+	return NewError(http.StatusBadRequest, scimType, detail)
+}
+
+func (e *Error) Error() string {
+	if e.ScimType == "" {
+		return e.Detail
+	}
+	return e.ScimType + ": " + e.Detail
+}
+
+// StatusCode returns the HTTP status of the error
+func (e *Error) StatusCode() int {
+	status, err := strconv.Atoi(e.Status)
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+	return status
+}
@@ -0,0 +1,131 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+)
+
+// fts5Index keeps the index in an SQLite FTS5 table next to the employees.
+// FTS5 ranks with bm25 and marks matches itself; typo tolerance comes from
+// expanding each query term against the table's vocabulary first.
+type fts5Index struct {
+	db *gorm.DB
+}
+
+// NewFTS5Index creates the FTS5 tables if needed, failing when the database
+// does not support FTS5. Tables from before tenants are dropped, so the
+// index has to be rebuilt.
+func NewFTS5Index(database *gorm.DB) (EmployeeIndex, error) {
+	statements := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS employee_search USING fts5(name, email, position, tenant_id UNINDEXED, tokenize = 'unicode61 remove_diacritics 0')`,
+		`CREATE VIRTUAL TABLE IF NOT EXISTS employee_search_terms USING fts5vocab(employee_search, row)`,
+	}
+	var columns int64
+	if err := database.Raw("SELECT COUNT(*) FROM pragma_table_info('employee_search') WHERE name = 'tenant_id'").Scan(&columns).Error; err != nil {
+		return nil, err
+	}
+	if columns == 0 {
+		statements = append([]string{"DROP TABLE IF EXISTS employee_search_terms", "DROP TABLE IF EXISTS employee_search"}, statements...)
+	}
+	for _, statement := range statements {
+		if err := database.Exec(statement).Error; err != nil {
+			return nil, err
+		}
+	}
+	return &fts5Index{db: database}, nil
+}
+
+func (f *fts5Index) Index(employees ...models.Employee) error {
+	return f.db.Transaction(func(tx *gorm.DB) error {
+		for _, employee := range employees {
+			if err := tx.Exec("DELETE FROM employee_search WHERE rowid = ?", employee.ID).Error; err != nil {
+				return err
+			}
+			err := tx.Exec("INSERT INTO employee_search (rowid, name, email, position, tenant_id) VALUES (?, ?, ?, ?, ?)",
+				employee.ID, employee.Name, employee.Email, employee.Position, employee.TenantID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (f *fts5Index) Remove(ids ...uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return f.db.Exec("DELETE FROM employee_search WHERE rowid IN ?", ids).Error
+}
+
+// Search reads the whole vocabulary to expand the query terms, which is
+// cheap next to the match itself for a company-sized index. The vocabulary
+// spans every tenant, but the matches are filtered to the searching one.
+func (f *fts5Index) Search(tenantID, query string, limit int) ([]Hit, error) {
+	queryTerms := tokenize(query)
+	if len(queryTerms) == 0 {
+		return nil, nil
+	}
+	var terms []string
+	if err := f.db.Raw("SELECT term FROM employee_search_terms").Scan(&terms).Error; err != nil {
+		return nil, err
+	}
+
+	clauses := make([]string, len(queryTerms))
+	for i, queryTerm := range queryTerms {
+		expansions := expandTerm(queryTerm, terms)
+		if len(expansions) == 0 {
+			return nil, nil
+		}
+		quoted := make([]string, 0, len(expansions))
+		for term := range expansions {
+			quoted = append(quoted, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+		}
+		clauses[i] = "(" + strings.Join(quoted, " OR ") + ")"
+	}
+
+	var rows []struct {
+		ID       uint
+		Score    float64
+		Name     string
+		Email    string
+		Position string
+	}
+	mark := fmt.Sprintf("'%s', '%s'", highlightStart, highlightEnd)
+	err := f.db.Raw(fmt.Sprintf(`
+		SELECT rowid AS id, -bm25(employee_search, %g, %g, %g) AS score,
+			highlight(employee_search, 0, %s) AS name,
+			highlight(employee_search, 1, %s) AS email,
+			highlight(employee_search, 2, %s) AS position
+		FROM employee_search WHERE employee_search MATCH ? AND tenant_id = ?
+		ORDER BY score DESC, rowid LIMIT ?`, nameWeight, emailWeight, positionWeight, mark, mark, mark),
+		strings.Join(clauses, " AND "), tenantID, limit).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]Hit, len(rows))
+	for i, row := range rows {
+		hits[i] = Hit{
+			ID:    row.ID,
+			Score: row.Score,
+			Highlights: models.SearchHighlights{
+				Name:     keepMarked(row.Name),
+				Email:    keepMarked(row.Email),
+				Position: keepMarked(row.Position),
+			},
+		}
+	}
+	return hits, nil
+}
+
+// keepMarked drops highlighted fields that have no match
+func keepMarked(text string) string {
+	if !strings.Contains(text, highlightStart) {
+		return ""
+	}
+	return text
+}
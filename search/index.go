@@ -0,0 +1,36 @@
+package search
+
+import (
+	"log"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"gorm.io/gorm"
+)
+
+// Hit is one employee matching a search, best first
+type Hit struct {
+	ID         uint
+	Score      float64
+	Highlights models.SearchHighlights
+}
+
+// EmployeeIndex is a full-text index over employee names, emails and
+// positions. Searches match every query term, tolerating typos and treating
+// each term as a prefix; matched terms are marked in the highlights. Each
+// employee is indexed under their tenant and only found by its searches.
+type EmployeeIndex interface {
+	Index(employees ...models.Employee) error
+	Remove(ids ...uint) error
+	Search(tenantID, query string, limit int) ([]Hit, error)
+}
+
+// NewEmployeeIndex returns an SQLite FTS5 index on the database when FTS5 is
+// available, and an in-process index otherwise
+func NewEmployeeIndex(database *gorm.DB) EmployeeIndex {
+	index, err := NewFTS5Index(database)
+	if err != nil {
+		log.Printf("SQLite FTS5 is unavailable (%v), using the in-process search index", err)
+		return NewMemoryIndex()
+	}
+	return index
+}
@@ -0,0 +1,132 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// EmployeeIndexTestSuite runs the same searches against every index implementation
+type EmployeeIndexTestSuite struct {
+	suite.Suite
+	newIndex func() (EmployeeIndex, error)
+	index    EmployeeIndex
+}
+
+func (suite *EmployeeIndexTestSuite) SetupTest() {
+	var err error
+	suite.index, err = suite.newIndex()
+	suite.Require().NoError(err)
+	suite.NoError(suite.index.Index(
+		models.Employee{ID: 1, TenantID: "acme", Name: "Alice Johnson", Email: "alice@example.com", Position: "Software Engineer"},
+		models.Employee{ID: 2, TenantID: "acme", Name: "Bob Engel", Email: "bob@example.com", Position: "QA Analyst"},
+		models.Employee{ID: 3, TenantID: "acme", Name: "Carol White", Email: "engineering@example.com", Position: "Manager"},
+	))
+}
+
+func TestMemoryIndexTestSuite(t *testing.T) {
+	suite.Run(t, &EmployeeIndexTestSuite{newIndex: func() (EmployeeIndex, error) {
+		return NewMemoryIndex(), nil
+	}})
+}
+
+func TestFTS5IndexTestSuite(t *testing.T) {
+	suite.Run(t, &EmployeeIndexTestSuite{newIndex: func() (EmployeeIndex, error) {
+		database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+		if err != nil {
+			return nil, err
+		}
+		// Every connection to :memory: opens its own empty database
+		sqlDB, err := database.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+		return NewFTS5Index(database)
+	}})
+}
+
+func (suite *EmployeeIndexTestSuite) ids(hits []Hit) []uint {
+	ids := make([]uint, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
+
+func (suite *EmployeeIndexTestSuite) TestExactMatchHighlights() {
+	hits, err := suite.index.Search("acme", "alice", 10)
+	suite.NoError(err)
+	suite.Equal([]uint{1}, suite.ids(hits))
+	suite.Equal("<mark>Alice</mark> Johnson", hits[0].Highlights.Name)
+	suite.Equal("<mark>alice</mark>@example.com", hits[0].Highlights.Email)
+	suite.Empty(hits[0].Highlights.Position)
+}
+
+func (suite *EmployeeIndexTestSuite) TestPrefixAndFieldRanking() {
+	// "eng" prefixes Bob's name, Alice's position and Carol's email
+	hits, err := suite.index.Search("acme", "eng", 10)
+	suite.NoError(err)
+	suite.Equal([]uint{2, 1, 3}, suite.ids(hits))
+	suite.Equal("Software <mark>Engineer</mark>", hits[1].Highlights.Position)
+}
+
+func (suite *EmployeeIndexTestSuite) TestTypoTolerance() {
+	hits, err := suite.index.Search("acme", "enginer", 10)
+	suite.NoError(err)
+	suite.Equal([]uint{1}, suite.ids(hits)[:1])
+	suite.Equal("Software <mark>Engineer</mark>", hits[0].Highlights.Position)
+
+	// Short terms must match exactly or as a prefix
+	hits, err = suite.index.Search("acme", "bab", 10)
+	suite.NoError(err)
+	suite.Empty(hits)
+}
+
+func (suite *EmployeeIndexTestSuite) TestEveryTermMustMatch() {
+	hits, err := suite.index.Search("acme", "alice engineer", 10)
+	suite.NoError(err)
+	suite.Equal([]uint{1}, suite.ids(hits))
+
+	hits, err = suite.index.Search("acme", "alice manager", 10)
+	suite.NoError(err)
+	suite.Empty(hits)
+}
+
+func (suite *EmployeeIndexTestSuite) TestLimit() {
+	hits, err := suite.index.Search("acme", "example", 2)
+	suite.NoError(err)
+	suite.Len(hits, 2)
+}
+
+func (suite *EmployeeIndexTestSuite) TestReindexAndRemove() {
+	suite.NoError(suite.index.Index(models.Employee{ID: 1, TenantID: "acme", Name: "Alice Smith", Email: "alice@example.com", Position: "Director"}))
+	hits, err := suite.index.Search("acme", "johnson", 10)
+	suite.NoError(err)
+	suite.Empty(hits)
+	hits, err = suite.index.Search("acme", "smith", 10)
+	suite.NoError(err)
+	suite.Equal([]uint{1}, suite.ids(hits))
+
+	suite.NoError(suite.index.Remove(1))
+	hits, err = suite.index.Search("acme", "alice", 10)
+	suite.NoError(err)
+	suite.Empty(hits)
+}
+
+func (suite *EmployeeIndexTestSuite) TestSearchStaysInTenant() {
+	suite.NoError(suite.index.Index(models.Employee{ID: 4, TenantID: "globex", Name: "Alice Park", Email: "alice@globex.example", Position: "Engineer"}))
+
+	hits, err := suite.index.Search("acme", "alice", 10)
+	suite.NoError(err)
+	suite.Equal([]uint{1}, suite.ids(hits))
+	hits, err = suite.index.Search("globex", "alice", 10)
+	suite.NoError(err)
+	suite.Equal([]uint{4}, suite.ids(hits))
+	hits, err = suite.index.Search("initech", "alice", 10)
+	suite.NoError(err)
+	suite.Empty(hits)
+}
@@ -0,0 +1,160 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// indexedEmployee holds the searchable fields of one employee and their tenant
+type indexedEmployee struct {
+	tenantID              string
+	name, email, position string
+}
+
+// memoryIndex is an inverted index kept in process memory. It must be
+// rebuilt from the database whenever the process starts.
+type memoryIndex struct {
+	mu        sync.RWMutex
+	employees map[uint]indexedEmployee
+	// postings maps each term to the employees containing it, with the
+	// weight of the most important field it appears in
+	postings map[string]map[uint]float64
+}
+
+// NewMemoryIndex returns an empty in-process EmployeeIndex
+func NewMemoryIndex() EmployeeIndex {
+	return &memoryIndex{
+		employees: make(map[uint]indexedEmployee),
+		postings:  make(map[string]map[uint]float64),
+	}
+}
+
+func (m *memoryIndex) Index(employees ...models.Employee) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, employee := range employees {
+		m.remove(employee.ID)
+		m.employees[employee.ID] = indexedEmployee{tenantID: employee.TenantID, name: employee.Name, email: employee.Email, position: employee.Position}
+		m.addTerms(employee.ID, employee.Name, nameWeight)
+		m.addTerms(employee.ID, employee.Position, positionWeight)
+		m.addTerms(employee.ID, employee.Email, emailWeight)
+	}
+	return nil
+}
+
+func (m *memoryIndex) Remove(ids ...uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range ids {
+		m.remove(id)
+	}
+	return nil
+}
+
+func (m *memoryIndex) addTerms(id uint, text string, weight float64) {
+	for _, term := range tokenize(text) {
+		docs, ok := m.postings[term]
+		if !ok {
+			docs = make(map[uint]float64)
+			m.postings[term] = docs
+		}
+		docs[id] = max(docs[id], weight)
+	}
+}
+
+func (m *memoryIndex) remove(id uint) {
+	employee, ok := m.employees[id]
+	if !ok {
+		return
+	}
+	delete(m.employees, id)
+	for _, term := range tokenize(employee.name + " " + employee.email + " " + employee.position) {
+		if docs, ok := m.postings[term]; ok {
+			delete(docs, id)
+			if len(docs) == 0 {
+				delete(m.postings, term)
+			}
+		}
+	}
+}
+
+// Search scores each employee by the sum, over the query terms, of its best
+// matching term's quality times the weight of the field it appears in
+func (m *memoryIndex) Search(tenantID, query string, limit int) ([]Hit, error) {
+	queryTerms := tokenize(query)
+	if len(queryTerms) == 0 {
+		return nil, nil
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	terms := make([]string, 0, len(m.postings))
+	for term := range m.postings {
+		terms = append(terms, term)
+	}
+
+	var scores map[uint]float64
+	matched := make(map[uint]map[string]bool)
+	for _, queryTerm := range queryTerms {
+		termScores := make(map[uint]float64)
+		for term, quality := range expandTerm(queryTerm, terms) {
+			for id, weight := range m.postings[term] {
+				if m.employees[id].tenantID != tenantID {
+					continue
+				}
+				if scores != nil {
+					if _, ok := scores[id]; !ok {
+						continue
+					}
+				}
+				termScores[id] = max(termScores[id], quality*weight)
+				if matched[id] == nil {
+					matched[id] = make(map[string]bool)
+				}
+				matched[id][term] = true
+			}
+		}
+		// Every query term has to match, so only employees already matching
+		// the earlier terms were scored above
+		for id := range termScores {
+			termScores[id] += scores[id]
+		}
+		scores = termScores
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		employee := m.employees[id]
+		hits = append(hits, Hit{
+			ID:    id,
+			Score: score,
+			Highlights: models.SearchHighlights{
+				Name:     markedOrEmpty(employee.name, matched[id]),
+				Email:    markedOrEmpty(employee.email, matched[id]),
+				Position: markedOrEmpty(employee.position, matched[id]),
+			},
+		})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// markedOrEmpty highlights text, returning "" when nothing in it matched
+func markedOrEmpty(text string, matched map[string]bool) string {
+	marked := highlight(text, matched)
+	if !strings.Contains(marked, highlightStart) {
+		return ""
+	}
+	return marked
+}
@@ -0,0 +1,133 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Field weights used when ranking matches; a match in the name counts most
+const (
+	nameWeight     = 3.0
+	positionWeight = 2.0
+	emailWeight    = 1.0
+)
+
+// Match quality of an index term against a query term
+const (
+	exactMatch  = 1.0
+	prefixMatch = 0.75
+	fuzzyMatch  = 0.5
+)
+
+const (
+	highlightStart = "<mark>"
+	highlightEnd   = "</mark>"
+)
+
+// tokenize lower-cases text and splits it into letter and digit runs, so
+// "alice.smith@example.com" becomes alice, smith, example and com
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), isSeparator)
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// maxEdits is how many typos a query term of the given length tolerates
+func maxEdits(term string) int {
+	switch n := len([]rune(term)); {
+	case n <= 3:
+		return 0
+	case n <= 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// matchTerm scores how well an index term matches a query term, or returns
+// zero when it does not match
+func matchTerm(queryTerm, term string) float64 {
+	switch {
+	case term == queryTerm:
+		return exactMatch
+	case strings.HasPrefix(term, queryTerm):
+		return prefixMatch
+	}
+	limit := maxEdits(queryTerm)
+	if limit == 0 {
+		return 0
+	}
+	if distance := editDistance(queryTerm, term, limit); distance <= limit {
+		return fuzzyMatch / float64(distance)
+	}
+	return 0
+}
+
+// expandTerm returns the index terms that match a query term with their scores
+func expandTerm(queryTerm string, terms []string) map[string]float64 {
+	matches := make(map[string]float64)
+	for _, term := range terms {
+		if score := matchTerm(queryTerm, term); score > 0 {
+			matches[term] = score
+		}
+	}
+	return matches
+}
+
+// editDistance returns the Levenshtein distance between a and b, or limit+1
+// as soon as it is known to exceed limit
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > limit || -diff > limit {
+		return limit + 1
+	}
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		rowMin := current[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			rowMin = min(rowMin, current[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// highlight marks the words of text whose lower-cased form is in matched
+func highlight(text string, matched map[string]bool) string {
+	var b strings.Builder
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		if isSeparator(runes[i]) {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && !isSeparator(runes[j]) {
+			j++
+		}
+		word := string(runes[i:j])
+		if matched[strings.ToLower(word)] {
+			b.WriteString(highlightStart + word + highlightEnd)
+		} else {
+			b.WriteString(word)
+		}
+		i = j
+	}
+	return b.String()
+}
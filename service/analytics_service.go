@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// AnalyticsService defines the interface for workforce reports
+type AnalyticsService interface {
+	GetHeadcount(ctx context.Context, filter models.AnalyticsFilter) ([]models.HeadcountGroup, error)
+	GetSalaryStats(ctx context.Context, filter models.AnalyticsFilter) ([]models.SalaryStats, error)
+	GetMovements(ctx context.Context, filter models.MovementFilter) ([]models.MonthlyMovement, error)
+	GetTenureDistribution(ctx context.Context, filter models.AnalyticsFilter) ([]models.TenureBucket, error)
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// AnalyticsServiceImpl implements the AnalyticsService interface
+type AnalyticsServiceImpl struct {
+	analyticsRepo repo.AnalyticsRepository
+}
+
+// NewAnalyticsService creates a new instance of AnalyticsService
+func NewAnalyticsService(analyticsRepo repo.AnalyticsRepository) AnalyticsService {
+	return &AnalyticsServiceImpl{analyticsRepo: analyticsRepo}
+}
+
+// GetHeadcount counts employees per group, largest group first
+func (s *AnalyticsServiceImpl) GetHeadcount(ctx context.Context, filter models.AnalyticsFilter) ([]models.HeadcountGroup, error) {
+	filter.Normalize()
+	return s.analyticsRepo.CountHeadcount(ctx, filter)
+}
+
+// GetSalaryStats summarises salaries per group and currency
+func (s *AnalyticsServiceImpl) GetSalaryStats(ctx context.Context, filter models.AnalyticsFilter) ([]models.SalaryStats, error) {
+	filter.Normalize()
+	return s.analyticsRepo.SalaryStats(ctx, filter)
+}
+
+// GetMovements returns the hires and terminations of every month in the
+// range, including months without any
+func (s *AnalyticsServiceImpl) GetMovements(ctx context.Context, filter models.MovementFilter) ([]models.MonthlyMovement, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	movements, err := s.analyticsRepo.CountMovements(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := filter.From, filter.To
+	if len(movements) > 0 {
+		if from == "" {
+			from = movements[0].Month
+		}
+		if to == "" {
+			to = movements[len(movements)-1].Month
+		}
+	}
+	if from == "" || to == "" {
+		return movements, nil
+	}
+	return fillMonths(movements, from, to), nil
+}
+
+// fillMonths returns one movement per month from from to to, taking the
+// counts from movements and zero for months it does not contain
+func fillMonths(movements []models.MonthlyMovement, from, to string) []models.MonthlyMovement {
+	byMonth := make(map[string]models.MonthlyMovement, len(movements))
+	for _, movement := range movements {
+		byMonth[movement.Month] = movement
+	}
+	start, _ := time.Parse(models.MonthFormat, from)
+	end, _ := time.Parse(models.MonthFormat, to)
+
+	var filled []models.MonthlyMovement
+	for month := start; !month.After(end); month = month.AddDate(0, 1, 0) {
+		key := month.Format(models.MonthFormat)
+		movement, ok := byMonth[key]
+		if !ok {
+			movement = models.MonthlyMovement{Month: key}
+		}
+		filled = append(filled, movement)
+	}
+	return filled
+}
+
+// GetTenureDistribution counts employees per tenure bucket as of today
+func (s *AnalyticsServiceImpl) GetTenureDistribution(ctx context.Context, filter models.AnalyticsFilter) ([]models.TenureBucket, error) {
+	return s.analyticsRepo.CountTenure(ctx, filter, time.Now())
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type AnalyticsServiceTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	repo *mocks.MockAnalyticsRepository
+	svc  AnalyticsService
+}
+
+func (suite *AnalyticsServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mocks.NewMockAnalyticsRepository(suite.ctrl)
+	suite.svc = NewAnalyticsService(suite.repo)
+}
+
+func (suite *AnalyticsServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestAnalyticsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsServiceTestSuite))
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetHeadcountDefaultsToDepartment() {
+	groups := []models.HeadcountGroup{{Group: "Engineering", Count: 3}}
+	suite.repo.EXPECT().CountHeadcount(gomock.Any(), models.AnalyticsFilter{GroupBy: models.GroupByDepartment}).Return(groups, nil)
+
+	result, err := suite.svc.GetHeadcount(testCtx, models.AnalyticsFilter{})
+	suite.NoError(err)
+	suite.Equal(groups, result)
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetSalaryStats() {
+	filter := models.AnalyticsFilter{GroupBy: models.GroupByPosition, IncludeTerminated: true}
+	stats := []models.SalaryStats{{Group: "Developer", Currency: "USD", Count: 1, Min: 1, Max: 1, Mean: 1, Median: 1}}
+	suite.repo.EXPECT().SalaryStats(gomock.Any(), filter).Return(stats, nil)
+
+	result, err := suite.svc.GetSalaryStats(testCtx, filter)
+	suite.NoError(err)
+	suite.Equal(stats, result)
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetMovementsFillsMissingMonths() {
+	suite.repo.EXPECT().CountMovements(gomock.Any(), models.MovementFilter{}).Return([]models.MonthlyMovement{
+		{Month: "2023-11", Hires: 2},
+		{Month: "2024-02", Hires: 1, Terminations: 1},
+	}, nil)
+
+	result, err := suite.svc.GetMovements(testCtx, models.MovementFilter{})
+	suite.NoError(err)
+	suite.Equal([]models.MonthlyMovement{
+		{Month: "2023-11", Hires: 2},
+		{Month: "2023-12"},
+		{Month: "2024-01"},
+		{Month: "2024-02", Hires: 1, Terminations: 1},
+	}, result)
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetMovementsRange() {
+	filter := models.MovementFilter{From: "2024-01", To: "2024-03"}
+	suite.repo.EXPECT().CountMovements(gomock.Any(), filter).Return([]models.MonthlyMovement{{Month: "2024-02", Hires: 1}}, nil)
+
+	result, err := suite.svc.GetMovements(testCtx, filter)
+	suite.NoError(err)
+	suite.Equal([]models.MonthlyMovement{{Month: "2024-01"}, {Month: "2024-02", Hires: 1}, {Month: "2024-03"}}, result)
+
+	_, err = suite.svc.GetMovements(testCtx, models.MovementFilter{From: "2024-03", To: "2024-01"})
+	suite.ErrorIs(err, models.ErrValidation)
+	_, err = suite.svc.GetMovements(testCtx, models.MovementFilter{To: "March"})
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *AnalyticsServiceTestSuite) TestGetTenureDistribution() {
+	suite.repo.EXPECT().CountTenure(gomock.Any(), models.AnalyticsFilter{}, gomock.Any()).Return(models.TenureBuckets, nil)
+	result, err := suite.svc.GetTenureDistribution(testCtx, models.AnalyticsFilter{})
+	suite.NoError(err)
+	suite.Len(result, len(models.TenureBuckets))
+
+	suite.repo.EXPECT().CountTenure(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+	_, err = suite.svc.GetTenureDistribution(testCtx, models.AnalyticsFilter{})
+	suite.Error(err)
+}
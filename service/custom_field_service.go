@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// CustomFieldService defines the interface for managing the custom fields
+// of employees. Fields are listed, read, created, updated and deleted
+// through the generic Service methods.
+type CustomFieldService interface {
+	Service[models.CustomField, uint]
+	GetAllCustomFields(ctx context.Context) ([]models.CustomField, error)
+}
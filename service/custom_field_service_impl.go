@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// CustomFieldServiceImpl implements the CustomFieldService interface
+type CustomFieldServiceImpl struct {
+	Service[models.CustomField, uint]
+	customFieldRepo repo.CustomFieldRepository
+	employeeRepo    repo.EmployeeRepository
+	unitOfWork      repo.UnitOfWork
+}
+
+// NewCustomFieldService creates a new instance of CustomFieldService.
+// Deleting a field removes its values from employees in the same unit of work.
+func NewCustomFieldService(customFieldRepo repo.CustomFieldRepository, employeeRepo repo.EmployeeRepository, unitOfWork repo.UnitOfWork) CustomFieldService {
+	s := &CustomFieldServiceImpl{
+		customFieldRepo: customFieldRepo,
+		employeeRepo:    employeeRepo,
+		unitOfWork:      unitOfWork,
+	}
+	s.Service = NewService(customFieldRepo, Hooks[models.CustomField, uint]{
+		BeforeCreate: s.beforeCreate,
+		BeforeUpdate: s.beforeUpdate,
+	})
+	return s
+}
+
+// GetAllCustomFields returns every custom field of the tenant, by name
+func (s *CustomFieldServiceImpl) GetAllCustomFields(ctx context.Context) ([]models.CustomField, error) {
+	return s.customFieldRepo.FindAll(ctx)
+}
+
+// Delete deletes a custom field and the values employees hold for it
+func (s *CustomFieldServiceImpl) Delete(ctx context.Context, id uint) error {
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		field, err := s.customFieldRepo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.customFieldRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		_, err = s.employeeRepo.RemoveCustomField(ctx, field.Name)
+		return err
+	})
+}
+
+// beforeCreate validates a new custom field
+func (s *CustomFieldServiceImpl) beforeCreate(_ context.Context, field *models.CustomField) (AfterWrite[models.CustomField], error) {
+	field.Name = strings.TrimSpace(field.Name)
+	return nil, field.Validate()
+}
+
+// beforeUpdate validates the new definition of a custom field. Its name and
+// type cannot change, since the values employees hold depend on them. Values
+// stored before are not checked again.
+func (s *CustomFieldServiceImpl) beforeUpdate(ctx context.Context, id uint, field *models.CustomField) (AfterWrite[models.CustomField], error) {
+	stored, err := s.customFieldRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	field.Name = strings.TrimSpace(field.Name)
+	if field.Name != stored.Name || field.Type != stored.Type {
+		return nil, fmt.Errorf("%w: the name and type of a custom field cannot change", models.ErrValidation)
+	}
+	return nil, field.Validate()
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type CustomFieldServiceTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	fieldRepo  *mocks.MockCustomFieldRepository
+	empRepo    *mocks.MockEmployeeRepository
+	unitOfWork *mocks.MockUnitOfWork
+	svc        CustomFieldService
+}
+
+func (suite *CustomFieldServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.fieldRepo = mocks.NewMockCustomFieldRepository(suite.ctrl)
+	suite.empRepo = mocks.NewMockEmployeeRepository(suite.ctrl)
+	suite.unitOfWork = mocks.NewMockUnitOfWork(suite.ctrl)
+	suite.svc = NewCustomFieldService(suite.fieldRepo, suite.empRepo, suite.unitOfWork)
+}
+
+func (suite *CustomFieldServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestCustomFieldServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomFieldServiceTestSuite))
+}
+
+func (suite *CustomFieldServiceTestSuite) TestCreateValidatesTheDefinition() {
+	for _, field := range []models.CustomField{
+		{Name: "Shirt Size", Type: models.CustomFieldString},
+		{Name: "shirt_size", Type: "color"},
+		{Name: "badge", Type: models.CustomFieldInteger, EnumValues: []string{"1"}},
+		{Name: "cost_center", Type: models.CustomFieldString, Pattern: "CC-("},
+		{Name: "size", Type: models.CustomFieldString, EnumValues: []string{"S", "XXL"}, Pattern: "[SML]"},
+	} {
+		_, err := suite.svc.Create(testCtx, field)
+		suite.ErrorIs(err, models.ErrValidation, field.Name)
+	}
+
+	field := models.CustomField{Name: " shirt_size ", Type: models.CustomFieldString, EnumValues: []string{"S", "M"}, Pattern: "[SML]"}
+	suite.fieldRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f models.CustomField) (models.CustomField, error) {
+		suite.Equal("shirt_size", f.Name)
+		f.ID = 1
+		return f, nil
+	})
+	created, err := suite.svc.Create(testCtx, field)
+	suite.NoError(err)
+	suite.Equal(uint(1), created.ID)
+}
+
+func (suite *CustomFieldServiceTestSuite) TestUpdateKeepsNameAndType() {
+	stored := models.CustomField{ID: 1, Name: "shirt_size", Type: models.CustomFieldString}
+	suite.fieldRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(stored, nil).Times(3)
+
+	_, err := suite.svc.Update(testCtx, 1, models.CustomField{Name: "size", Type: models.CustomFieldString})
+	suite.ErrorIs(err, models.ErrValidation)
+	_, err = suite.svc.Update(testCtx, 1, models.CustomField{Name: "shirt_size", Type: models.CustomFieldInteger})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	update := models.CustomField{Name: "shirt_size", Type: models.CustomFieldString, Required: true}
+	suite.fieldRepo.EXPECT().Update(gomock.Any(), uint(1), update).Return(update, nil)
+	_, err = suite.svc.Update(testCtx, 1, update)
+	suite.NoError(err)
+}
+
+func (suite *CustomFieldServiceTestSuite) TestDeleteRemovesValuesInOneUnitOfWork() {
+	type txMarker struct{}
+	inTx := gomock.Cond(func(x any) bool { return x.(context.Context).Value(txMarker{}) != nil })
+	suite.unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(context.WithValue(ctx, txMarker{}, true))
+		})
+
+	suite.fieldRepo.EXPECT().FindByID(inTx, uint(1)).Return(models.CustomField{ID: 1, Name: "shirt_size"}, nil)
+	suite.fieldRepo.EXPECT().Delete(inTx, uint(1)).Return(nil)
+	suite.empRepo.EXPECT().RemoveCustomField(inTx, "shirt_size").Return([]uint{4, 5}, nil)
+	suite.NoError(suite.svc.Delete(testCtx, 1))
+
+	suite.fieldRepo.EXPECT().FindByID(inTx, uint(2)).Return(models.CustomField{}, models.ErrCustomFieldNotFound)
+	suite.ErrorIs(suite.svc.Delete(testCtx, 2), models.ErrCustomFieldNotFound)
+}
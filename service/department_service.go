@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// DepartmentService defines the interface for department operations
+type DepartmentService interface {
+	GetAllDepartments(ctx context.Context) ([]models.Department, error)
+	GetDepartmentByID(ctx context.Context, id uint) (models.Department, error)
+	CreateDepartment(ctx context.Context, department models.Department) (models.Department, error)
+	UpdateDepartment(ctx context.Context, id uint, department models.Department) (models.Department, error)
+	DeleteDepartment(ctx context.Context, id uint) error
+	GetDepartmentEmployees(ctx context.Context, id uint, filter models.EmployeeFilter) ([]models.Employee, int64, error)
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// DepartmentServiceImpl implements the DepartmentService interface
+type DepartmentServiceImpl struct {
+	departmentRepo repo.DepartmentRepository
+	employeeRepo   repo.EmployeeRepository
+}
+
+// NewDepartmentService creates a new instance of DepartmentService
+func NewDepartmentService(departmentRepo repo.DepartmentRepository, employeeRepo repo.EmployeeRepository) DepartmentService {
+	return &DepartmentServiceImpl{departmentRepo: departmentRepo, employeeRepo: employeeRepo}
+}
+
+// GetAllDepartments returns all departments
+func (s *DepartmentServiceImpl) GetAllDepartments(ctx context.Context) ([]models.Department, error) {
+	return s.departmentRepo.FindAll(ctx)
+}
+
+// GetDepartmentByID returns a department by ID
+func (s *DepartmentServiceImpl) GetDepartmentByID(ctx context.Context, id uint) (models.Department, error) {
+	return s.departmentRepo.FindByID(ctx, id)
+}
+
+// CreateDepartment creates a new department
+func (s *DepartmentServiceImpl) CreateDepartment(ctx context.Context, department models.Department) (models.Department, error) {
+	return s.departmentRepo.Create(ctx, department)
+}
+
+// UpdateDepartment updates an existing department
+func (s *DepartmentServiceImpl) UpdateDepartment(ctx context.Context, id uint, department models.Department) (models.Department, error) {
+	return s.departmentRepo.Update(ctx, id, department)
+}
+
+// DeleteDepartment deletes a department by ID, refusing while employees are still assigned
+func (s *DepartmentServiceImpl) DeleteDepartment(ctx context.Context, id uint) error {
+	return s.departmentRepo.Delete(ctx, id)
+}
+
+// GetDepartmentEmployees returns one page of the department's employees matching the filter
+func (s *DepartmentServiceImpl) GetDepartmentEmployees(ctx context.Context, id uint, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
+	if _, err := s.departmentRepo.FindByID(ctx, id); err != nil {
+		return nil, 0, err
+	}
+	filter.DepartmentID = &id
+	filter.Normalize()
+	return s.employeeRepo.FindAll(ctx, filter)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type DepartmentServiceTestSuite struct {
+	suite.Suite
+	ctrl     *gomock.Controller
+	deptRepo *mocks.MockDepartmentRepository
+	empRepo  *mocks.MockEmployeeRepository
+	svc      DepartmentService
+}
+
+func (suite *DepartmentServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.deptRepo = mocks.NewMockDepartmentRepository(suite.ctrl)
+	suite.empRepo = mocks.NewMockEmployeeRepository(suite.ctrl)
+	suite.svc = NewDepartmentService(suite.deptRepo, suite.empRepo)
+}
+
+func (suite *DepartmentServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestDepartmentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DepartmentServiceTestSuite))
+}
+
+func (suite *DepartmentServiceTestSuite) TestGetAllDepartments() {
+	departments := []models.Department{{ID: 1, Name: "Engineering"}, {ID: 2, Name: "Design"}}
+	suite.deptRepo.EXPECT().FindAll(gomock.Any()).Return(departments, nil)
+
+	result, err := suite.svc.GetAllDepartments(testCtx)
+	suite.NoError(err)
+	suite.Equal(departments, result)
+}
+
+func (suite *DepartmentServiceTestSuite) TestCreateDepartment() {
+	department := models.Department{Name: "Engineering"}
+	created := department
+	created.ID = 1
+	suite.deptRepo.EXPECT().Create(gomock.Any(), department).Return(created, nil)
+
+	result, err := suite.svc.CreateDepartment(testCtx, department)
+	suite.NoError(err)
+	suite.Equal(created, result)
+}
+
+func (suite *DepartmentServiceTestSuite) TestDeleteDepartment() {
+	suite.deptRepo.EXPECT().Delete(gomock.Any(), uint(1)).Return(models.ErrConflict)
+
+	err := suite.svc.DeleteDepartment(testCtx, 1)
+	suite.ErrorIs(err, models.ErrConflict)
+}
+
+func (suite *DepartmentServiceTestSuite) TestGetDepartmentEmployees() {
+	departmentID := uint(1)
+	employees := []models.Employee{{ID: 1, Name: "Alice", DepartmentID: &departmentID}}
+	suite.deptRepo.EXPECT().FindByID(gomock.Any(), departmentID).Return(models.Department{ID: departmentID}, nil)
+	suite.empRepo.EXPECT().FindAll(gomock.Any(), models.EmployeeFilter{
+		Pagination:   models.Pagination{Page: 1, PageSize: models.DefaultPageSize},
+		Name:         "Ali",
+		DepartmentID: &departmentID,
+	}).Return(employees, int64(1), nil)
+
+	result, total, err := suite.svc.GetDepartmentEmployees(testCtx, departmentID, models.EmployeeFilter{Name: "Ali"})
+	suite.NoError(err)
+	suite.Equal(employees, result)
+	suite.Equal(int64(1), total)
+
+	suite.deptRepo.EXPECT().FindByID(gomock.Any(), uint(9)).Return(models.Department{}, models.ErrDepartmentNotFound)
+	_, _, err = suite.svc.GetDepartmentEmployees(testCtx, 9, models.EmployeeFilter{})
+	suite.ErrorIs(err, models.ErrDepartmentNotFound)
+}
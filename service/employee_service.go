@@ -1,14 +1,31 @@
 package service
 
 import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/events"
 	"github.com/chinmay-sawant/gin-example/models"
 )
 
-// EmployeeService defines the interface for employee operations
+// EmployeeService defines the interface for employee operations. Employees
+// are created, updated and deleted through the generic Service methods.
 type EmployeeService interface {
-	GetAllEmployees() ([]models.Employee, error)
-	GetEmployeeByID(id uint) (models.Employee, error)
-	CreateEmployee(employee models.Employee) (models.Employee, error)
-	UpdateEmployee(id uint, employee models.Employee) (models.Employee, error)
-	DeleteEmployee(id uint) error
+	Service[models.Employee, uint]
+	GetAllEmployees(ctx context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error)
+	GetEmployeeByID(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error)
+	GetDirectReports(ctx context.Context, id uint) ([]models.Employee, error)
+	GetReportingChain(ctx context.Context, id uint) ([]models.Employee, error)
+	GetSubordinates(ctx context.Context, id uint) ([]models.Employee, error)
+	GetOrgChart(ctx context.Context, rootID *uint) ([]models.OrgChartNode, error)
+	GetSalaryOverrides(ctx context.Context, id uint) ([]models.SalaryBandOverride, error)
+	TransitionEmployee(ctx context.Context, id uint, status models.EmployeeStatus, request models.StatusTransitionRequest) (models.Employee, error)
+	GetStatusHistory(ctx context.Context, id uint) ([]models.EmployeeStatusTransition, error)
+	BulkCreateEmployees(ctx context.Context, employees []models.Employee, mode models.BulkMode) ([]models.BulkItemResult, error)
+	BulkUpdateEmployees(ctx context.Context, patches []models.EmployeePatch, mode models.BulkMode) ([]models.BulkItemResult, error)
+	BulkDeleteEmployees(ctx context.Context, ids []uint, mode models.BulkMode) ([]models.BulkItemResult, error)
+	ImportEmployees(ctx context.Context, rows []models.ImportRow, dryRun bool) (models.ImportReport, error)
+	ExportEmployees(ctx context.Context, filter models.EmployeeFilter, fn func([]models.Employee) error) error
+	SearchEmployees(ctx context.Context, query string, limit int) ([]models.SearchHit, error)
+	RebuildSearchIndex(ctx context.Context) error
+	SubscribeEvents(ctx context.Context, filter models.EventFilter, after *uint64) (*events.Subscription, error)
 }
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// BulkCreateEmployees validates and creates many employees. Atomic mode
+// creates all of them and their salary override records in one transaction
+// or none; best-effort mode creates
+// every valid employee and reports the failures.
+func (s *EmployeeServiceImpl) BulkCreateEmployees(ctx context.Context, employees []models.Employee, mode models.BulkMode) ([]models.BulkItemResult, error) {
+	if err := s.checkBulkSize(len(employees)); err != nil {
+		return nil, err
+	}
+
+	results := newBulkResults(len(employees))
+	var prepared []models.Employee
+	var overrides []*models.SalaryBandOverride
+	var indices []int
+	for i := range employees {
+		employee := employees[i]
+		override, err := s.prepareCreate(ctx, &employee)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		prepared = append(prepared, employee)
+		overrides = append(overrides, override)
+		indices = append(indices, i)
+	}
+	if len(prepared) == 0 || (mode == models.BulkAtomic && len(prepared) < len(employees)) {
+		return markRolledBack(results), nil
+	}
+
+	if mode == models.BulkAtomic {
+		err := s.transaction(ctx, func(ctx context.Context) error {
+			created, err := s.employeeRepo.CreateBatch(ctx, prepared, s.bulkBatchSize)
+			if err != nil {
+				for _, i := range indices {
+					results[i].Err = err
+				}
+				return err
+			}
+			return s.recordBulkResults(ctx, results, indices, created, overrides, mode)
+		})
+		if err != nil {
+			// The created employees were rolled back, so their IDs do not exist
+			for i := range results {
+				results[i].ID = 0
+				results[i].Employee = nil
+			}
+			return markRolledBack(results), nil
+		}
+		s.indexBulkResults(results)
+		return results, nil
+	}
+
+	created, err := s.employeeRepo.CreateBatch(ctx, prepared, s.bulkBatchSize)
+	if err != nil {
+		// The batch failed as a whole; retry row by row to find the bad rows
+		created = make([]models.Employee, len(prepared))
+		for j, employee := range prepared {
+			if created[j], err = s.employeeRepo.Create(ctx, employee); err != nil {
+				results[indices[j]].Err = err
+			}
+		}
+	}
+	s.recordBulkResults(ctx, results, indices, created, overrides, mode)
+	s.indexBulkResults(results)
+	return results, nil
+}
+
+// BulkUpdateEmployees applies partial updates to many employees. Atomic mode
+// applies all of them and records their salary overrides in one transaction
+// or none; best-effort mode applies
+// every valid update and reports the failures.
+func (s *EmployeeServiceImpl) BulkUpdateEmployees(ctx context.Context, patches []models.EmployeePatch, mode models.BulkMode) ([]models.BulkItemResult, error) {
+	if err := s.checkBulkSize(len(patches)); err != nil {
+		return nil, err
+	}
+
+	results := newBulkResults(len(patches))
+	var prepared []models.Employee
+	var overrides []*models.SalaryBandOverride
+	var indices []int
+	for i, patch := range patches {
+		results[i].ID = patch.ID
+		employee, err := s.employeeRepo.FindByID(ctx, patch.ID)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		patch.Apply(&employee)
+		override, err := s.prepareUpdate(ctx, patch.ID, &employee)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		prepared = append(prepared, employee)
+		overrides = append(overrides, override)
+		indices = append(indices, i)
+	}
+	if len(prepared) == 0 || (mode == models.BulkAtomic && len(prepared) < len(patches)) {
+		return markRolledBack(results), nil
+	}
+
+	if mode == models.BulkAtomic {
+		err := s.transaction(ctx, func(ctx context.Context) error {
+			updated, err := s.employeeRepo.UpdateBatch(ctx, prepared)
+			if err != nil {
+				for _, i := range indices {
+					results[i].Err = err
+				}
+				return err
+			}
+			return s.recordBulkResults(ctx, results, indices, updated, overrides, mode)
+		})
+		if err != nil {
+			for i := range results {
+				results[i].Employee = nil
+			}
+			return markRolledBack(results), nil
+		}
+		s.indexBulkResults(results)
+		return results, nil
+	}
+
+	updated := make([]models.Employee, len(prepared))
+	for j, employee := range prepared {
+		var err error
+		if updated[j], err = s.employeeRepo.Update(ctx, employee.ID, employee); err != nil {
+			results[indices[j]].Err = err
+		}
+	}
+	s.recordBulkResults(ctx, results, indices, updated, overrides, mode)
+	s.indexBulkResults(results)
+	return results, nil
+}
+
+// BulkDeleteEmployees deletes many employees. Atomic mode deletes all of
+// them in one transaction or none; best-effort mode deletes every employee
+// it can and reports the failures.
+func (s *EmployeeServiceImpl) BulkDeleteEmployees(ctx context.Context, ids []uint, mode models.BulkMode) ([]models.BulkItemResult, error) {
+	if err := s.checkBulkSize(len(ids)); err != nil {
+		return nil, err
+	}
+
+	results := newBulkResults(len(ids))
+	for i, id := range ids {
+		results[i].ID = id
+	}
+
+	if mode != models.BulkAtomic {
+		var deleted []uint
+		for i, id := range ids {
+			if results[i].Err = s.employeeRepo.Delete(ctx, id); results[i].Err == nil {
+				deleted = append(deleted, id)
+			}
+		}
+		s.unindexEmployees(deleted...)
+		return results, nil
+	}
+
+	// Check every ID up front so a missing employee is reported against its own item
+	missing := false
+	for i, id := range ids {
+		if _, err := s.employeeRepo.FindByID(ctx, id); err != nil {
+			results[i].Err = err
+			missing = true
+		}
+	}
+	if missing {
+		return markRolledBack(results), nil
+	}
+	if err := s.employeeRepo.DeleteBatch(ctx, ids); err != nil {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results, nil
+	}
+	s.unindexEmployees(ids...)
+	return results, nil
+}
+
+// recordBulkResults stores the salary override of every saved employee and
+// fills in its result. Best-effort mode reports a failing override on its
+// item and carries on; atomic mode stops there and returns the error, so the
+// caller rolls back the batch.
+func (s *EmployeeServiceImpl) recordBulkResults(ctx context.Context, results []models.BulkItemResult, indices []int, saved []models.Employee, overrides []*models.SalaryBandOverride, mode models.BulkMode) error {
+	for j, i := range indices {
+		if results[i].Err != nil {
+			continue
+		}
+		employee := saved[j]
+		results[i].ID = employee.ID
+		results[i].Employee = &employee
+		if err := s.recordSalaryOverride(ctx, employee, overrides[j]); err != nil {
+			results[i].Err = err
+			if mode == models.BulkAtomic {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkBulkSize rejects empty bulk requests and ones above the configured limit
+func (s *EmployeeServiceImpl) checkBulkSize(n int) error {
+	if n == 0 {
+		return fmt.Errorf("%w: a bulk request needs at least one item", models.ErrValidation)
+	}
+	if n > s.bulkMaxItems {
+		return fmt.Errorf("%w: a bulk request may contain at most %d items, got %d", models.ErrValidation, s.bulkMaxItems, n)
+	}
+	return nil
+}
+
+// newBulkResults returns one empty result per item, in request order
+func newBulkResults(n int) []models.BulkItemResult {
+	results := make([]models.BulkItemResult, n)
+	for i := range results {
+		results[i].Index = i
+	}
+	return results
+}
+
+// markRolledBack flags every item without its own error as not applied
+func markRolledBack(results []models.BulkItemResult) []models.BulkItemResult {
+	for i := range results {
+		if results[i].Err == nil {
+			results[i].Err = models.ErrRolledBack
+		}
+	}
+	return results
+}
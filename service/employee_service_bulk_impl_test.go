@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *EmployeeServiceTestSuite) TestBulkCreateEmployeesAtomic() {
+	employees := []models.Employee{
+		{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000},
+		{Name: "Jane", Email: "jane@example.com", Position: "QA", Salary: 55000},
+	}
+	prepared := []models.Employee{
+		{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000, Currency: "USD", Status: models.StatusActive},
+		{Name: "Jane", Email: "jane@example.com", Position: "QA", Salary: 55000, Currency: "USD", Status: models.StatusActive},
+	}
+	created := []models.Employee{prepared[0], prepared[1]}
+	created[0].ID, created[1].ID = 1, 2
+	suite.repo.EXPECT().CreateBatch(gomock.Any(), prepared, DefaultBulkBatchSize).Return(created, nil)
+
+	results, err := suite.svc.BulkCreateEmployees(testCtx, employees, models.BulkAtomic)
+	suite.NoError(err)
+	suite.Len(results, 2)
+	suite.NoError(results[0].Err)
+	suite.Equal(uint(1), results[0].ID)
+	suite.Equal(uint(2), results[1].ID)
+	suite.Equal(1, results[1].Index)
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkCreateEmployeesAtomicInvalidItem() {
+	employees := []models.Employee{
+		{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000},
+		{Name: "Jane", Email: "jane@example.com", Position: "QA", Salary: 55000, Status: models.StatusTerminated},
+	}
+
+	results, err := suite.svc.BulkCreateEmployees(testCtx, employees, models.BulkAtomic)
+	suite.NoError(err)
+	suite.ErrorIs(results[0].Err, models.ErrRolledBack)
+	suite.ErrorIs(results[1].Err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkCreateEmployeesBestEffortRetriesRows() {
+	employees := []models.Employee{
+		{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000},
+		{Name: "Jane", Email: "john@example.com", Position: "QA", Salary: 55000},
+	}
+	suite.repo.EXPECT().CreateBatch(gomock.Any(), gomock.Any(), DefaultBulkBatchSize).Return(nil, errors.New("duplicate email"))
+	first := models.Employee{ID: 1, Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000, Currency: "USD", Status: models.StatusActive}
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(first, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(models.Employee{}, errors.New("duplicate email"))
+
+	results, err := suite.svc.BulkCreateEmployees(testCtx, employees, models.BulkBestEffort)
+	suite.NoError(err)
+	suite.NoError(results[0].Err)
+	suite.Equal(uint(1), results[0].ID)
+	suite.Error(results[1].Err)
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkCreateEmployeesLimit() {
+	svc := NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo, WithBulkLimits(1, 0))
+
+	_, err := svc.BulkCreateEmployees(testCtx, make([]models.Employee, 2), models.BulkAtomic)
+	suite.ErrorIs(err, models.ErrValidation)
+	_, err = svc.BulkCreateEmployees(testCtx, nil, models.BulkAtomic)
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkUpdateEmployeesBestEffort() {
+	name := "Alice Smith"
+	existing := models.Employee{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000, Currency: "USD"}
+	updated := existing
+	updated.Name = name
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(existing, nil)
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(9)).Return(models.Employee{}, models.ErrEmployeeNotFound)
+	suite.repo.EXPECT().Update(gomock.Any(), uint(1), updated).Return(updated, nil)
+
+	patches := []models.EmployeePatch{{ID: 1, Name: &name}, {ID: 9, Name: &name}}
+	results, err := suite.svc.BulkUpdateEmployees(testCtx, patches, models.BulkBestEffort)
+	suite.NoError(err)
+	suite.NoError(results[0].Err)
+	suite.Equal(name, results[0].Employee.Name)
+	suite.ErrorIs(results[1].Err, models.ErrEmployeeNotFound)
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkDeleteEmployeesAtomic() {
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{ID: 1}, nil)
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(9)).Return(models.Employee{}, models.ErrEmployeeNotFound)
+
+	results, err := suite.svc.BulkDeleteEmployees(testCtx, []uint{1, 9}, models.BulkAtomic)
+	suite.NoError(err)
+	suite.ErrorIs(results[0].Err, models.ErrRolledBack)
+	suite.ErrorIs(results[1].Err, models.ErrEmployeeNotFound)
+
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{ID: 1}, nil)
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(models.Employee{ID: 2}, nil)
+	suite.repo.EXPECT().DeleteBatch(gomock.Any(), []uint{1, 2}).Return(nil)
+
+	results, err = suite.svc.BulkDeleteEmployees(testCtx, []uint{1, 2}, models.BulkAtomic)
+	suite.NoError(err)
+	suite.NoError(results[0].Err)
+	suite.NoError(results[1].Err)
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkCreateEmployeesAtomicRollsBackFailedOverride() {
+	type txMarker struct{}
+	inTx := gomock.Cond(func(x any) bool { return x.(context.Context).Value(txMarker{}) != nil })
+	unitOfWork := mocks.NewMockUnitOfWork(suite.ctrl)
+	unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(context.WithValue(ctx, txMarker{}, true))
+		})
+	svc := NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo, WithUnitOfWork(unitOfWork))
+
+	// The batch and the salary override records are written in one
+	// transaction, so an override that cannot be recorded rolls back both
+	positionID := uint(7)
+	suite.posRepo.EXPECT().FindByID(gomock.Any(), positionID).Times(2).Return(models.Position{ID: positionID, Title: "Manager", StrictBand: true,
+		SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 80000, MaxSalary: 120000}}}, nil)
+	suite.repo.EXPECT().CreateBatch(inTx, gomock.Len(2), DefaultBulkBatchSize).Return([]models.Employee{{ID: 1}, {ID: 2}}, nil)
+	suite.posRepo.EXPECT().CreateSalaryOverride(inTx, gomock.Any()).Return(models.SalaryBandOverride{}, errors.New("db down"))
+
+	override := &models.SalaryOverride{Justification: "Competing offer", ApprovedBy: "hr-lead"}
+	results, err := svc.BulkCreateEmployees(testCtx, []models.Employee{
+		{Name: "Ann", Email: "ann@example.com", PositionID: &positionID, Salary: 150000, SalaryOverride: override},
+		{Name: "Bob", Email: "bob@example.com", PositionID: &positionID, Salary: 90000},
+	}, models.BulkAtomic)
+	suite.NoError(err)
+	suite.EqualError(results[0].Err, "db down")
+	suite.ErrorIs(results[1].Err, models.ErrRolledBack)
+	for _, result := range results {
+		suite.Zero(result.ID)
+		suite.Nil(result.Employee)
+	}
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkUpdateEmployeesClearsNullReferences() {
+	var patches []models.EmployeePatch
+	suite.Require().NoError(json.Unmarshal([]byte(`[{"id":1,"department_id":null,"manager_id":null},{"id":2,"department_id":3}]`), &patches))
+	departmentID, managerID := uint(2), uint(5)
+	first := models.Employee{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000, Currency: "USD", DepartmentID: &departmentID, ManagerID: &managerID}
+	second := models.Employee{ID: 2, Name: "Bob", Email: "bob@example.com", Position: "Dev", Salary: 50000, Currency: "USD", ManagerID: &managerID}
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(first, nil)
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(second, nil)
+	suite.deptRepo.EXPECT().FindByID(gomock.Any(), uint(3)).Return(models.Department{ID: 3}, nil)
+	suite.repo.EXPECT().FindByID(gomock.Any(), managerID).Return(models.Employee{ID: managerID}, nil)
+	suite.repo.EXPECT().FindReportingChain(gomock.Any(), managerID).Return(nil, nil)
+	suite.repo.EXPECT().UpdateBatch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, employees []models.Employee) ([]models.Employee, error) {
+			// Null references are cleared, and left out ones are kept
+			suite.Nil(employees[0].DepartmentID)
+			suite.Nil(employees[0].ManagerID)
+			suite.Equal(&managerID, employees[1].ManagerID)
+			suite.Equal(uint(3), *employees[1].DepartmentID)
+			return employees, nil
+		})
+
+	results, err := suite.svc.BulkUpdateEmployees(testCtx, patches, models.BulkAtomic)
+	suite.NoError(err)
+	suite.NoError(results[0].Err)
+	suite.NoError(results[1].Err)
+}
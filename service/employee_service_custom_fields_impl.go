@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// WithCustomFields validates the custom field values of employees against
+// the tenant's definitions in customFieldRepo. Without it employees cannot
+// hold custom field values.
+func WithCustomFields(customFieldRepo repo.CustomFieldRepository) EmployeeServiceOption {
+	return func(s *EmployeeServiceImpl) {
+		s.customFieldRepo = customFieldRepo
+	}
+}
+
+// customFields returns the custom fields of the tenant by name
+func (s *EmployeeServiceImpl) customFields(ctx context.Context) (map[string]models.CustomField, error) {
+	if s.customFieldRepo == nil {
+		return nil, nil
+	}
+	fields, err := s.customFieldRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]models.CustomField, len(fields))
+	for _, field := range fields {
+		byName[field.Name] = field
+	}
+	return byName, nil
+}
+
+// validateCustomFields checks the employee's custom field values against the
+// tenant's definitions and converts them to the form they are stored in.
+// Null values are dropped. Updates without values keep the stored ones, so
+// only new employees are checked for required fields then.
+func (s *EmployeeServiceImpl) validateCustomFields(ctx context.Context, employee *models.Employee, creating bool) error {
+	if employee.CustomFields == nil && !creating {
+		return nil
+	}
+	fields, err := s.customFields(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(employee.CustomFields)) {
+		if _, ok := fields[name]; !ok {
+			return fmt.Errorf("%w: unknown custom field %s", models.ErrValidation, name)
+		}
+	}
+	var values models.CustomFieldValues
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		field := fields[name]
+		value := employee.CustomFields[name]
+		if value == nil {
+			if field.Required {
+				return fmt.Errorf("%w: custom field %s is required", models.ErrValidation, name)
+			}
+			continue
+		}
+		if values == nil {
+			values = make(models.CustomFieldValues, len(employee.CustomFields))
+		}
+		if values[name], err = field.CheckValue(value); err != nil {
+			return err
+		}
+	}
+	if values == nil && employee.CustomFields != nil {
+		values = models.CustomFieldValues{}
+	}
+	employee.CustomFields = values
+	return nil
+}
+
+// parseCustomFieldFilters converts the text of custom field filters to the
+// type of their field, rejecting filters on unknown fields
+func (s *EmployeeServiceImpl) parseCustomFieldFilters(ctx context.Context, filter *models.EmployeeFilter) error {
+	if len(filter.CustomFields) == 0 {
+		return nil
+	}
+	fields, err := s.customFields(ctx)
+	if err != nil {
+		return err
+	}
+	parsed := make(map[string]interface{}, len(filter.CustomFields))
+	for name, value := range filter.CustomFields {
+		field, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("%w: unknown custom field %s", models.ErrValidation, name)
+		}
+		text, _ := value.(string)
+		if parsed[name], err = field.ParseValue(text); err != nil {
+			return err
+		}
+	}
+	filter.CustomFields = parsed
+	return nil
+}
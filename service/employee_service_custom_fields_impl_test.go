@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+// customFieldsService returns an employee service with shirt_size, badge
+// and remote custom fields
+func (suite *EmployeeServiceTestSuite) customFieldsService() EmployeeService {
+	fieldRepo := mocks.NewMockCustomFieldRepository(suite.ctrl)
+	fieldRepo.EXPECT().FindAll(gomock.Any()).Return([]models.CustomField{
+		{Name: "badge", Type: models.CustomFieldInteger},
+		{Name: "remote", Type: models.CustomFieldBoolean},
+		{Name: "shirt_size", Type: models.CustomFieldString, Required: true, EnumValues: []string{"S", "M", "L"}},
+	}, nil).AnyTimes()
+	return NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo, WithCustomFields(fieldRepo))
+}
+
+func (suite *EmployeeServiceTestSuite) TestCreateEmployeeValidatesCustomFields() {
+	svc := suite.customFieldsService()
+	employee := models.Employee{Name: "Ann", Email: "ann@example.com", Position: "Dev", Salary: 100}
+
+	for _, values := range []models.CustomFieldValues{
+		nil,
+		{"shirt_size": nil},
+		{"shirt_size": "XL"},
+		{"shirt_size": "M", "badge": 1.5},
+		{"shirt_size": "M", "remote": "yes"},
+		{"shirt_size": "M", "unknown": 1},
+	} {
+		employee.CustomFields = values
+		_, err := svc.Create(testCtx, employee)
+		suite.ErrorIs(err, models.ErrValidation, values)
+	}
+
+	// Values are stored in their field's type and null optional values are dropped
+	employee.CustomFields = models.CustomFieldValues{"shirt_size": "M", "badge": float64(42), "remote": nil}
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.Equal(models.CustomFieldValues{"shirt_size": "M", "badge": int64(42)}, e.CustomFields)
+		return e, nil
+	})
+	_, err := svc.Create(testCtx, employee)
+	suite.NoError(err)
+}
+
+func (suite *EmployeeServiceTestSuite) TestUpdateEmployeeWithoutCustomFieldsKeepsThem() {
+	svc := suite.customFieldsService()
+	employee := models.Employee{Name: "Ann", Email: "ann@example.com", Position: "Dev", Salary: 100}
+
+	suite.repo.EXPECT().Update(gomock.Any(), uint(1), gomock.Any()).Return(employee, nil)
+	_, err := svc.Update(testCtx, 1, employee)
+	suite.NoError(err)
+
+	employee.CustomFields = models.CustomFieldValues{"badge": float64(7)}
+	_, err = svc.Update(testCtx, 1, employee)
+	suite.ErrorIs(err, models.ErrValidation, "a given set of values must hold the required fields")
+}
+
+func (suite *EmployeeServiceTestSuite) TestBulkPatchMergesCustomFields() {
+	svc := suite.customFieldsService()
+	stored := models.Employee{ID: 1, Name: "Ann", Email: "ann@example.com", Position: "Dev", Salary: 100,
+		CustomFields: models.CustomFieldValues{"shirt_size": "M", "badge": float64(42)}}
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(stored, nil)
+	suite.repo.EXPECT().UpdateBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, employees []models.Employee) ([]models.Employee, error) {
+		suite.Equal(models.CustomFieldValues{"shirt_size": "L", "remote": true}, employees[0].CustomFields)
+		return employees, nil
+	})
+
+	results, err := svc.BulkUpdateEmployees(testCtx, []models.EmployeePatch{
+		{ID: 1, CustomFields: models.CustomFieldValues{"shirt_size": "L", "badge": nil, "remote": true}},
+	}, models.BulkAtomic)
+	suite.NoError(err)
+	suite.NoError(results[0].Err)
+}
+
+func (suite *EmployeeServiceTestSuite) TestGetAllEmployeesParsesCustomFieldFilters() {
+	svc := suite.customFieldsService()
+
+	_, _, err := svc.GetAllEmployees(testCtx, models.EmployeeFilter{CustomFields: map[string]interface{}{"unknown": "x"}})
+	suite.ErrorIs(err, models.ErrValidation)
+	_, _, err = svc.GetAllEmployees(testCtx, models.EmployeeFilter{CustomFields: map[string]interface{}{"badge": "many"}})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	suite.repo.EXPECT().FindAll(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
+		suite.Equal(map[string]interface{}{"badge": int64(42), "remote": true, "shirt_size": "M"}, filter.CustomFields)
+		return nil, 0, nil
+	})
+	_, _, err = svc.GetAllEmployees(testCtx, models.EmployeeFilter{CustomFields: map[string]interface{}{"badge": "42", "remote": "true", "shirt_size": "M"}})
+	suite.NoError(err)
+}
+
+func (suite *EmployeeServiceTestSuite) TestExportEmployeesParsesCustomFieldFilters() {
+	svc := suite.customFieldsService()
+	export := func(filter models.EmployeeFilter) error {
+		return svc.ExportEmployees(testCtx, filter, func([]models.Employee) error { return nil })
+	}
+
+	suite.ErrorIs(export(models.EmployeeFilter{CustomFields: map[string]interface{}{"unknown": "x"}}), models.ErrValidation)
+	suite.repo.EXPECT().FindInBatches(gomock.Any(), gomock.Any(), exportBatchSize, gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter models.EmployeeFilter, _ int, _ func([]models.Employee) error) error {
+			suite.Equal(map[string]interface{}{"badge": int64(42)}, filter.CustomFields)
+			return nil
+		})
+	suite.NoError(export(models.EmployeeFilter{CustomFields: map[string]interface{}{"badge": "42"}}))
+}
+
+func (suite *EmployeeServiceTestSuite) TestCustomFieldsWithoutDefinitions() {
+	employee := models.Employee{Name: "Ann", Email: "ann@example.com", Position: "Dev", Salary: 100,
+		CustomFields: models.CustomFieldValues{"shirt_size": "M"}}
+	_, err := suite.svc.Create(testCtx, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+// WithEventBroker sets the broker employee changes are subscribed from. The
+// outbox relay publishes every stored change to it.
+func WithEventBroker(broker events.Broker) EmployeeServiceOption {
+	return func(s *EmployeeServiceImpl) {
+		if broker != nil {
+			s.events = broker
+		}
+	}
+}
+
+// SubscribeEvents subscribes to the employee changes of the context's tenant
+// matching the filter, replaying the retained changes after the given event
+// ID when it is set. A context without a tenant fails with tenant.ErrNoTenant.
+func (s *EmployeeServiceImpl) SubscribeEvents(ctx context.Context, filter models.EventFilter, after *uint64) (*events.Subscription, error) {
+	tenantID, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, tenant.ErrNoTenant
+	}
+	filter.TenantID = tenantID
+	return s.events.Subscribe(filter, after), nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/events"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+func (suite *EmployeeServiceTestSuite) TestSubscribeEventsUsesBroker() {
+	broker := events.NewBroker(10)
+	svc := NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo, WithEventBroker(broker))
+	broker.Publish(models.EmployeeEvent{ID: 4, Type: models.EventEmployeeCreated, EmployeeID: 7, TenantID: "acme"})
+
+	after := uint64(3)
+	subscription, err := svc.SubscribeEvents(testCtx, models.EventFilter{}, &after)
+	suite.Require().NoError(err)
+	defer subscription.Close()
+	suite.False(subscription.Missed)
+	suite.Require().Len(subscription.Replay, 1)
+	suite.Equal(uint(7), subscription.Replay[0].EmployeeID)
+}
+
+func (suite *EmployeeServiceTestSuite) TestSubscribeEventsRequiresTenant() {
+	svc := NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo, WithEventBroker(events.NewBroker(10)))
+
+	_, err := svc.SubscribeEvents(context.Background(), models.EventFilter{}, nil)
+	suite.ErrorIs(err, tenant.ErrNoTenant)
+}
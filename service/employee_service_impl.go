@@ -1,41 +1,496 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/events"
 	"github.com/chinmay-sawant/gin-example/models"
 	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/search"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+const (
+	// DefaultBulkMaxItems is the most items a bulk request may contain by default
+	DefaultBulkMaxItems = 500
+	// DefaultBulkBatchSize is how many rows a bulk create inserts per statement by default
+	DefaultBulkBatchSize = 100
+	// exportBatchSize is how many rows an export reads from the database at a time
+	exportBatchSize = 500
 )
 
 // EmployeeServiceImpl implements the EmployeeService interface
 type EmployeeServiceImpl struct {
-	employeeRepo repo.EmployeeRepository
+	Service[models.Employee, uint]
+	employeeRepo    repo.EmployeeRepository
+	departmentRepo  repo.DepartmentRepository
+	positionRepo    repo.PositionRepository
+	tenantRepo      repo.TenantRepository
+	customFieldRepo repo.CustomFieldRepository
+	unitOfWork      repo.UnitOfWork
+	bulkMaxItems    int
+	bulkBatchSize   int
+	searchIndex     search.EmployeeIndex
+	events          events.Broker
+}
+
+// EmployeeServiceOption configures optional EmployeeService behaviour
+type EmployeeServiceOption func(*EmployeeServiceImpl)
+
+// WithBulkLimits sets the most items a bulk request may contain and how many
+// rows a bulk create inserts per statement. Non-positive values keep the defaults.
+func WithBulkLimits(maxItems, batchSize int) EmployeeServiceOption {
+	return func(s *EmployeeServiceImpl) {
+		if maxItems > 0 {
+			s.bulkMaxItems = maxItems
+		}
+		if batchSize > 0 {
+			s.bulkBatchSize = batchSize
+		}
+	}
+}
+
+// WithTenantSettings applies the settings of the tenant each request runs
+// for, such as its default currency, read from tenantRepo
+func WithTenantSettings(tenantRepo repo.TenantRepository) EmployeeServiceOption {
+	return func(s *EmployeeServiceImpl) {
+		s.tenantRepo = tenantRepo
+	}
+}
+
+// WithUnitOfWork runs each employee write, with the records that go with it
+// such as salary overrides, in one transaction of unitOfWork
+func WithUnitOfWork(unitOfWork repo.UnitOfWork) EmployeeServiceOption {
+	return func(s *EmployeeServiceImpl) {
+		s.unitOfWork = unitOfWork
+	}
 }
 
 // NewEmployeeService creates a new instance of EmployeeService
-func NewEmployeeService(employeeRepo repo.EmployeeRepository) EmployeeService {
-	return &EmployeeServiceImpl{employeeRepo: employeeRepo}
+func NewEmployeeService(employeeRepo repo.EmployeeRepository, departmentRepo repo.DepartmentRepository, positionRepo repo.PositionRepository, opts ...EmployeeServiceOption) EmployeeService {
+	s := &EmployeeServiceImpl{
+		employeeRepo:   employeeRepo,
+		departmentRepo: departmentRepo,
+		positionRepo:   positionRepo,
+		bulkMaxItems:   DefaultBulkMaxItems,
+		bulkBatchSize:  DefaultBulkBatchSize,
+		searchIndex:    search.NewMemoryIndex(),
+		events:         events.NewBroker(events.DefaultRetainedEvents),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	var serviceOpts []ServiceOption
+	if s.unitOfWork != nil {
+		serviceOpts = append(serviceOpts, Transactional(s.unitOfWork))
+	}
+	s.Service = NewService(employeeRepo, Hooks[models.Employee, uint]{
+		BeforeCreate: s.beforeCreate,
+		BeforeUpdate: s.beforeUpdate,
+		AfterCommit:  s.afterCommit,
+		AfterDelete:  s.afterDelete,
+	}, serviceOpts...)
+	return s
+}
+
+// GetAllEmployees returns one page of employees matching the filter and the total match count
+func (s *EmployeeServiceImpl) GetAllEmployees(ctx context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
+	filter.Normalize()
+	if err := s.parseCustomFieldFilters(ctx, &filter); err != nil {
+		return nil, 0, err
+	}
+	return s.employeeRepo.FindAll(ctx, filter)
+}
+
+// ExportEmployees passes every employee matching the filter to fn in batches,
+// so large exports never hold the full list in memory. The filter selects
+// the same employees as in GetAllEmployees, across every page.
+func (s *EmployeeServiceImpl) ExportEmployees(ctx context.Context, filter models.EmployeeFilter, fn func([]models.Employee) error) error {
+	filter.Normalize()
+	if err := s.parseCustomFieldFilters(ctx, &filter); err != nil {
+		return err
+	}
+	return s.employeeRepo.FindInBatches(ctx, filter, exportBatchSize, fn)
+}
+
+// GetEmployeeByID returns an employee by ID, shaped by the view
+func (s *EmployeeServiceImpl) GetEmployeeByID(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error) {
+	return s.employeeRepo.FindByIDWithView(ctx, id, view)
+}
+
+// beforeCreate validates a new employee before it is saved. Once it has
+// been, its salary override is recorded.
+func (s *EmployeeServiceImpl) beforeCreate(ctx context.Context, employee *models.Employee) (AfterWrite[models.Employee], error) {
+	override, err := s.prepareCreate(ctx, employee)
+	if err != nil {
+		return nil, err
+	}
+	return s.afterWrite(override), nil
+}
+
+// beforeUpdate validates the new state of an existing employee before it is
+// saved, which is then followed up like a create
+func (s *EmployeeServiceImpl) beforeUpdate(ctx context.Context, id uint, employee *models.Employee) (AfterWrite[models.Employee], error) {
+	if err := s.validateStatusUnchanged(ctx, id, employee.Status); err != nil {
+		return nil, err
+	}
+	override, err := s.prepareUpdate(ctx, id, employee)
+	if err != nil {
+		return nil, err
+	}
+	return s.afterWrite(override), nil
+}
+
+// afterWrite records the salary override a saved employee was saved with
+func (s *EmployeeServiceImpl) afterWrite(override *models.SalaryBandOverride) AfterWrite[models.Employee] {
+	return func(ctx context.Context, saved models.Employee) error {
+		return s.recordSalaryOverride(ctx, saved, override)
+	}
+}
+
+// afterCommit indexes a saved employee for search
+func (s *EmployeeServiceImpl) afterCommit(_ context.Context, saved models.Employee) {
+	s.indexEmployees(saved)
+}
+
+// afterDelete removes a deleted employee from the search index
+func (s *EmployeeServiceImpl) afterDelete(_ context.Context, id uint) {
+	s.unindexEmployees(id)
+}
+
+// GetDirectReports returns the employees who report directly to the given manager
+func (s *EmployeeServiceImpl) GetDirectReports(ctx context.Context, id uint) ([]models.Employee, error) {
+	if _, err := s.employeeRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.employeeRepo.FindDirectReports(ctx, id)
+}
+
+// GetReportingChain returns the employee's managers, from their direct manager up to the top
+func (s *EmployeeServiceImpl) GetReportingChain(ctx context.Context, id uint) ([]models.Employee, error) {
+	if _, err := s.employeeRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.employeeRepo.FindReportingChain(ctx, id)
 }
 
-// GetAllEmployees returns all employees
-func (s *EmployeeServiceImpl) GetAllEmployees() ([]models.Employee, error) {
-	return s.employeeRepo.FindAll()
+// GetSubordinates returns everyone below the given manager, at any depth
+func (s *EmployeeServiceImpl) GetSubordinates(ctx context.Context, id uint) ([]models.Employee, error) {
+	if _, err := s.employeeRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.employeeRepo.FindSubordinates(ctx, id)
 }
 
-// GetEmployeeByID returns an employee by ID
-func (s *EmployeeServiceImpl) GetEmployeeByID(id uint) (models.Employee, error) {
-	return s.employeeRepo.FindByID(id)
+// GetOrgChart returns the reporting tree below rootID, or the whole organisation when rootID is nil
+func (s *EmployeeServiceImpl) GetOrgChart(ctx context.Context, rootID *uint) ([]models.OrgChartNode, error) {
+	var roots []models.Employee
+	if rootID != nil {
+		root, err := s.employeeRepo.FindByID(ctx, *rootID)
+		if err != nil {
+			return nil, err
+		}
+		roots = []models.Employee{root}
+	} else {
+		var err error
+		if roots, err = s.employeeRepo.FindTopLevel(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	chart := make([]models.OrgChartNode, 0, len(roots))
+	for _, root := range roots {
+		subordinates, err := s.employeeRepo.FindSubordinates(ctx, root.ID)
+		if err != nil {
+			return nil, err
+		}
+		chart = append(chart, buildOrgChartNode(root, subordinates))
+	}
+	return chart, nil
+}
+
+// buildOrgChartNode assembles the tree below root from its flat list of subordinates
+func buildOrgChartNode(root models.Employee, subordinates []models.Employee) models.OrgChartNode {
+	reportsByManager := make(map[uint][]models.Employee)
+	for _, employee := range subordinates {
+		if employee.ManagerID != nil {
+			reportsByManager[*employee.ManagerID] = append(reportsByManager[*employee.ManagerID], employee)
+		}
+	}
+
+	var build func(employee models.Employee) models.OrgChartNode
+	build = func(employee models.Employee) models.OrgChartNode {
+		node := models.OrgChartNode{ID: employee.ID, Name: employee.Name, Position: employee.Position, Reports: []models.OrgChartNode{}}
+		for _, report := range reportsByManager[employee.ID] {
+			node.Reports = append(node.Reports, build(report))
+		}
+		return node
+	}
+	return build(root)
+}
+
+// GetSalaryOverrides returns the recorded out-of-band salary approvals for an employee
+func (s *EmployeeServiceImpl) GetSalaryOverrides(ctx context.Context, id uint) ([]models.SalaryBandOverride, error) {
+	if _, err := s.employeeRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.positionRepo.FindSalaryOverrides(ctx, id)
+}
+
+// prepareCreate validates a new employee and fills in derived fields. It
+// returns the salary override to record once the employee has been saved.
+func (s *EmployeeServiceImpl) prepareCreate(ctx context.Context, employee *models.Employee) (*models.SalaryBandOverride, error) {
+	// Expanded relations are read-only and never taken from a request
+	employee.Department, employee.Manager, employee.Tags = nil, nil, nil
+	switch employee.Status {
+	case "":
+		employee.Status = models.StatusActive
+	case models.StatusOnboarding, models.StatusActive:
+	default:
+		return nil, fmt.Errorf("%w: new employees start as %s or %s", models.ErrValidation, models.StatusOnboarding, models.StatusActive)
+	}
+	if err := s.validateDepartment(ctx, employee.DepartmentID); err != nil {
+		return nil, err
+	}
+	if err := s.validateManager(ctx, 0, employee.ManagerID); err != nil {
+		return nil, err
+	}
+	if err := s.validateCustomFields(ctx, employee, true); err != nil {
+		return nil, err
+	}
+	return s.applyPosition(ctx, employee)
+}
+
+// prepareUpdate validates the new state of an existing employee and fills in
+// derived fields. It returns the salary override to record once saved.
+func (s *EmployeeServiceImpl) prepareUpdate(ctx context.Context, id uint, employee *models.Employee) (*models.SalaryBandOverride, error) {
+	employee.Department, employee.Manager = nil, nil
+	if err := s.validateDepartment(ctx, employee.DepartmentID); err != nil {
+		return nil, err
+	}
+	if err := s.validateManager(ctx, id, employee.ManagerID); err != nil {
+		return nil, err
+	}
+	if err := s.validateCustomFields(ctx, employee, false); err != nil {
+		return nil, err
+	}
+	return s.applyPosition(ctx, employee)
+}
+
+// TransitionEmployee moves an employee to a new lifecycle status. Terminations
+// need an effective date and a reason, leave needs a reason; other transitions
+// take effect immediately unless a date is given. The status only changes
+// from the one it was checked against, so of concurrent transitions from one
+// status a single one applies and the others fail with ErrConflict.
+func (s *EmployeeServiceImpl) TransitionEmployee(ctx context.Context, id uint, status models.EmployeeStatus, request models.StatusTransitionRequest) (models.Employee, error) {
+	var transitioned models.Employee
+	err := s.transaction(ctx, func(ctx context.Context) error {
+		var err error
+		transitioned, err = s.transitionEmployee(ctx, id, status, request)
+		return err
+	})
+	return transitioned, err
+}
+
+// transitionEmployee validates and records a lifecycle transition
+func (s *EmployeeServiceImpl) transitionEmployee(ctx context.Context, id uint, status models.EmployeeStatus, request models.StatusTransitionRequest) (models.Employee, error) {
+	employee, err := s.employeeRepo.FindByID(ctx, id)
+	if err != nil {
+		return employee, err
+	}
+	if !employee.Status.CanTransitionTo(status) {
+		return models.Employee{}, fmt.Errorf("%w: cannot move employee %d from %s to %s", models.ErrInvalidTransition, id, employee.Status, status)
+	}
+
+	switch status {
+	case models.StatusTerminated:
+		if request.EffectiveDate == nil || request.Reason == "" {
+			return models.Employee{}, fmt.Errorf("%w: termination requires an effective_date and a reason", models.ErrValidation)
+		}
+		employee.TerminationDate = request.EffectiveDate
+		employee.TerminationReason = request.Reason
+	case models.StatusOnLeave:
+		if request.Reason == "" {
+			return models.Employee{}, fmt.Errorf("%w: leave requires a reason", models.ErrValidation)
+		}
+	}
+
+	effectiveDate := time.Now()
+	if request.EffectiveDate != nil {
+		effectiveDate = *request.EffectiveDate
+	}
+	transition := models.EmployeeStatusTransition{
+		FromStatus:    employee.Status,
+		ToStatus:      status,
+		Reason:        request.Reason,
+		EffectiveDate: effectiveDate,
+		ChangedBy:     request.ChangedBy,
+	}
+	employee.Status = status
+	return s.employeeRepo.UpdateStatus(ctx, employee, transition)
+}
+
+// GetStatusHistory returns an employee's lifecycle transitions, oldest first
+func (s *EmployeeServiceImpl) GetStatusHistory(ctx context.Context, id uint) ([]models.EmployeeStatusTransition, error) {
+	if _, err := s.employeeRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.employeeRepo.FindStatusHistory(ctx, id)
+}
+
+// validateStatusUnchanged rejects updates that try to change the status
+// directly instead of going through TransitionEmployee
+func (s *EmployeeServiceImpl) validateStatusUnchanged(ctx context.Context, id uint, status models.EmployeeStatus) error {
+	if status == "" {
+		return nil
+	}
+	current, err := s.employeeRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.Status != status {
+		return fmt.Errorf("%w: status changes go through the lifecycle transition endpoints", models.ErrValidation)
+	}
+	return nil
 }
 
-// CreateEmployee creates a new employee
-func (s *EmployeeServiceImpl) CreateEmployee(employee models.Employee) (models.Employee, error) {
-	return s.employeeRepo.Create(employee)
+// applyPosition links the employee to their catalog position and checks the
+// salary against the position's band for the employee's currency, which
+// defaults to the tenant's. Salaries outside the band are flagged; strict
+// positions, and every position of tenants with strict salary bands, reject
+// them unless the request carries an override, which is returned so it can
+// be recorded once the employee has been saved.
+func (s *EmployeeServiceImpl) applyPosition(ctx context.Context, employee *models.Employee) (*models.SalaryBandOverride, error) {
+	settings, err := s.tenantSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	override := employee.SalaryOverride
+	employee.SalaryOverride = nil
+	employee.SalaryOutOfBand = false
+	employee.Currency = strings.ToUpper(employee.Currency)
+	if employee.Currency == "" {
+		employee.Currency = settings.SalaryCurrency()
+	}
+	if employee.PositionID == nil {
+		return nil, nil
+	}
+
+	position, err := s.positionRepo.FindByID(ctx, *employee.PositionID)
+	if err != nil {
+		if errors.Is(err, models.ErrPositionNotFound) {
+			return nil, fmt.Errorf("%w: position %d does not exist", models.ErrValidation, *employee.PositionID)
+		}
+		return nil, err
+	}
+	employee.Position = position.Title
+
+	band, ok := position.BandFor(employee.Currency)
+	if !ok {
+		if len(position.SalaryBands) > 0 {
+			return nil, fmt.Errorf("%w: position %q has no salary band in %s", models.ErrValidation, position.Title, employee.Currency)
+		}
+		return nil, nil
+	}
+	if band.Contains(employee.Salary) {
+		return nil, nil
+	}
+
+	employee.SalaryOutOfBand = true
+	if override == nil {
+		if position.StrictBand || settings.StrictSalaryBands {
+			return nil, fmt.Errorf("%w: salary %.2f %s is outside the band %.2f-%.2f for position %q", models.ErrValidation,
+				employee.Salary, employee.Currency, band.MinSalary, band.MaxSalary, position.Title)
+		}
+		return nil, nil
+	}
+	return &models.SalaryBandOverride{
+		PositionID:    position.ID,
+		Salary:        employee.Salary,
+		Currency:      employee.Currency,
+		MinSalary:     band.MinSalary,
+		MaxSalary:     band.MaxSalary,
+		Justification: override.Justification,
+		ApprovedBy:    override.ApprovedBy,
+	}, nil
 }
 
-// UpdateEmployee updates an existing employee
-func (s *EmployeeServiceImpl) UpdateEmployee(id uint, employee models.Employee) (models.Employee, error) {
-	return s.employeeRepo.Update(id, employee)
+// tenantSettings returns the tenant the context runs for, or the defaults
+// when the service was not given the tenants
+func (s *EmployeeServiceImpl) tenantSettings(ctx context.Context) (models.Tenant, error) {
+	if s.tenantRepo == nil {
+		return models.Tenant{}, nil
+	}
+	id, ok := tenant.FromContext(ctx)
+	if !ok {
+		return models.Tenant{}, tenant.ErrNoTenant
+	}
+	return s.tenantRepo.FindByID(ctx, id)
+}
+
+// transaction runs fn in one transaction when the service has a unit of work
+func (s *EmployeeServiceImpl) transaction(ctx context.Context, fn func(context.Context) error) error {
+	if s.unitOfWork == nil {
+		return fn(ctx)
+	}
+	return s.unitOfWork.Do(ctx, fn)
+}
+
+// recordSalaryOverride stores the justification for an accepted out-of-band salary
+func (s *EmployeeServiceImpl) recordSalaryOverride(ctx context.Context, employee models.Employee, override *models.SalaryBandOverride) error {
+	if override == nil {
+		return nil
+	}
+	override.EmployeeID = employee.ID
+	_, err := s.positionRepo.CreateSalaryOverride(ctx, *override)
+	return err
+}
+
+// validateManager checks that an assigned manager exists and that the
+// assignment would not create a reporting cycle. id is 0 for new employees.
+func (s *EmployeeServiceImpl) validateManager(ctx context.Context, id uint, managerID *uint) error {
+	if managerID == nil {
+		return nil
+	}
+	if *managerID == id {
+		return fmt.Errorf("%w: an employee cannot be their own manager", models.ErrValidation)
+	}
+	if _, err := s.employeeRepo.FindByID(ctx, *managerID); err != nil {
+		if errors.Is(err, models.ErrEmployeeNotFound) {
+			return fmt.Errorf("%w: manager %d does not exist", models.ErrValidation, *managerID)
+		}
+		return err
+	}
+	if id == 0 {
+		return nil
+	}
+
+	chain, err := s.employeeRepo.FindReportingChain(ctx, *managerID)
+	if err != nil {
+		return err
+	}
+	for _, manager := range chain {
+		if manager.ID == id {
+			return fmt.Errorf("%w: employee %d already reports to employee %d, which would create a reporting cycle", models.ErrValidation, *managerID, id)
+		}
+	}
+	return nil
 }
 
-// DeleteEmployee deletes an employee by ID
-func (s *EmployeeServiceImpl) DeleteEmployee(id uint) error {
-	return s.employeeRepo.Delete(id)
+// validateDepartment checks that an assigned department exists
+func (s *EmployeeServiceImpl) validateDepartment(ctx context.Context, departmentID *uint) error {
+	if departmentID == nil {
+		return nil
+	}
+	if _, err := s.departmentRepo.FindByID(ctx, *departmentID); err != nil {
+		if errors.Is(err, models.ErrDepartmentNotFound) {
+			return fmt.Errorf("%w: department %d does not exist", models.ErrValidation, *departmentID)
+		}
+		return err
+	}
+	return nil
 }
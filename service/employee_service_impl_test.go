@@ -1,26 +1,36 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/chinmay-sawant/gin-example/models"
 	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/chinmay-sawant/gin-example/tenant"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
 
+// testCtx is the context service calls are made with in tests
+var testCtx = tenant.NewContext(context.Background(), "acme")
+
 type EmployeeServiceTestSuite struct {
 	suite.Suite
-	ctrl *gomock.Controller
-	repo *mocks.MockEmployeeRepository
-	svc  EmployeeService
+	ctrl     *gomock.Controller
+	repo     *mocks.MockEmployeeRepository
+	deptRepo *mocks.MockDepartmentRepository
+	posRepo  *mocks.MockPositionRepository
+	svc      EmployeeService
 }
 
 func (suite *EmployeeServiceTestSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 	suite.repo = mocks.NewMockEmployeeRepository(suite.ctrl)
-	suite.svc = NewEmployeeService(suite.repo)
+	suite.deptRepo = mocks.NewMockDepartmentRepository(suite.ctrl)
+	suite.posRepo = mocks.NewMockPositionRepository(suite.ctrl)
+	suite.svc = NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo)
 }
 
 func (suite *EmployeeServiceTestSuite) TearDownTest() {
@@ -36,53 +46,296 @@ func (suite *EmployeeServiceTestSuite) TestGetAllEmployees() {
 		{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000},
 		{ID: 2, Name: "Bob", Email: "bob@example.com", Position: "QA", Salary: 40000},
 	}
-	suite.repo.EXPECT().FindAll().Return(employees, nil)
+	expectedFilter := models.EmployeeFilter{Pagination: models.Pagination{Page: 1, PageSize: models.DefaultPageSize}}
+	suite.repo.EXPECT().FindAll(gomock.Any(), expectedFilter).Return(employees, int64(2), nil)
 
-	result, err := suite.svc.GetAllEmployees()
+	result, total, err := suite.svc.GetAllEmployees(testCtx, models.EmployeeFilter{})
 	suite.NoError(err)
 	suite.Equal(employees, result)
+	suite.Equal(int64(2), total)
 }
 
 func (suite *EmployeeServiceTestSuite) TestGetEmployeeByID() {
 	employee := models.Employee{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000}
-	suite.repo.EXPECT().FindByID(uint(1)).Return(employee, nil)
+	view := models.EmployeeView{Fields: []string{"id", "name"}}
+	suite.repo.EXPECT().FindByIDWithView(gomock.Any(), uint(1), view).Return(employee, nil)
 
-	result, err := suite.svc.GetEmployeeByID(1)
+	result, err := suite.svc.GetEmployeeByID(testCtx, 1, view)
 	suite.NoError(err)
 	suite.Equal(employee, result)
 
-	suite.repo.EXPECT().FindByID(uint(2)).Return(models.Employee{}, errors.New("not found"))
-	_, err = suite.svc.GetEmployeeByID(2)
+	suite.repo.EXPECT().FindByIDWithView(gomock.Any(), uint(2), models.EmployeeView{}).Return(models.Employee{}, errors.New("not found"))
+	_, err = suite.svc.GetEmployeeByID(testCtx, 2, models.EmployeeView{})
 	suite.Error(err)
 }
 
 func (suite *EmployeeServiceTestSuite) TestCreateEmployee() {
-	employee := models.Employee{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000}
+	employee := models.Employee{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000, Currency: "USD", Status: models.StatusActive}
 	created := employee
 	created.ID = 1
-	suite.repo.EXPECT().Create(employee).Return(created, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), employee).Return(created, nil)
 
-	result, err := suite.svc.CreateEmployee(employee)
+	result, err := suite.svc.Create(testCtx, employee)
 	suite.NoError(err)
 	suite.Equal(created, result)
 }
 
+func (suite *EmployeeServiceTestSuite) TestCreateEmployeeWithDepartment() {
+	departmentID := uint(3)
+	employee := models.Employee{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000, Currency: "USD", Status: models.StatusActive, DepartmentID: &departmentID}
+	suite.deptRepo.EXPECT().FindByID(gomock.Any(), departmentID).Return(models.Department{ID: departmentID, Name: "Engineering"}, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), employee).Return(employee, nil)
+
+	_, err := suite.svc.Create(testCtx, employee)
+	suite.NoError(err)
+
+	suite.deptRepo.EXPECT().FindByID(gomock.Any(), departmentID).Return(models.Department{}, models.ErrDepartmentNotFound)
+	_, err = suite.svc.Create(testCtx, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
 func (suite *EmployeeServiceTestSuite) TestUpdateEmployee() {
-	updated := models.Employee{ID: 1, Name: "Updated", Email: "updated@example.com", Position: "Lead", Salary: 80000}
-	suite.repo.EXPECT().Update(uint(1), updated).Return(updated, nil)
+	updated := models.Employee{ID: 1, Name: "Updated", Email: "updated@example.com", Position: "Lead", Salary: 80000, Currency: "USD"}
+	suite.repo.EXPECT().Update(gomock.Any(), uint(1), updated).Return(updated, nil)
 
-	result, err := suite.svc.UpdateEmployee(1, updated)
+	result, err := suite.svc.Update(testCtx, 1, updated)
 	suite.NoError(err)
 	suite.Equal(updated, result)
 }
 
 func (suite *EmployeeServiceTestSuite) TestDeleteEmployee() {
-	suite.repo.EXPECT().Delete(uint(1)).Return(nil)
+	suite.repo.EXPECT().Delete(gomock.Any(), uint(1)).Return(nil)
+
+	err := suite.svc.Delete(testCtx, 1)
+	suite.NoError(err)
+
+	suite.repo.EXPECT().Delete(gomock.Any(), uint(2)).Return(errors.New("not found"))
+	err = suite.svc.Delete(testCtx, 2)
+	suite.Error(err)
+}
+
+func (suite *EmployeeServiceTestSuite) TestUpdateEmployeeManagerCycle() {
+	managerID := uint(4)
+	employee := models.Employee{Name: "Charlie", Email: "charlie@example.com", Position: "Manager", Salary: 90000, ManagerID: &managerID}
+	suite.repo.EXPECT().FindByID(gomock.Any(), managerID).Return(models.Employee{ID: managerID}, nil)
+	suite.repo.EXPECT().FindReportingChain(gomock.Any(), managerID).Return([]models.Employee{{ID: 1}, {ID: 3}}, nil)
+
+	_, err := suite.svc.Update(testCtx, 3, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+	suite.Contains(err.Error(), "reporting cycle")
+
+	self := uint(3)
+	employee.ManagerID = &self
+	_, err = suite.svc.Update(testCtx, 3, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestCreateEmployeeUnknownManager() {
+	managerID := uint(42)
+	employee := models.Employee{Name: "John", Email: "john@example.com", Position: "Dev", Salary: 60000, ManagerID: &managerID}
+	suite.repo.EXPECT().FindByID(gomock.Any(), managerID).Return(models.Employee{}, models.ErrEmployeeNotFound)
+
+	_, err := suite.svc.Create(testCtx, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestGetReportingChain() {
+	chain := []models.Employee{{ID: 1, Name: "Alice"}, {ID: 3, Name: "Charlie"}}
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(4)).Return(models.Employee{ID: 4}, nil)
+	suite.repo.EXPECT().FindReportingChain(gomock.Any(), uint(4)).Return(chain, nil)
+
+	result, err := suite.svc.GetReportingChain(testCtx, 4)
+	suite.NoError(err)
+	suite.Equal(chain, result)
+
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(9)).Return(models.Employee{}, models.ErrEmployeeNotFound)
+	_, err = suite.svc.GetReportingChain(testCtx, 9)
+	suite.ErrorIs(err, models.ErrEmployeeNotFound)
+}
+
+func (suite *EmployeeServiceTestSuite) TestGetOrgChart() {
+	charlie, alice := uint(3), uint(1)
+	suite.repo.EXPECT().FindTopLevel(gomock.Any()).Return([]models.Employee{{ID: charlie, Name: "Charlie", Position: "Manager"}}, nil)
+	suite.repo.EXPECT().FindSubordinates(gomock.Any(), charlie).Return([]models.Employee{
+		{ID: alice, Name: "Alice", Position: "Dev", ManagerID: &charlie},
+		{ID: 2, Name: "Bob", Position: "Designer", ManagerID: &charlie},
+		{ID: 4, Name: "Diana", Position: "QA", ManagerID: &alice},
+	}, nil)
+
+	chart, err := suite.svc.GetOrgChart(testCtx, nil)
+	suite.NoError(err)
+	suite.Len(chart, 1)
+	suite.Equal("Charlie", chart[0].Name)
+	suite.Len(chart[0].Reports, 2)
+	suite.Equal("Alice", chart[0].Reports[0].Name)
+	suite.Equal("Diana", chart[0].Reports[0].Reports[0].Name)
+	suite.Empty(chart[0].Reports[1].Reports)
+}
+
+func (suite *EmployeeServiceTestSuite) TestCreateEmployeeSalaryBand() {
+	positionID := uint(7)
+	position := models.Position{ID: positionID, Title: "Manager", StrictBand: true, SalaryBands: []models.SalaryBand{
+		{Currency: "USD", MinSalary: 80000, MaxSalary: 120000},
+	}}
+	suite.posRepo.EXPECT().FindByID(gomock.Any(), positionID).Return(position, nil).AnyTimes()
+
+	// Inside the band the catalog title replaces the free-text position
+	employee := models.Employee{Name: "Ann", Email: "ann@example.com", Position: "mgr", PositionID: &positionID, Salary: 90000, Currency: "usd"}
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.Equal("Manager", e.Position)
+		suite.Equal("USD", e.Currency)
+		suite.False(e.SalaryOutOfBand)
+		e.ID = 1
+		return e, nil
+	})
+	_, err := suite.svc.Create(testCtx, employee)
+	suite.NoError(err)
 
-	err := suite.svc.DeleteEmployee(1)
+	// Strict bands reject out-of-band salaries without an override
+	employee.Salary = 150000
+	_, err = suite.svc.Create(testCtx, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+
+	// An override is accepted, flagged and its justification recorded
+	employee.SalaryOverride = &models.SalaryOverride{Justification: "Competing offer", ApprovedBy: "hr-lead"}
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.True(e.SalaryOutOfBand)
+		suite.Nil(e.SalaryOverride)
+		e.ID = 2
+		return e, nil
+	})
+	suite.posRepo.EXPECT().CreateSalaryOverride(gomock.Any(), models.SalaryBandOverride{
+		EmployeeID: 2, PositionID: positionID, Salary: 150000, Currency: "USD",
+		MinSalary: 80000, MaxSalary: 120000, Justification: "Competing offer", ApprovedBy: "hr-lead",
+	}).Return(models.SalaryBandOverride{ID: 1}, nil)
+	created, err := suite.svc.Create(testCtx, employee)
+	suite.NoError(err)
+	suite.True(created.SalaryOutOfBand)
+
+	// Positions without a band in the employee's currency are rejected
+	employee.SalaryOverride = nil
+	employee.Currency = "EUR"
+	_, err = suite.svc.Create(testCtx, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestCreateEmployeeSalaryBandFlagOnly() {
+	positionID := uint(8)
+	position := models.Position{ID: positionID, Title: "Developer", SalaryBands: []models.SalaryBand{
+		{Currency: "USD", MinSalary: 60000, MaxSalary: 90000},
+	}}
+	suite.posRepo.EXPECT().FindByID(gomock.Any(), positionID).Return(position, nil)
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		return e, nil
+	})
+
+	created, err := suite.svc.Create(testCtx, models.Employee{Name: "Dev", Email: "dev@example.com", PositionID: &positionID, Salary: 40000})
+	suite.NoError(err)
+	suite.True(created.SalaryOutOfBand)
+}
+
+func (suite *EmployeeServiceTestSuite) TestCreateEmployeeStatus() {
+	employee := models.Employee{Name: "New", Email: "new@example.com", Position: "Dev", Salary: 1, Status: models.StatusOnboarding}
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.Equal(models.StatusOnboarding, e.Status)
+		return e, nil
+	})
+	_, err := suite.svc.Create(testCtx, employee)
+	suite.NoError(err)
+
+	employee.Status = models.StatusTerminated
+	_, err = suite.svc.Create(testCtx, employee)
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestUpdateEmployeeRejectsStatusChange() {
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{ID: 1, Status: models.StatusActive}, nil)
+
+	_, err := suite.svc.Update(testCtx, 1, models.Employee{Name: "A", Status: models.StatusTerminated})
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestTerminateEmployee() {
+	when := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{ID: 1, Status: models.StatusActive}, nil).Times(2)
+
+	// Termination needs a date and a reason
+	_, err := suite.svc.TransitionEmployee(testCtx, 1, models.StatusTerminated, models.StatusTransitionRequest{Reason: "Resigned"})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	suite.repo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), models.EmployeeStatusTransition{
+		FromStatus: models.StatusActive, ToStatus: models.StatusTerminated,
+		Reason: "Resigned", EffectiveDate: when, ChangedBy: "hr-lead",
+	}).DoAndReturn(func(_ context.Context, e models.Employee, _ models.EmployeeStatusTransition) (models.Employee, error) {
+		return e, nil
+	})
+	result, err := suite.svc.TransitionEmployee(testCtx, 1, models.StatusTerminated, models.StatusTransitionRequest{
+		EffectiveDate: &when, Reason: "Resigned", ChangedBy: "hr-lead",
+	})
 	suite.NoError(err)
+	suite.Equal(models.StatusTerminated, result.Status)
+	suite.Equal(&when, result.TerminationDate)
+	suite.Equal("Resigned", result.TerminationReason)
+}
+
+func (suite *EmployeeServiceTestSuite) TestTransitionEmployeeNotAllowed() {
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{ID: 1, Status: models.StatusTerminated}, nil)
+	_, err := suite.svc.TransitionEmployee(testCtx, 1, models.StatusActive, models.StatusTransitionRequest{})
+	suite.ErrorIs(err, models.ErrInvalidTransition)
+
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(models.Employee{ID: 2, Status: models.StatusOnboarding}, nil)
+	_, err = suite.svc.TransitionEmployee(testCtx, 2, models.StatusOnLeave, models.StatusTransitionRequest{Reason: "Holiday"})
+	suite.ErrorIs(err, models.ErrInvalidTransition)
+}
+
+func (suite *EmployeeServiceTestSuite) TestUnitOfWorkSpansRelatedWrites() {
+	type txMarker struct{}
+	inTx := gomock.Cond(func(x any) bool { return x.(context.Context).Value(txMarker{}) != nil })
+	unitOfWork := mocks.NewMockUnitOfWork(suite.ctrl)
+	unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(context.WithValue(ctx, txMarker{}, true))
+		})
+	svc := NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo, WithUnitOfWork(unitOfWork))
 
-	suite.repo.EXPECT().Delete(uint(2)).Return(errors.New("not found"))
-	err = suite.svc.DeleteEmployee(2)
+	// The employee and its salary override are written together, and an
+	// employee whose override could not be recorded is never indexed
+	positionID := uint(7)
+	suite.posRepo.EXPECT().FindByID(inTx, positionID).Return(models.Position{ID: positionID, Title: "Manager", StrictBand: true,
+		SalaryBands: []models.SalaryBand{{Currency: "USD", MinSalary: 80000, MaxSalary: 120000}}}, nil)
+	suite.repo.EXPECT().Create(inTx, gomock.Any()).Return(models.Employee{ID: 2, Name: "Ann Override"}, nil)
+	suite.posRepo.EXPECT().CreateSalaryOverride(inTx, gomock.Any()).Return(models.SalaryBandOverride{}, errors.New("db down"))
+	_, err := svc.Create(testCtx, models.Employee{Name: "Ann Override", Email: "ann@example.com", PositionID: &positionID,
+		Salary: 150000, Currency: "USD", SalaryOverride: &models.SalaryOverride{Justification: "Competing offer", ApprovedBy: "hr-lead"}})
 	suite.Error(err)
+	suite.repo.EXPECT().FindByIDs(gomock.Any(), gomock.Len(0)).Return(nil, nil)
+	hits, err := svc.SearchEmployees(testCtx, "override", 10)
+	suite.NoError(err)
+	suite.Empty(hits)
+
+	// Transitions check and change the status in the same transaction
+	suite.repo.EXPECT().FindByID(inTx, uint(1)).Return(models.Employee{ID: 1, Status: models.StatusActive}, nil)
+	suite.repo.EXPECT().UpdateStatus(inTx, gomock.Any(), gomock.Any()).Return(models.Employee{ID: 1, Status: models.StatusOnLeave}, nil)
+	updated, err := svc.TransitionEmployee(testCtx, 1, models.StatusOnLeave, models.StatusTransitionRequest{Reason: "Sabbatical"})
+	suite.NoError(err)
+	suite.Equal(models.StatusOnLeave, updated.Status)
+}
+
+func (suite *EmployeeServiceTestSuite) TestExportEmployees() {
+	filter := models.EmployeeFilter{Status: string(models.StatusActive)}
+	normalized := filter
+	normalized.Normalize()
+	batch := []models.Employee{{ID: 1, Name: "Alice"}}
+	suite.repo.EXPECT().FindInBatches(gomock.Any(), normalized, exportBatchSize, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ models.EmployeeFilter, _ int, fn func([]models.Employee) error) error {
+			return fn(batch)
+		})
+
+	var exported []models.Employee
+	err := suite.svc.ExportEmployees(testCtx, filter, func(employees []models.Employee) error {
+		exported = append(exported, employees...)
+		return nil
+	})
+	suite.NoError(err)
+	suite.Equal(batch, exported)
 }
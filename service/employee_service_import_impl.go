@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// ImportEmployees validates imported rows and upserts them by email. Every row
+// goes through the same binding rules and checks as CreateEmployee or
+// UpdateEmployee, and an email may appear only once per import. Nothing is
+// written on a dry run or when any row fails; otherwise all rows are saved in
+// one transaction.
+func (s *EmployeeServiceImpl) ImportEmployees(ctx context.Context, rows []models.ImportRow, dryRun bool) (models.ImportReport, error) {
+	report := models.ImportReport{DryRun: dryRun}
+	if err := s.checkBulkSize(len(rows)); err != nil {
+		return report, err
+	}
+
+	var emails []string
+	for _, row := range rows {
+		if row.Patch.Email != nil {
+			emails = append(emails, *row.Patch.Email)
+		}
+	}
+	found, err := s.employeeRepo.FindByEmails(ctx, emails)
+	if err != nil {
+		return report, err
+	}
+	existing := make(map[string][]models.Employee, len(found))
+	for _, employee := range found {
+		existing[employee.Email] = append(existing[employee.Email], employee)
+	}
+
+	report.Rows = make([]models.ImportRowResult, len(rows))
+	prepared := make([]models.Employee, 0, len(rows))
+	indices := make([]int, 0, len(rows))
+	seen := make(map[string]int, len(rows))
+	for i, row := range rows {
+		result := &report.Rows[i]
+		result.Line = row.Line
+		employee, err := s.prepareImportRow(ctx, row, existing, seen, result)
+		if err != nil {
+			result.Error = err.Error()
+			report.Failed++
+			continue
+		}
+		if result.Action == models.ImportCreate {
+			report.Created++
+		} else {
+			report.Updated++
+		}
+		prepared = append(prepared, employee)
+		indices = append(indices, i)
+	}
+	if dryRun || report.Failed > 0 {
+		return report, nil
+	}
+
+	saved, err := s.employeeRepo.UpsertBatch(ctx, prepared)
+	if err != nil {
+		return report, err
+	}
+	for j, i := range indices {
+		report.Rows[i].ID = saved[j].ID
+	}
+	s.indexEmployees(saved...)
+	report.Committed = true
+	return report, nil
+}
+
+// prepareImportRow turns one row into the employee to save, filling in the
+// row's email and action as soon as they are known
+func (s *EmployeeServiceImpl) prepareImportRow(ctx context.Context, row models.ImportRow, existing map[string][]models.Employee, seen map[string]int, result *models.ImportRowResult) (models.Employee, error) {
+	if row.Err != nil {
+		return models.Employee{}, row.Err
+	}
+	if row.Patch.Email == nil {
+		return models.Employee{}, fmt.Errorf("%w: email is required to match the row", models.ErrValidation)
+	}
+	email := *row.Patch.Email
+	result.Email = email
+	if line, ok := seen[email]; ok {
+		return models.Employee{}, fmt.Errorf("%w: email %s is already used on line %d", models.ErrConflict, email, line)
+	}
+	seen[email] = row.Line
+
+	var employee models.Employee
+	switch matches := existing[email]; len(matches) {
+	case 0:
+		result.Action = models.ImportCreate
+		employee.Status = row.Status
+	case 1:
+		result.Action = models.ImportUpdate
+		result.ID = matches[0].ID
+		employee = matches[0]
+		if row.Status != "" && row.Status != employee.Status {
+			return models.Employee{}, fmt.Errorf("%w: status changes go through the lifecycle transition endpoints", models.ErrValidation)
+		}
+	default:
+		return models.Employee{}, fmt.Errorf("%w: %d employees share the email %s", models.ErrConflict, len(matches), email)
+	}
+
+	row.Patch.Apply(&employee)
+	if err := binding.Validator.ValidateStruct(&employee); err != nil {
+		return models.Employee{}, fmt.Errorf("%w: %s", models.ErrValidation, err.Error())
+	}
+	var err error
+	if result.Action == models.ImportCreate {
+		_, err = s.prepareCreate(ctx, &employee)
+	} else {
+		_, err = s.prepareUpdate(ctx, employee.ID, &employee)
+	}
+	return employee, err
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"github.com/chinmay-sawant/gin-example/models"
+	"go.uber.org/mock/gomock"
+)
+
+func importRow(line int, name, email, position string, salary float64) models.ImportRow {
+	row := models.ImportRow{Line: line}
+	row.Patch.Name = &name
+	row.Patch.Email = &email
+	row.Patch.Position = &position
+	row.Patch.Salary = &salary
+	return row
+}
+
+func (suite *EmployeeServiceTestSuite) TestImportEmployeesCommit() {
+	existing := models.Employee{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000, Currency: "USD", Status: models.StatusActive}
+	rows := []models.ImportRow{
+		importRow(2, "Alice Smith", "alice@example.com", "Dev", 55000),
+		importRow(3, "John", "john@example.com", "QA", 40000),
+	}
+	suite.repo.EXPECT().FindByEmails(gomock.Any(), []string{"alice@example.com", "john@example.com"}).Return([]models.Employee{existing}, nil)
+	updated := existing
+	updated.Name, updated.Salary = "Alice Smith", 55000
+	created := models.Employee{Name: "John", Email: "john@example.com", Position: "QA", Salary: 40000, Currency: "USD", Status: models.StatusActive}
+	saved := created
+	saved.ID = 2
+	suite.repo.EXPECT().UpsertBatch(gomock.Any(), []models.Employee{updated, created}).Return([]models.Employee{updated, saved}, nil)
+
+	report, err := suite.svc.ImportEmployees(testCtx, rows, false)
+	suite.NoError(err)
+	suite.True(report.Committed)
+	suite.Equal(1, report.Created)
+	suite.Equal(1, report.Updated)
+	suite.Equal(models.ImportUpdate, report.Rows[0].Action)
+	suite.Equal(uint(2), report.Rows[1].ID)
+}
+
+func (suite *EmployeeServiceTestSuite) TestImportEmployeesDryRunReportsRowErrors() {
+	missingEmail := models.ImportRow{Line: 4}
+	rows := []models.ImportRow{
+		importRow(2, "John", "john@example.com", "QA", 40000),
+		importRow(3, "Johnny", "john@example.com", "QA", 40000),
+		missingEmail,
+		importRow(5, "Jane", "jane@example", "QA", 40000),
+	}
+	suite.repo.EXPECT().FindByEmails(gomock.Any(), []string{"john@example.com", "john@example.com", "jane@example"}).Return(nil, nil)
+
+	report, err := suite.svc.ImportEmployees(testCtx, rows, true)
+	suite.NoError(err)
+	suite.False(report.Committed)
+	suite.Equal(1, report.Created)
+	suite.Equal(3, report.Failed)
+	suite.Empty(report.Rows[0].Error)
+	suite.Contains(report.Rows[1].Error, "line 2")
+	suite.Contains(report.Rows[2].Error, "email is required")
+	suite.Contains(report.Rows[3].Error, "Email")
+}
+
+func (suite *EmployeeServiceTestSuite) TestImportEmployeesRejectsStatusChange() {
+	existing := models.Employee{ID: 1, Name: "Alice", Email: "alice@example.com", Position: "Dev", Salary: 50000, Currency: "USD", Status: models.StatusActive}
+	row := importRow(2, "Alice", "alice@example.com", "Dev", 50000)
+	row.Status = models.StatusTerminated
+	suite.repo.EXPECT().FindByEmails(gomock.Any(), []string{"alice@example.com"}).Return([]models.Employee{existing}, nil)
+
+	report, err := suite.svc.ImportEmployees(testCtx, []models.ImportRow{row}, false)
+	suite.NoError(err)
+	suite.False(report.Committed)
+	suite.Equal(1, report.Failed)
+	suite.Contains(report.Rows[0].Error, "lifecycle transition")
+}
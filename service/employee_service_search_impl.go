@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/search"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+const (
+	// DefaultSearchLimit is how many hits a search returns when no limit is given
+	DefaultSearchLimit = 20
+	// MaxSearchLimit is the most hits a search may return
+	MaxSearchLimit = 100
+	// indexBatchSize is how many employees a rebuild indexes at a time
+	indexBatchSize = 500
+)
+
+// WithSearchIndex sets the index SearchEmployees reads and every write keeps up to date
+func WithSearchIndex(index search.EmployeeIndex) EmployeeServiceOption {
+	return func(s *EmployeeServiceImpl) {
+		if index != nil {
+			s.searchIndex = index
+		}
+	}
+}
+
+// SearchEmployees returns the employees matching the query, most relevant
+// first, with the matched words highlighted
+func (s *EmployeeServiceImpl) SearchEmployees(ctx context.Context, query string, limit int) ([]models.SearchHit, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("%w: a search needs a query", models.ErrValidation)
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		return nil, fmt.Errorf("%w: limit must be at most %d", models.ErrValidation, MaxSearchLimit)
+	}
+
+	tenantID, ok := tenant.FromContext(ctx)
+	if !ok {
+		return nil, tenant.ErrNoTenant
+	}
+	hits, err := s.searchIndex.Search(tenantID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	employees, err := s.employeeRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]models.Employee, len(employees))
+	for _, employee := range employees {
+		byID[employee.ID] = employee
+	}
+
+	results := make([]models.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		// Skip hits the index still holds for employees that are gone
+		employee, ok := byID[hit.ID]
+		if !ok {
+			continue
+		}
+		results = append(results, models.SearchHit{Employee: employee, Score: hit.Score, Highlights: hit.Highlights})
+	}
+	return results, nil
+}
+
+// RebuildSearchIndex indexes every stored employee of every tenant, for
+// starting with an empty in-process index or recovering from a failed index update
+func (s *EmployeeServiceImpl) RebuildSearchIndex(ctx context.Context) error {
+	return s.employeeRepo.FindInBatches(tenant.System(ctx), models.EmployeeFilter{}, indexBatchSize, func(employees []models.Employee) error {
+		return s.searchIndex.Index(employees...)
+	})
+}
+
+// indexEmployees updates the search index after a write. The write has
+// already succeeded, so a failure is logged rather than returned.
+func (s *EmployeeServiceImpl) indexEmployees(employees ...models.Employee) {
+	if len(employees) == 0 {
+		return
+	}
+	if err := s.searchIndex.Index(employees...); err != nil {
+		log.Printf("failed to index %d employees for search: %v", len(employees), err)
+	}
+}
+
+// unindexEmployees removes deleted employees from the search index, logging failures
+func (s *EmployeeServiceImpl) unindexEmployees(ids ...uint) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := s.searchIndex.Remove(ids...); err != nil {
+		log.Printf("failed to remove %d employees from the search index: %v", len(ids), err)
+	}
+}
+
+// indexBulkResults indexes the employees a bulk create or update saved
+func (s *EmployeeServiceImpl) indexBulkResults(results []models.BulkItemResult) {
+	var saved []models.Employee
+	for _, result := range results {
+		if result.Employee != nil {
+			saved = append(saved, *result.Employee)
+		}
+	}
+	s.indexEmployees(saved...)
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/search"
+	"go.uber.org/mock/gomock"
+)
+
+func (suite *EmployeeServiceTestSuite) TestSearchEmployees() {
+	index := search.NewMemoryIndex()
+	suite.NoError(index.Index(
+		models.Employee{ID: 1, TenantID: "acme", Name: "Alice", Email: "alice@example.com", Position: "Engineer"},
+		models.Employee{ID: 2, TenantID: "acme", Name: "Bob Engel", Email: "bob@example.com", Position: "QA"},
+		models.Employee{ID: 3, TenantID: "acme", Name: "Carol", Email: "carol@example.com", Position: "Engineer"},
+	))
+	svc := NewEmployeeService(suite.repo, suite.deptRepo, suite.posRepo, WithSearchIndex(index))
+
+	// Employee 3 has been deleted without the index noticing
+	suite.repo.EXPECT().FindByIDs(gomock.Any(), []uint{2, 1, 3}).Return([]models.Employee{
+		{ID: 1, Name: "Alice"},
+		{ID: 2, Name: "Bob Engel"},
+	}, nil)
+
+	hits, err := svc.SearchEmployees(testCtx, "eng", 0)
+	suite.NoError(err)
+	suite.Len(hits, 2)
+	suite.Equal(uint(2), hits[0].Employee.ID)
+	suite.Equal("Bob <mark>Engel</mark>", hits[0].Highlights.Name)
+	suite.Equal(uint(1), hits[1].Employee.ID)
+	suite.Greater(hits[0].Score, hits[1].Score)
+}
+
+func (suite *EmployeeServiceTestSuite) TestSearchEmployeesValidation() {
+	_, err := suite.svc.SearchEmployees(testCtx, "  ", 0)
+	suite.ErrorIs(err, models.ErrValidation)
+
+	_, err = suite.svc.SearchEmployees(testCtx, "alice", MaxSearchLimit+1)
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *EmployeeServiceTestSuite) TestWritesUpdateSearchIndex() {
+	employee := models.Employee{Name: "Zed", Email: "zed@example.com", Position: "Dev", Salary: 60000, Currency: "USD", Status: models.StatusActive}
+	created := employee
+	created.ID = 7
+	created.TenantID = "acme"
+	suite.repo.EXPECT().Create(gomock.Any(), employee).Return(created, nil)
+	_, err := suite.svc.Create(testCtx, employee)
+	suite.NoError(err)
+
+	suite.repo.EXPECT().FindByIDs(gomock.Any(), []uint{7}).Return([]models.Employee{created}, nil)
+	hits, err := suite.svc.SearchEmployees(testCtx, "zed", 0)
+	suite.NoError(err)
+	suite.Len(hits, 1)
+
+	suite.repo.EXPECT().Delete(gomock.Any(), uint(7)).Return(nil)
+	suite.NoError(suite.svc.Delete(testCtx, 7))
+
+	suite.repo.EXPECT().FindByIDs(gomock.Any(), gomock.Len(0)).Return(nil, nil)
+	hits, err = suite.svc.SearchEmployees(testCtx, "zed", 0)
+	suite.NoError(err)
+	suite.Empty(hits)
+}
+
+func (suite *EmployeeServiceTestSuite) TestRebuildSearchIndex() {
+	suite.repo.EXPECT().FindInBatches(gomock.Any(), models.EmployeeFilter{}, indexBatchSize, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ models.EmployeeFilter, _ int, fn func([]models.Employee) error) error {
+			return fn([]models.Employee{{ID: 4, TenantID: "acme", Name: "Dana", Email: "dana@example.com", Position: "Designer"}})
+		})
+	suite.NoError(suite.svc.RebuildSearchIndex(testCtx))
+
+	suite.repo.EXPECT().FindByIDs(gomock.Any(), []uint{4}).Return([]models.Employee{{ID: 4, Name: "Dana"}}, nil)
+	hits, err := suite.svc.SearchEmployees(testCtx, "designer", 0)
+	suite.NoError(err)
+	suite.Len(hits, 1)
+
+	suite.repo.EXPECT().FindInBatches(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+	suite.Error(suite.svc.RebuildSearchIndex(testCtx))
+}
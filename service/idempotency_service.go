@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// IdempotencyService defines the interface for remembering requests sent with
+// an Idempotency-Key header and the responses to replay for their retries
+type IdempotencyService interface {
+	Begin(ctx context.Context, scope, key, fingerprint string) (models.IdempotencyRecord, error)
+	Complete(ctx context.Context, record models.IdempotencyRecord, statusCode int, header http.Header, body []byte) error
+	Release(ctx context.Context, record models.IdempotencyRecord) error
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"gorm.io/gorm"
+)
+
+// DefaultIdempotencyTTL is how long a key is remembered after its first request
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// IdempotencyServiceImpl implements the IdempotencyService interface
+type IdempotencyServiceImpl struct {
+	idempotencyRepo repo.IdempotencyRepository
+	ttl             time.Duration
+	now             func() time.Time
+}
+
+// IdempotencyServiceOption configures optional IdempotencyService behaviour
+type IdempotencyServiceOption func(*IdempotencyServiceImpl)
+
+// WithIdempotencyTTL sets how long keys are remembered. A non-positive TTL keeps the default.
+func WithIdempotencyTTL(ttl time.Duration) IdempotencyServiceOption {
+	return func(s *IdempotencyServiceImpl) {
+		if ttl > 0 {
+			s.ttl = ttl
+		}
+	}
+}
+
+// NewIdempotencyService creates a new instance of IdempotencyService
+func NewIdempotencyService(idempotencyRepo repo.IdempotencyRepository, opts ...IdempotencyServiceOption) IdempotencyService {
+	s := &IdempotencyServiceImpl{
+		idempotencyRepo: idempotencyRepo,
+		ttl:             DefaultIdempotencyTTL,
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Begin reserves the key for a request with the given fingerprint. When the
+// key was already used for the same request and that request has finished,
+// the returned record is completed and holds the response to replay. A key
+// whose request is still running is an ErrConflict, and a key used for a
+// different request is ErrIdempotencyReused. Expired keys are forgotten first.
+func (s *IdempotencyServiceImpl) Begin(ctx context.Context, scope, key, fingerprint string) (models.IdempotencyRecord, error) {
+	now := s.now()
+	if _, err := s.idempotencyRepo.DeleteExpired(ctx, now); err != nil {
+		return models.IdempotencyRecord{}, err
+	}
+
+	record, err := s.idempotencyRepo.Create(ctx, models.IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+	})
+	if !errors.Is(err, models.ErrConflict) {
+		return record, err
+	}
+
+	existing, err := s.idempotencyRepo.Find(ctx, scope, key)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// The other request failed and released the key in between
+		return models.IdempotencyRecord{}, fmt.Errorf("%w: a request with idempotency key %q is in progress", models.ErrConflict, key)
+	}
+	if err != nil {
+		return models.IdempotencyRecord{}, err
+	}
+	if existing.Fingerprint != fingerprint {
+		return models.IdempotencyRecord{}, fmt.Errorf("%w: %q", models.ErrIdempotencyReused, key)
+	}
+	if !existing.Completed {
+		return models.IdempotencyRecord{}, fmt.Errorf("%w: a request with idempotency key %q is in progress", models.ErrConflict, key)
+	}
+	return existing, nil
+}
+
+// Complete stores the response of the request that reserved the record, to be replayed for its retries
+func (s *IdempotencyServiceImpl) Complete(ctx context.Context, record models.IdempotencyRecord, statusCode int, header http.Header, body []byte) error {
+	record.Completed = true
+	record.StatusCode = statusCode
+	record.Header = header
+	record.Body = body
+	return s.idempotencyRepo.Update(ctx, record)
+}
+
+// Release forgets a reserved key, so a retry of a request that failed runs again
+func (s *IdempotencyServiceImpl) Release(ctx context.Context, record models.IdempotencyRecord) error {
+	return s.idempotencyRepo.Delete(ctx, record.Scope, record.Key)
+}
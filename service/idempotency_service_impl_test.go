@@ -0,0 +1,96 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	repomocks "github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+type IdempotencyServiceTestSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	idempotencyRepo *repomocks.MockIdempotencyRepository
+	now             time.Time
+	svc             *IdempotencyServiceImpl
+}
+
+func (suite *IdempotencyServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.idempotencyRepo = repomocks.NewMockIdempotencyRepository(suite.ctrl)
+	suite.now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	suite.svc = NewIdempotencyService(suite.idempotencyRepo, WithIdempotencyTTL(time.Hour)).(*IdempotencyServiceImpl)
+	suite.svc.now = func() time.Time { return suite.now }
+}
+
+func (suite *IdempotencyServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestIdempotencyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyServiceTestSuite))
+}
+
+// expectTaken expects an expired-key purge and a reservation that finds the key taken
+func (suite *IdempotencyServiceTestSuite) expectTaken(existing models.IdempotencyRecord, err error) {
+	suite.idempotencyRepo.EXPECT().DeleteExpired(gomock.Any(), suite.now).Return(int64(0), nil)
+	suite.idempotencyRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(models.IdempotencyRecord{}, models.ErrConflict)
+	suite.idempotencyRepo.EXPECT().Find(gomock.Any(), "alice", "key-1").Return(existing, err)
+}
+
+func (suite *IdempotencyServiceTestSuite) TestBeginReservesNewKey() {
+	suite.idempotencyRepo.EXPECT().DeleteExpired(gomock.Any(), suite.now).Return(int64(2), nil)
+	expected := models.IdempotencyRecord{Scope: "alice", Key: "key-1", Fingerprint: "abc",
+		CreatedAt: suite.now, ExpiresAt: suite.now.Add(time.Hour)}
+	stored := expected
+	stored.ID = 1
+	suite.idempotencyRepo.EXPECT().Create(gomock.Any(), expected).Return(stored, nil)
+
+	record, err := suite.svc.Begin(testCtx, "alice", "key-1", "abc")
+	suite.NoError(err)
+	suite.Equal(stored, record)
+	suite.False(record.Completed)
+}
+
+func (suite *IdempotencyServiceTestSuite) TestBeginReturnsCompletedRecordForReplay() {
+	existing := models.IdempotencyRecord{ID: 1, Scope: "alice", Key: "key-1", Fingerprint: "abc",
+		Completed: true, StatusCode: http.StatusCreated, Body: []byte(`{"id":6}`)}
+	suite.expectTaken(existing, nil)
+
+	record, err := suite.svc.Begin(testCtx, "alice", "key-1", "abc")
+	suite.NoError(err)
+	suite.Equal(existing, record)
+}
+
+func (suite *IdempotencyServiceTestSuite) TestBeginRejectsKeyInProgress() {
+	suite.expectTaken(models.IdempotencyRecord{ID: 1, Scope: "alice", Key: "key-1", Fingerprint: "abc"}, nil)
+	_, err := suite.svc.Begin(testCtx, "alice", "key-1", "abc")
+	suite.ErrorIs(err, models.ErrConflict)
+
+	// Released by the other request between the reservation and the lookup
+	suite.expectTaken(models.IdempotencyRecord{}, gorm.ErrRecordNotFound)
+	_, err = suite.svc.Begin(testCtx, "alice", "key-1", "abc")
+	suite.ErrorIs(err, models.ErrConflict)
+}
+
+func (suite *IdempotencyServiceTestSuite) TestBeginRejectsKeyReusedForDifferentRequest() {
+	suite.expectTaken(models.IdempotencyRecord{ID: 1, Scope: "alice", Key: "key-1", Fingerprint: "abc", Completed: true}, nil)
+	_, err := suite.svc.Begin(testCtx, "alice", "key-1", "def")
+	suite.ErrorIs(err, models.ErrIdempotencyReused)
+}
+
+func (suite *IdempotencyServiceTestSuite) TestCompleteAndRelease() {
+	record := models.IdempotencyRecord{ID: 1, Scope: "alice", Key: "key-1", Fingerprint: "abc"}
+	header := http.Header{"Content-Type": {"application/json"}}
+	suite.idempotencyRepo.EXPECT().Update(gomock.Any(), models.IdempotencyRecord{ID: 1, Scope: "alice", Key: "key-1", Fingerprint: "abc",
+		Completed: true, StatusCode: http.StatusCreated, Header: header, Body: []byte(`{"id":6}`)}).Return(nil)
+	suite.NoError(suite.svc.Complete(testCtx, record, http.StatusCreated, header, []byte(`{"id":6}`)))
+
+	suite.idempotencyRepo.EXPECT().Delete(gomock.Any(), "alice", "key-1").Return(nil)
+	suite.NoError(suite.svc.Release(testCtx, record))
+}
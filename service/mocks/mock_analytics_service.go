@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\analytics_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\analytics_service.go -destination=service\mocks\mock_analytics_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAnalyticsService is a mock of AnalyticsService interface.
+type MockAnalyticsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAnalyticsServiceMockRecorder is the mock recorder for MockAnalyticsService.
+type MockAnalyticsServiceMockRecorder struct {
+	mock *MockAnalyticsService
+}
+
+// NewMockAnalyticsService creates a new mock instance.
+func NewMockAnalyticsService(ctrl *gomock.Controller) *MockAnalyticsService {
+	mock := &MockAnalyticsService{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsService) EXPECT() *MockAnalyticsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetHeadcount mocks base method.
+func (m *MockAnalyticsService) GetHeadcount(ctx context.Context, filter models.AnalyticsFilter) ([]models.HeadcountGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeadcount", ctx, filter)
+	ret0, _ := ret[0].([]models.HeadcountGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeadcount indicates an expected call of GetHeadcount.
+func (mr *MockAnalyticsServiceMockRecorder) GetHeadcount(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeadcount", reflect.TypeOf((*MockAnalyticsService)(nil).GetHeadcount), ctx, filter)
+}
+
+// GetMovements mocks base method.
+func (m *MockAnalyticsService) GetMovements(ctx context.Context, filter models.MovementFilter) ([]models.MonthlyMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovements", ctx, filter)
+	ret0, _ := ret[0].([]models.MonthlyMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovements indicates an expected call of GetMovements.
+func (mr *MockAnalyticsServiceMockRecorder) GetMovements(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovements", reflect.TypeOf((*MockAnalyticsService)(nil).GetMovements), ctx, filter)
+}
+
+// GetSalaryStats mocks base method.
+func (m *MockAnalyticsService) GetSalaryStats(ctx context.Context, filter models.AnalyticsFilter) ([]models.SalaryStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalaryStats", ctx, filter)
+	ret0, _ := ret[0].([]models.SalaryStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalaryStats indicates an expected call of GetSalaryStats.
+func (mr *MockAnalyticsServiceMockRecorder) GetSalaryStats(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalaryStats", reflect.TypeOf((*MockAnalyticsService)(nil).GetSalaryStats), ctx, filter)
+}
+
+// GetTenureDistribution mocks base method.
+func (m *MockAnalyticsService) GetTenureDistribution(ctx context.Context, filter models.AnalyticsFilter) ([]models.TenureBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenureDistribution", ctx, filter)
+	ret0, _ := ret[0].([]models.TenureBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenureDistribution indicates an expected call of GetTenureDistribution.
+func (mr *MockAnalyticsServiceMockRecorder) GetTenureDistribution(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenureDistribution", reflect.TypeOf((*MockAnalyticsService)(nil).GetTenureDistribution), ctx, filter)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\custom_field_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\custom_field_service.go -destination=service\mocks\mock_custom_field_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldService is a mock of CustomFieldService interface.
+type MockCustomFieldService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldServiceMockRecorder is the mock recorder for MockCustomFieldService.
+type MockCustomFieldServiceMockRecorder struct {
+	mock *MockCustomFieldService
+}
+
+// NewMockCustomFieldService creates a new mock instance.
+func NewMockCustomFieldService(ctrl *gomock.Controller) *MockCustomFieldService {
+	mock := &MockCustomFieldService{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldService) EXPECT() *MockCustomFieldServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCustomFieldService) Create(ctx context.Context, entity models.CustomField) (models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomFieldServiceMockRecorder) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomFieldService)(nil).Create), ctx, entity)
+}
+
+// Delete mocks base method.
+func (m *MockCustomFieldService) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCustomFieldServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCustomFieldService)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockCustomFieldService) Get(ctx context.Context, id uint) (models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCustomFieldServiceMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCustomFieldService)(nil).Get), ctx, id)
+}
+
+// GetAllCustomFields mocks base method.
+func (m *MockCustomFieldService) GetAllCustomFields(ctx context.Context) ([]models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllCustomFields", ctx)
+	ret0, _ := ret[0].([]models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllCustomFields indicates an expected call of GetAllCustomFields.
+func (mr *MockCustomFieldServiceMockRecorder) GetAllCustomFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCustomFields", reflect.TypeOf((*MockCustomFieldService)(nil).GetAllCustomFields), ctx)
+}
+
+// List mocks base method.
+func (m *MockCustomFieldService) List(ctx context.Context, query models.ListQuery) ([]models.CustomField, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]models.CustomField)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomFieldServiceMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomFieldService)(nil).List), ctx, query)
+}
+
+// Update mocks base method.
+func (m *MockCustomFieldService) Update(ctx context.Context, id uint, entity models.CustomField) (models.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
+	ret0, _ := ret[0].(models.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomFieldServiceMockRecorder) Update(ctx, id, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomFieldService)(nil).Update), ctx, id, entity)
+}
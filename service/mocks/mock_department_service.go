@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\department_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\department_service.go -destination=service\mocks\mock_department_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDepartmentService is a mock of DepartmentService interface.
+type MockDepartmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentServiceMockRecorder is the mock recorder for MockDepartmentService.
+type MockDepartmentServiceMockRecorder struct {
+	mock *MockDepartmentService
+}
+
+// NewMockDepartmentService creates a new mock instance.
+func NewMockDepartmentService(ctrl *gomock.Controller) *MockDepartmentService {
+	mock := &MockDepartmentService{ctrl: ctrl}
+	mock.recorder = &MockDepartmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentService) EXPECT() *MockDepartmentServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateDepartment mocks base method.
+func (m *MockDepartmentService) CreateDepartment(ctx context.Context, department models.Department) (models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDepartment", ctx, department)
+	ret0, _ := ret[0].(models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDepartment indicates an expected call of CreateDepartment.
+func (mr *MockDepartmentServiceMockRecorder) CreateDepartment(ctx, department any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDepartment", reflect.TypeOf((*MockDepartmentService)(nil).CreateDepartment), ctx, department)
+}
+
+// DeleteDepartment mocks base method.
+func (m *MockDepartmentService) DeleteDepartment(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDepartment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDepartment indicates an expected call of DeleteDepartment.
+func (mr *MockDepartmentServiceMockRecorder) DeleteDepartment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDepartment", reflect.TypeOf((*MockDepartmentService)(nil).DeleteDepartment), ctx, id)
+}
+
+// GetAllDepartments mocks base method.
+func (m *MockDepartmentService) GetAllDepartments(ctx context.Context) ([]models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDepartments", ctx)
+	ret0, _ := ret[0].([]models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDepartments indicates an expected call of GetAllDepartments.
+func (mr *MockDepartmentServiceMockRecorder) GetAllDepartments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDepartments", reflect.TypeOf((*MockDepartmentService)(nil).GetAllDepartments), ctx)
+}
+
+// GetDepartmentByID mocks base method.
+func (m *MockDepartmentService) GetDepartmentByID(ctx context.Context, id uint) (models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepartmentByID", ctx, id)
+	ret0, _ := ret[0].(models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepartmentByID indicates an expected call of GetDepartmentByID.
+func (mr *MockDepartmentServiceMockRecorder) GetDepartmentByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepartmentByID", reflect.TypeOf((*MockDepartmentService)(nil).GetDepartmentByID), ctx, id)
+}
+
+// GetDepartmentEmployees mocks base method.
+func (m *MockDepartmentService) GetDepartmentEmployees(ctx context.Context, id uint, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepartmentEmployees", ctx, id, filter)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDepartmentEmployees indicates an expected call of GetDepartmentEmployees.
+func (mr *MockDepartmentServiceMockRecorder) GetDepartmentEmployees(ctx, id, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepartmentEmployees", reflect.TypeOf((*MockDepartmentService)(nil).GetDepartmentEmployees), ctx, id, filter)
+}
+
+// UpdateDepartment mocks base method.
+func (m *MockDepartmentService) UpdateDepartment(ctx context.Context, id uint, department models.Department) (models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDepartment", ctx, id, department)
+	ret0, _ := ret[0].(models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDepartment indicates an expected call of UpdateDepartment.
+func (mr *MockDepartmentServiceMockRecorder) UpdateDepartment(ctx, id, department any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDepartment", reflect.TypeOf((*MockDepartmentService)(nil).UpdateDepartment), ctx, id, department)
+}
@@ -10,8 +10,10 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
+	events "github.com/chinmay-sawant/gin-example/events"
 	models "github.com/chinmay-sawant/gin-example/models"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -40,76 +42,331 @@ func (m *MockEmployeeService) EXPECT() *MockEmployeeServiceMockRecorder {
 	return m.recorder
 }
 
-// CreateEmployee mocks base method.
-func (m *MockEmployeeService) CreateEmployee(employee models.Employee) (models.Employee, error) {
+// BulkCreateEmployees mocks base method.
+func (m *MockEmployeeService) BulkCreateEmployees(ctx context.Context, employees []models.Employee, mode models.BulkMode) ([]models.BulkItemResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEmployee", employee)
+	ret := m.ctrl.Call(m, "BulkCreateEmployees", ctx, employees, mode)
+	ret0, _ := ret[0].([]models.BulkItemResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCreateEmployees indicates an expected call of BulkCreateEmployees.
+func (mr *MockEmployeeServiceMockRecorder) BulkCreateEmployees(ctx, employees, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateEmployees", reflect.TypeOf((*MockEmployeeService)(nil).BulkCreateEmployees), ctx, employees, mode)
+}
+
+// BulkDeleteEmployees mocks base method.
+func (m *MockEmployeeService) BulkDeleteEmployees(ctx context.Context, ids []uint, mode models.BulkMode) ([]models.BulkItemResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDeleteEmployees", ctx, ids, mode)
+	ret0, _ := ret[0].([]models.BulkItemResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkDeleteEmployees indicates an expected call of BulkDeleteEmployees.
+func (mr *MockEmployeeServiceMockRecorder) BulkDeleteEmployees(ctx, ids, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDeleteEmployees", reflect.TypeOf((*MockEmployeeService)(nil).BulkDeleteEmployees), ctx, ids, mode)
+}
+
+// BulkUpdateEmployees mocks base method.
+func (m *MockEmployeeService) BulkUpdateEmployees(ctx context.Context, patches []models.EmployeePatch, mode models.BulkMode) ([]models.BulkItemResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdateEmployees", ctx, patches, mode)
+	ret0, _ := ret[0].([]models.BulkItemResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateEmployees indicates an expected call of BulkUpdateEmployees.
+func (mr *MockEmployeeServiceMockRecorder) BulkUpdateEmployees(ctx, patches, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateEmployees", reflect.TypeOf((*MockEmployeeService)(nil).BulkUpdateEmployees), ctx, patches, mode)
+}
+
+// Create mocks base method.
+func (m *MockEmployeeService) Create(ctx context.Context, entity models.Employee) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
 	ret0, _ := ret[0].(models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEmployee indicates an expected call of CreateEmployee.
-func (mr *MockEmployeeServiceMockRecorder) CreateEmployee(employee any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockEmployeeServiceMockRecorder) Create(ctx, entity any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployee", reflect.TypeOf((*MockEmployeeService)(nil).CreateEmployee), employee)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmployeeService)(nil).Create), ctx, entity)
 }
 
-// DeleteEmployee mocks base method.
-func (m *MockEmployeeService) DeleteEmployee(id uint) error {
+// Delete mocks base method.
+func (m *MockEmployeeService) Delete(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEmployee", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteEmployee indicates an expected call of DeleteEmployee.
-func (mr *MockEmployeeServiceMockRecorder) DeleteEmployee(id any) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockEmployeeServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmployeeService)(nil).Delete), ctx, id)
+}
+
+// ExportEmployees mocks base method.
+func (m *MockEmployeeService) ExportEmployees(ctx context.Context, filter models.EmployeeFilter, fn func([]models.Employee) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEmployees", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportEmployees indicates an expected call of ExportEmployees.
+func (mr *MockEmployeeServiceMockRecorder) ExportEmployees(ctx, filter, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEmployees", reflect.TypeOf((*MockEmployeeService)(nil).ExportEmployees), ctx, filter, fn)
+}
+
+// Get mocks base method.
+func (m *MockEmployeeService) Get(ctx context.Context, id uint) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEmployeeServiceMockRecorder) Get(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmployee", reflect.TypeOf((*MockEmployeeService)(nil).DeleteEmployee), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmployeeService)(nil).Get), ctx, id)
 }
 
 // GetAllEmployees mocks base method.
-func (m *MockEmployeeService) GetAllEmployees() ([]models.Employee, error) {
+func (m *MockEmployeeService) GetAllEmployees(ctx context.Context, filter models.EmployeeFilter) ([]models.Employee, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllEmployees", ctx, filter)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllEmployees indicates an expected call of GetAllEmployees.
+func (mr *MockEmployeeServiceMockRecorder) GetAllEmployees(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmployees", reflect.TypeOf((*MockEmployeeService)(nil).GetAllEmployees), ctx, filter)
+}
+
+// GetDirectReports mocks base method.
+func (m *MockEmployeeService) GetDirectReports(ctx context.Context, id uint) ([]models.Employee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllEmployees")
+	ret := m.ctrl.Call(m, "GetDirectReports", ctx, id)
 	ret0, _ := ret[0].([]models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllEmployees indicates an expected call of GetAllEmployees.
-func (mr *MockEmployeeServiceMockRecorder) GetAllEmployees() *gomock.Call {
+// GetDirectReports indicates an expected call of GetDirectReports.
+func (mr *MockEmployeeServiceMockRecorder) GetDirectReports(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmployees", reflect.TypeOf((*MockEmployeeService)(nil).GetAllEmployees))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectReports", reflect.TypeOf((*MockEmployeeService)(nil).GetDirectReports), ctx, id)
 }
 
 // GetEmployeeByID mocks base method.
-func (m *MockEmployeeService) GetEmployeeByID(id uint) (models.Employee, error) {
+func (m *MockEmployeeService) GetEmployeeByID(ctx context.Context, id uint, view models.EmployeeView) (models.Employee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEmployeeByID", id)
+	ret := m.ctrl.Call(m, "GetEmployeeByID", ctx, id, view)
 	ret0, _ := ret[0].(models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEmployeeByID indicates an expected call of GetEmployeeByID.
-func (mr *MockEmployeeServiceMockRecorder) GetEmployeeByID(id any) *gomock.Call {
+func (mr *MockEmployeeServiceMockRecorder) GetEmployeeByID(ctx, id, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeByID", reflect.TypeOf((*MockEmployeeService)(nil).GetEmployeeByID), ctx, id, view)
+}
+
+// GetOrgChart mocks base method.
+func (m *MockEmployeeService) GetOrgChart(ctx context.Context, rootID *uint) ([]models.OrgChartNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgChart", ctx, rootID)
+	ret0, _ := ret[0].([]models.OrgChartNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgChart indicates an expected call of GetOrgChart.
+func (mr *MockEmployeeServiceMockRecorder) GetOrgChart(ctx, rootID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgChart", reflect.TypeOf((*MockEmployeeService)(nil).GetOrgChart), ctx, rootID)
+}
+
+// GetReportingChain mocks base method.
+func (m *MockEmployeeService) GetReportingChain(ctx context.Context, id uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportingChain", ctx, id)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportingChain indicates an expected call of GetReportingChain.
+func (mr *MockEmployeeServiceMockRecorder) GetReportingChain(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportingChain", reflect.TypeOf((*MockEmployeeService)(nil).GetReportingChain), ctx, id)
+}
+
+// GetSalaryOverrides mocks base method.
+func (m *MockEmployeeService) GetSalaryOverrides(ctx context.Context, id uint) ([]models.SalaryBandOverride, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalaryOverrides", ctx, id)
+	ret0, _ := ret[0].([]models.SalaryBandOverride)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalaryOverrides indicates an expected call of GetSalaryOverrides.
+func (mr *MockEmployeeServiceMockRecorder) GetSalaryOverrides(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalaryOverrides", reflect.TypeOf((*MockEmployeeService)(nil).GetSalaryOverrides), ctx, id)
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockEmployeeService) GetStatusHistory(ctx context.Context, id uint) ([]models.EmployeeStatusTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", ctx, id)
+	ret0, _ := ret[0].([]models.EmployeeStatusTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockEmployeeServiceMockRecorder) GetStatusHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockEmployeeService)(nil).GetStatusHistory), ctx, id)
+}
+
+// GetSubordinates mocks base method.
+func (m *MockEmployeeService) GetSubordinates(ctx context.Context, id uint) ([]models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubordinates", ctx, id)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubordinates indicates an expected call of GetSubordinates.
+func (mr *MockEmployeeServiceMockRecorder) GetSubordinates(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubordinates", reflect.TypeOf((*MockEmployeeService)(nil).GetSubordinates), ctx, id)
+}
+
+// ImportEmployees mocks base method.
+func (m *MockEmployeeService) ImportEmployees(ctx context.Context, rows []models.ImportRow, dryRun bool) (models.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEmployees", ctx, rows, dryRun)
+	ret0, _ := ret[0].(models.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEmployees indicates an expected call of ImportEmployees.
+func (mr *MockEmployeeServiceMockRecorder) ImportEmployees(ctx, rows, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEmployees", reflect.TypeOf((*MockEmployeeService)(nil).ImportEmployees), ctx, rows, dryRun)
+}
+
+// List mocks base method.
+func (m *MockEmployeeService) List(ctx context.Context, query models.ListQuery) ([]models.Employee, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]models.Employee)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockEmployeeServiceMockRecorder) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEmployeeService)(nil).List), ctx, query)
+}
+
+// RebuildSearchIndex mocks base method.
+func (m *MockEmployeeService) RebuildSearchIndex(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildSearchIndex", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildSearchIndex indicates an expected call of RebuildSearchIndex.
+func (mr *MockEmployeeServiceMockRecorder) RebuildSearchIndex(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildSearchIndex", reflect.TypeOf((*MockEmployeeService)(nil).RebuildSearchIndex), ctx)
+}
+
+// SearchEmployees mocks base method.
+func (m *MockEmployeeService) SearchEmployees(ctx context.Context, query string, limit int) ([]models.SearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEmployees", ctx, query, limit)
+	ret0, _ := ret[0].([]models.SearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEmployees indicates an expected call of SearchEmployees.
+func (mr *MockEmployeeServiceMockRecorder) SearchEmployees(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEmployees", reflect.TypeOf((*MockEmployeeService)(nil).SearchEmployees), ctx, query, limit)
+}
+
+// SubscribeEvents mocks base method.
+func (m *MockEmployeeService) SubscribeEvents(ctx context.Context, filter models.EventFilter, after *uint64) (*events.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeEvents", ctx, filter, after)
+	ret0, _ := ret[0].(*events.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockEmployeeServiceMockRecorder) SubscribeEvents(ctx, filter, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockEmployeeService)(nil).SubscribeEvents), ctx, filter, after)
+}
+
+// TransitionEmployee mocks base method.
+func (m *MockEmployeeService) TransitionEmployee(ctx context.Context, id uint, status models.EmployeeStatus, request models.StatusTransitionRequest) (models.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionEmployee", ctx, id, status, request)
+	ret0, _ := ret[0].(models.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionEmployee indicates an expected call of TransitionEmployee.
+func (mr *MockEmployeeServiceMockRecorder) TransitionEmployee(ctx, id, status, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeByID", reflect.TypeOf((*MockEmployeeService)(nil).GetEmployeeByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionEmployee", reflect.TypeOf((*MockEmployeeService)(nil).TransitionEmployee), ctx, id, status, request)
 }
 
-// UpdateEmployee mocks base method.
-func (m *MockEmployeeService) UpdateEmployee(id uint, employee models.Employee) (models.Employee, error) {
+// Update mocks base method.
+func (m *MockEmployeeService) Update(ctx context.Context, id uint, entity models.Employee) (models.Employee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEmployee", id, employee)
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
 	ret0, _ := ret[0].(models.Employee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateEmployee indicates an expected call of UpdateEmployee.
-func (mr *MockEmployeeServiceMockRecorder) UpdateEmployee(id, employee any) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockEmployeeServiceMockRecorder) Update(ctx, id, entity any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployee", reflect.TypeOf((*MockEmployeeService)(nil).UpdateEmployee), id, employee)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEmployeeService)(nil).Update), ctx, id, entity)
 }
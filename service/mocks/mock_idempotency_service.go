@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\idempotency_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\idempotency_service.go -destination=service\mocks\mock_idempotency_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyService is a mock of IdempotencyService interface.
+type MockIdempotencyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyServiceMockRecorder is the mock recorder for MockIdempotencyService.
+type MockIdempotencyServiceMockRecorder struct {
+	mock *MockIdempotencyService
+}
+
+// NewMockIdempotencyService creates a new mock instance.
+func NewMockIdempotencyService(ctrl *gomock.Controller) *MockIdempotencyService {
+	mock := &MockIdempotencyService{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyService) EXPECT() *MockIdempotencyServiceMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockIdempotencyService) Begin(ctx context.Context, scope, key, fingerprint string) (models.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, scope, key, fingerprint)
+	ret0, _ := ret[0].(models.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyServiceMockRecorder) Begin(ctx, scope, key, fingerprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotencyService)(nil).Begin), ctx, scope, key, fingerprint)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyService) Complete(ctx context.Context, record models.IdempotencyRecord, statusCode int, header http.Header, body []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, record, statusCode, header, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyServiceMockRecorder) Complete(ctx, record, statusCode, header, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyService)(nil).Complete), ctx, record, statusCode, header, body)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyService) Release(ctx context.Context, record models.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyServiceMockRecorder) Release(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyService)(nil).Release), ctx, record)
+}
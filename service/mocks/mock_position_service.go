@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\position_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\position_service.go -destination=service\mocks\mock_position_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPositionService is a mock of PositionService interface.
+type MockPositionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPositionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPositionServiceMockRecorder is the mock recorder for MockPositionService.
+type MockPositionServiceMockRecorder struct {
+	mock *MockPositionService
+}
+
+// NewMockPositionService creates a new mock instance.
+func NewMockPositionService(ctrl *gomock.Controller) *MockPositionService {
+	mock := &MockPositionService{ctrl: ctrl}
+	mock.recorder = &MockPositionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPositionService) EXPECT() *MockPositionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePosition mocks base method.
+func (m *MockPositionService) CreatePosition(ctx context.Context, position models.Position) (models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePosition", ctx, position)
+	ret0, _ := ret[0].(models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePosition indicates an expected call of CreatePosition.
+func (mr *MockPositionServiceMockRecorder) CreatePosition(ctx, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePosition", reflect.TypeOf((*MockPositionService)(nil).CreatePosition), ctx, position)
+}
+
+// DeletePosition mocks base method.
+func (m *MockPositionService) DeletePosition(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePosition", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePosition indicates an expected call of DeletePosition.
+func (mr *MockPositionServiceMockRecorder) DeletePosition(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePosition", reflect.TypeOf((*MockPositionService)(nil).DeletePosition), ctx, id)
+}
+
+// GetAllPositions mocks base method.
+func (m *MockPositionService) GetAllPositions(ctx context.Context) ([]models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPositions", ctx)
+	ret0, _ := ret[0].([]models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPositions indicates an expected call of GetAllPositions.
+func (mr *MockPositionServiceMockRecorder) GetAllPositions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPositions", reflect.TypeOf((*MockPositionService)(nil).GetAllPositions), ctx)
+}
+
+// GetPositionByID mocks base method.
+func (m *MockPositionService) GetPositionByID(ctx context.Context, id uint) (models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositionByID", ctx, id)
+	ret0, _ := ret[0].(models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositionByID indicates an expected call of GetPositionByID.
+func (mr *MockPositionServiceMockRecorder) GetPositionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositionByID", reflect.TypeOf((*MockPositionService)(nil).GetPositionByID), ctx, id)
+}
+
+// UpdatePosition mocks base method.
+func (m *MockPositionService) UpdatePosition(ctx context.Context, id uint, position models.Position) (models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePosition", ctx, id, position)
+	ret0, _ := ret[0].(models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePosition indicates an expected call of UpdatePosition.
+func (mr *MockPositionServiceMockRecorder) UpdatePosition(ctx, id, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePosition", reflect.TypeOf((*MockPositionService)(nil).UpdatePosition), ctx, id, position)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\scim_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\scim_service.go -destination=service\mocks\mock_scim_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	scim "github.com/chinmay-sawant/gin-example/scim"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSCIMService is a mock of SCIMService interface.
+type MockSCIMService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSCIMServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSCIMServiceMockRecorder is the mock recorder for MockSCIMService.
+type MockSCIMServiceMockRecorder struct {
+	mock *MockSCIMService
+}
+
+// NewMockSCIMService creates a new mock instance.
+func NewMockSCIMService(ctrl *gomock.Controller) *MockSCIMService {
+	mock := &MockSCIMService{ctrl: ctrl}
+	mock.recorder = &MockSCIMServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSCIMService) EXPECT() *MockSCIMServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateUser mocks base method.
+func (m *MockSCIMService) CreateUser(ctx context.Context, user scim.User) (scim.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(scim.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockSCIMServiceMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockSCIMService)(nil).CreateUser), ctx, user)
+}
+
+// DeleteUser mocks base method.
+func (m *MockSCIMService) DeleteUser(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockSCIMServiceMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockSCIMService)(nil).DeleteUser), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockSCIMService) GetUser(ctx context.Context, id uint) (scim.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(scim.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockSCIMServiceMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockSCIMService)(nil).GetUser), ctx, id)
+}
+
+// ListUsers mocks base method.
+func (m *MockSCIMService) ListUsers(ctx context.Context, query scim.ListQuery) ([]scim.User, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, query)
+	ret0, _ := ret[0].([]scim.User)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockSCIMServiceMockRecorder) ListUsers(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockSCIMService)(nil).ListUsers), ctx, query)
+}
+
+// PatchUser mocks base method.
+func (m *MockSCIMService) PatchUser(ctx context.Context, id uint, patch scim.PatchRequest, actor string) (scim.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchUser", ctx, id, patch, actor)
+	ret0, _ := ret[0].(scim.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUser indicates an expected call of PatchUser.
+func (mr *MockSCIMServiceMockRecorder) PatchUser(ctx, id, patch, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUser", reflect.TypeOf((*MockSCIMService)(nil).PatchUser), ctx, id, patch, actor)
+}
+
+// ReplaceUser mocks base method.
+func (m *MockSCIMService) ReplaceUser(ctx context.Context, id uint, user scim.User, actor string) (scim.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUser", ctx, id, user, actor)
+	ret0, _ := ret[0].(scim.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceUser indicates an expected call of ReplaceUser.
+func (mr *MockSCIMServiceMockRecorder) ReplaceUser(ctx, id, user, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUser", reflect.TypeOf((*MockSCIMService)(nil).ReplaceUser), ctx, id, user, actor)
+}
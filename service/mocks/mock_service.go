@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\service.go -destination=service\mocks\mock_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService[T any, ID comparable] struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder[T, ID]
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder[T any, ID comparable] struct {
+	mock *MockService[T, ID]
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService[T any, ID comparable](ctrl *gomock.Controller) *MockService[T, ID] {
+	mock := &MockService[T, ID]{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder[T, ID]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService[T, ID]) EXPECT() *MockServiceMockRecorder[T, ID] {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockService[T, ID]) Create(ctx context.Context, entity T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceMockRecorder[T, ID]) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService[T, ID])(nil).Create), ctx, entity)
+}
+
+// Delete mocks base method.
+func (m *MockService[T, ID]) Delete(ctx context.Context, id ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder[T, ID]) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService[T, ID])(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockService[T, ID]) Get(ctx context.Context, id ID) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceMockRecorder[T, ID]) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService[T, ID])(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockService[T, ID]) List(ctx context.Context, query models.ListQuery) ([]T, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder[T, ID]) List(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService[T, ID])(nil).List), ctx, query)
+}
+
+// Update mocks base method.
+func (m *MockService[T, ID]) Update(ctx context.Context, id ID, entity T) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, entity)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockServiceMockRecorder[T, ID]) Update(ctx, id, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService[T, ID])(nil).Update), ctx, id, entity)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\tag_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\tag_service.go -destination=service\mocks\mock_tag_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagService is a mock of TagService interface.
+type MockTagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTagServiceMockRecorder is the mock recorder for MockTagService.
+type MockTagServiceMockRecorder struct {
+	mock *MockTagService
+}
+
+// NewMockTagService creates a new mock instance.
+func NewMockTagService(ctrl *gomock.Controller) *MockTagService {
+	mock := &MockTagService{ctrl: ctrl}
+	mock.recorder = &MockTagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagService) EXPECT() *MockTagServiceMockRecorder {
+	return m.recorder
+}
+
+// AddEmployeeTags mocks base method.
+func (m *MockTagService) AddEmployeeTags(ctx context.Context, employeeID uint, names []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEmployeeTags", ctx, employeeID, names)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEmployeeTags indicates an expected call of AddEmployeeTags.
+func (mr *MockTagServiceMockRecorder) AddEmployeeTags(ctx, employeeID, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmployeeTags", reflect.TypeOf((*MockTagService)(nil).AddEmployeeTags), ctx, employeeID, names)
+}
+
+// GetAllTags mocks base method.
+func (m *MockTagService) GetAllTags(ctx context.Context) ([]models.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTags", ctx)
+	ret0, _ := ret[0].([]models.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTags indicates an expected call of GetAllTags.
+func (mr *MockTagServiceMockRecorder) GetAllTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTags", reflect.TypeOf((*MockTagService)(nil).GetAllTags), ctx)
+}
+
+// GetEmployeeTags mocks base method.
+func (m *MockTagService) GetEmployeeTags(ctx context.Context, employeeID uint) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeTags", ctx, employeeID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeTags indicates an expected call of GetEmployeeTags.
+func (mr *MockTagServiceMockRecorder) GetEmployeeTags(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeTags", reflect.TypeOf((*MockTagService)(nil).GetEmployeeTags), ctx, employeeID)
+}
+
+// RemoveEmployeeTag mocks base method.
+func (m *MockTagService) RemoveEmployeeTag(ctx context.Context, employeeID uint, name string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEmployeeTag", ctx, employeeID, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEmployeeTag indicates an expected call of RemoveEmployeeTag.
+func (mr *MockTagServiceMockRecorder) RemoveEmployeeTag(ctx, employeeID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEmployeeTag", reflect.TypeOf((*MockTagService)(nil).RemoveEmployeeTag), ctx, employeeID, name)
+}
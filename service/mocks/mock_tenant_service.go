@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\tenant_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\tenant_service.go -destination=service\mocks\mock_tenant_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantService is a mock of TenantService interface.
+type MockTenantService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantServiceMockRecorder is the mock recorder for MockTenantService.
+type MockTenantServiceMockRecorder struct {
+	mock *MockTenantService
+}
+
+// NewMockTenantService creates a new mock instance.
+func NewMockTenantService(ctrl *gomock.Controller) *MockTenantService {
+	mock := &MockTenantService{ctrl: ctrl}
+	mock.recorder = &MockTenantServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantService) EXPECT() *MockTenantServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTenant mocks base method.
+func (m *MockTenantService) CreateTenant(ctx context.Context, tenant models.Tenant) (models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTenant", ctx, tenant)
+	ret0, _ := ret[0].(models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTenant indicates an expected call of CreateTenant.
+func (mr *MockTenantServiceMockRecorder) CreateTenant(ctx, tenant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTenant", reflect.TypeOf((*MockTenantService)(nil).CreateTenant), ctx, tenant)
+}
+
+// DeleteTenant mocks base method.
+func (m *MockTenantService) DeleteTenant(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenant", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockTenantServiceMockRecorder) DeleteTenant(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockTenantService)(nil).DeleteTenant), ctx, id)
+}
+
+// GetAllTenants mocks base method.
+func (m *MockTenantService) GetAllTenants(ctx context.Context) ([]models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTenants", ctx)
+	ret0, _ := ret[0].([]models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTenants indicates an expected call of GetAllTenants.
+func (mr *MockTenantServiceMockRecorder) GetAllTenants(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTenants", reflect.TypeOf((*MockTenantService)(nil).GetAllTenants), ctx)
+}
+
+// GetTenantByID mocks base method.
+func (m *MockTenantService) GetTenantByID(ctx context.Context, id string) (models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantByID", ctx, id)
+	ret0, _ := ret[0].(models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantByID indicates an expected call of GetTenantByID.
+func (mr *MockTenantServiceMockRecorder) GetTenantByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantByID", reflect.TypeOf((*MockTenantService)(nil).GetTenantByID), ctx, id)
+}
+
+// UpdateTenant mocks base method.
+func (m *MockTenantService) UpdateTenant(ctx context.Context, id string, tenant models.Tenant) (models.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenant", ctx, id, tenant)
+	ret0, _ := ret[0].(models.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockTenantServiceMockRecorder) UpdateTenant(ctx, id, tenant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockTenantService)(nil).UpdateTenant), ctx, id, tenant)
+}
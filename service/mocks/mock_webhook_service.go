@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service\webhook_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service\webhook_service.go -destination=service\mocks\mock_webhook_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/chinmay-sawant/gin-example/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookService is a mock of WebhookService interface.
+type MockWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookServiceMockRecorder is the mock recorder for MockWebhookService.
+type MockWebhookServiceMockRecorder struct {
+	mock *MockWebhookService
+}
+
+// NewMockWebhookService creates a new mock instance.
+func NewMockWebhookService(ctrl *gomock.Controller) *MockWebhookService {
+	mock := &MockWebhookService{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookService) EXPECT() *MockWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookService) CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, webhook)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookServiceMockRecorder) CreateWebhook(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookService)(nil).CreateWebhook), ctx, webhook)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookService) DeleteWebhook(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookServiceMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookService)(nil).DeleteWebhook), ctx, id)
+}
+
+// DeliverDue mocks base method.
+func (m *MockWebhookService) DeliverDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverDue indicates an expected call of DeliverDue.
+func (mr *MockWebhookServiceMockRecorder) DeliverDue(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverDue", reflect.TypeOf((*MockWebhookService)(nil).DeliverDue), ctx)
+}
+
+// EnqueueEvent mocks base method.
+func (m *MockWebhookService) EnqueueEvent(ctx context.Context, event models.EmployeeEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueEvent indicates an expected call of EnqueueEvent.
+func (mr *MockWebhookServiceMockRecorder) EnqueueEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueEvent", reflect.TypeOf((*MockWebhookService)(nil).EnqueueEvent), ctx, event)
+}
+
+// GetAllWebhooks mocks base method.
+func (m *MockWebhookService) GetAllWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllWebhooks", ctx)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllWebhooks indicates an expected call of GetAllWebhooks.
+func (mr *MockWebhookServiceMockRecorder) GetAllWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWebhooks", reflect.TypeOf((*MockWebhookService)(nil).GetAllWebhooks), ctx)
+}
+
+// GetDeadLetters mocks base method.
+func (m *MockWebhookService) GetDeadLetters(ctx context.Context, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetters", ctx, p)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeadLetters indicates an expected call of GetDeadLetters.
+func (mr *MockWebhookServiceMockRecorder) GetDeadLetters(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetters", reflect.TypeOf((*MockWebhookService)(nil).GetDeadLetters), ctx, p)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookService) GetDeliveries(ctx context.Context, webhookID uint, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, webhookID, p)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookServiceMockRecorder) GetDeliveries(ctx, webhookID, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookService)(nil).GetDeliveries), ctx, webhookID, p)
+}
+
+// GetWebhookByID mocks base method.
+func (m *MockWebhookService) GetWebhookByID(ctx context.Context, id uint) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookByID", ctx, id)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookByID indicates an expected call of GetWebhookByID.
+func (mr *MockWebhookServiceMockRecorder) GetWebhookByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByID", reflect.TypeOf((*MockWebhookService)(nil).GetWebhookByID), ctx, id)
+}
+
+// RetryDelivery mocks base method.
+func (m *MockWebhookService) RetryDelivery(ctx context.Context, id uint) (models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryDelivery", ctx, id)
+	ret0, _ := ret[0].(models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryDelivery indicates an expected call of RetryDelivery.
+func (mr *MockWebhookServiceMockRecorder) RetryDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryDelivery", reflect.TypeOf((*MockWebhookService)(nil).RetryDelivery), ctx, id)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockWebhookService) UpdateWebhook(ctx context.Context, id uint, webhook models.Webhook) (models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, id, webhook)
+	ret0, _ := ret[0].(models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockWebhookServiceMockRecorder) UpdateWebhook(ctx, id, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhookService)(nil).UpdateWebhook), ctx, id, webhook)
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// PositionService defines the interface for job catalog operations
+type PositionService interface {
+	GetAllPositions(ctx context.Context) ([]models.Position, error)
+	GetPositionByID(ctx context.Context, id uint) (models.Position, error)
+	CreatePosition(ctx context.Context, position models.Position) (models.Position, error)
+	UpdatePosition(ctx context.Context, id uint, position models.Position) (models.Position, error)
+	DeletePosition(ctx context.Context, id uint) error
+}
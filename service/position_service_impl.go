@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// PositionServiceImpl implements the PositionService interface
+type PositionServiceImpl struct {
+	positionRepo repo.PositionRepository
+}
+
+// NewPositionService creates a new instance of PositionService
+func NewPositionService(positionRepo repo.PositionRepository) PositionService {
+	return &PositionServiceImpl{positionRepo: positionRepo}
+}
+
+// GetAllPositions returns the whole job catalog
+func (s *PositionServiceImpl) GetAllPositions(ctx context.Context) ([]models.Position, error) {
+	return s.positionRepo.FindAll(ctx)
+}
+
+// GetPositionByID returns a catalog entry by ID
+func (s *PositionServiceImpl) GetPositionByID(ctx context.Context, id uint) (models.Position, error) {
+	return s.positionRepo.FindByID(ctx, id)
+}
+
+// CreatePosition adds a new catalog entry
+func (s *PositionServiceImpl) CreatePosition(ctx context.Context, position models.Position) (models.Position, error) {
+	normalizeBands(&position)
+	return s.positionRepo.Create(ctx, position)
+}
+
+// UpdatePosition updates a catalog entry, replacing its salary bands
+func (s *PositionServiceImpl) UpdatePosition(ctx context.Context, id uint, position models.Position) (models.Position, error) {
+	normalizeBands(&position)
+	return s.positionRepo.Update(ctx, id, position)
+}
+
+// DeletePosition removes a catalog entry that no employee holds
+func (s *PositionServiceImpl) DeletePosition(ctx context.Context, id uint) error {
+	return s.positionRepo.Delete(ctx, id)
+}
+
+// normalizeBands upper-cases band currencies so lookups are case-insensitive
+func normalizeBands(position *models.Position) {
+	for i := range position.SalaryBands {
+		position.SalaryBands[i].Currency = strings.ToUpper(position.SalaryBands[i].Currency)
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type PositionServiceTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	repo *mocks.MockPositionRepository
+	svc  PositionService
+}
+
+func (suite *PositionServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mocks.NewMockPositionRepository(suite.ctrl)
+	suite.svc = NewPositionService(suite.repo)
+}
+
+func (suite *PositionServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestPositionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PositionServiceTestSuite))
+}
+
+func (suite *PositionServiceTestSuite) TestCreatePositionNormalizesCurrency() {
+	position := models.Position{Title: "Developer", SalaryBands: []models.SalaryBand{{Currency: "eur", MinSalary: 1, MaxSalary: 2}}}
+	expected := models.Position{Title: "Developer", SalaryBands: []models.SalaryBand{{Currency: "EUR", MinSalary: 1, MaxSalary: 2}}}
+	suite.repo.EXPECT().Create(gomock.Any(), expected).Return(expected, nil)
+
+	result, err := suite.svc.CreatePosition(testCtx, position)
+	suite.NoError(err)
+	suite.Equal("EUR", result.SalaryBands[0].Currency)
+}
+
+func (suite *PositionServiceTestSuite) TestDeletePosition() {
+	suite.repo.EXPECT().Delete(gomock.Any(), uint(1)).Return(models.ErrConflict)
+
+	err := suite.svc.DeletePosition(testCtx, 1)
+	suite.ErrorIs(err, models.ErrConflict)
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/scim"
+)
+
+// SCIMService defines the interface for provisioning employees as SCIM users
+type SCIMService interface {
+	ListUsers(ctx context.Context, query scim.ListQuery) ([]scim.User, int, error)
+	GetUser(ctx context.Context, id uint) (scim.User, error)
+	CreateUser(ctx context.Context, user scim.User) (scim.User, error)
+	ReplaceUser(ctx context.Context, id uint, user scim.User, actor string) (scim.User, error)
+	PatchUser(ctx context.Context, id uint, patch scim.PatchRequest, actor string) (scim.User, error)
+	DeleteUser(ctx context.Context, id uint) error
+}
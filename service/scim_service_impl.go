@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/scim"
+)
+
+// scimDeprovisionReason is recorded on terminations caused by setting active to false
+const scimDeprovisionReason = "Deprovisioned through SCIM"
+
+// SCIMServiceImpl implements the SCIMService interface. Users map onto
+// employees: userName and emails are the email address, name and
+// displayName the name, title the position and active the lifecycle
+// status. The enterprise extension carries the employee ID, the department
+// by name and the manager. Attributes employees have no field for, such as
+// externalId, are accepted but not stored.
+type SCIMServiceImpl struct {
+	employeeService EmployeeService
+	departmentRepo  repo.DepartmentRepository
+	now             func() time.Time
+}
+
+// NewSCIMService creates a new instance of SCIMService
+func NewSCIMService(employeeService EmployeeService, departmentRepo repo.DepartmentRepository) SCIMService {
+	return &SCIMServiceImpl{employeeService: employeeService, departmentRepo: departmentRepo, now: time.Now}
+}
+
+// ListUsers returns the page of users matching the query's filter and the
+// total match count. Filters can test any attribute, so they are evaluated
+// against every employee in turn rather than translated to SQL.
+func (s *SCIMServiceImpl) ListUsers(ctx context.Context, query scim.ListQuery) ([]scim.User, int, error) {
+	query.Normalize()
+	var filter scim.Filter
+	if query.Filter != "" {
+		var err error
+		if filter, err = scim.ParseFilter(query.Filter); err != nil {
+			return nil, 0, err
+		}
+	}
+	departments, err := s.departmentRepo.FindAll(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	departmentNames := make(map[uint]string, len(departments))
+	for _, department := range departments {
+		departmentNames[department.ID] = department.Name
+	}
+
+	users := []scim.User{}
+	total := 0
+	err = s.employeeService.ExportEmployees(ctx, models.EmployeeFilter{}, func(employees []models.Employee) error {
+		for _, employee := range employees {
+			var departmentName string
+			if employee.DepartmentID != nil {
+				departmentName = departmentNames[*employee.DepartmentID]
+			}
+			user := toSCIMUser(employee, departmentName)
+			if filter != nil {
+				resource, err := scim.ToMap(user)
+				if err != nil {
+					return err
+				}
+				if !filter.Matches(resource) {
+					continue
+				}
+			}
+			total++
+			if total >= query.StartIndex && len(users) < *query.Count {
+				users = append(users, user)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// GetUser returns the user for an employee
+func (s *SCIMServiceImpl) GetUser(ctx context.Context, id uint) (scim.User, error) {
+	employee, err := s.employeeService.GetEmployeeByID(ctx, id, models.EmployeeView{})
+	if err != nil {
+		return scim.User{}, err
+	}
+	return s.userFor(ctx, employee)
+}
+
+// CreateUser creates an employee for a user. Active users start as active
+// employees and inactive ones as onboarding; neither has a salary until HR
+// sets one.
+func (s *SCIMServiceImpl) CreateUser(ctx context.Context, user scim.User) (scim.User, error) {
+	employee := models.Employee{JoinDate: s.now(), Status: models.StatusActive}
+	if !user.IsActive() {
+		employee.Status = models.StatusOnboarding
+	}
+	if err := s.applyUser(ctx, &employee, user, nil); err != nil {
+		return scim.User{}, err
+	}
+	if err := s.checkUserNameUnique(ctx, employee.Email, 0); err != nil {
+		return scim.User{}, err
+	}
+
+	created, err := s.employeeService.Create(ctx, employee)
+	if err != nil {
+		return scim.User{}, err
+	}
+	return s.userFor(ctx, created)
+}
+
+// ReplaceUser replaces the attributes of a user. Attributes the request
+// leaves out are cleared.
+func (s *SCIMServiceImpl) ReplaceUser(ctx context.Context, id uint, user scim.User, actor string) (scim.User, error) {
+	employee, err := s.employeeService.GetEmployeeByID(ctx, id, models.EmployeeView{})
+	if err != nil {
+		return scim.User{}, err
+	}
+	current, err := s.userFor(ctx, employee)
+	if err != nil {
+		return scim.User{}, err
+	}
+	return s.updateUser(ctx, employee, current, user, actor)
+}
+
+// PatchUser applies PATCH operations to a user
+func (s *SCIMServiceImpl) PatchUser(ctx context.Context, id uint, patch scim.PatchRequest, actor string) (scim.User, error) {
+	if err := patch.Validate(); err != nil {
+		return scim.User{}, err
+	}
+	employee, err := s.employeeService.GetEmployeeByID(ctx, id, models.EmployeeView{})
+	if err != nil {
+		return scim.User{}, err
+	}
+	current, err := s.userFor(ctx, employee)
+	if err != nil {
+		return scim.User{}, err
+	}
+
+	resource, err := scim.ToMap(current)
+	if err != nil {
+		return scim.User{}, err
+	}
+	if err := patch.Apply(resource); err != nil {
+		return scim.User{}, err
+	}
+	var patched scim.User
+	if err := scim.FromMap(resource, &patched); err != nil {
+		return scim.User{}, err
+	}
+	return s.updateUser(ctx, employee, current, patched, actor)
+}
+
+// DeleteUser deletes the employee of a user
+func (s *SCIMServiceImpl) DeleteUser(ctx context.Context, id uint) error {
+	return s.employeeService.Delete(ctx, id)
+}
+
+// updateUser saves the new state of a user. A change of the active
+// attribute goes through the employee lifecycle: deactivating terminates the
+// employee and activating makes an onboarding employee active. Terminated
+// employees cannot be reactivated.
+func (s *SCIMServiceImpl) updateUser(ctx context.Context, employee models.Employee, current, user scim.User, actor string) (scim.User, error) {
+	var nextStatus models.EmployeeStatus
+	switch {
+	case user.IsActive() && !current.IsActive():
+		nextStatus = models.StatusActive
+	case !user.IsActive() && current.IsActive():
+		nextStatus = models.StatusTerminated
+	}
+	if nextStatus != "" && !employee.Status.CanTransitionTo(nextStatus) {
+		return scim.User{}, fmt.Errorf("%w: a %s employee cannot be made active again", models.ErrInvalidTransition, employee.Status)
+	}
+	if err := s.applyUser(ctx, &employee, user, &current); err != nil {
+		return scim.User{}, err
+	}
+	if err := s.checkUserNameUnique(ctx, employee.Email, employee.ID); err != nil {
+		return scim.User{}, err
+	}
+
+	updated, err := s.employeeService.Update(ctx, employee.ID, employee)
+	if err != nil {
+		return scim.User{}, err
+	}
+	if nextStatus != "" {
+		request := models.StatusTransitionRequest{ChangedBy: actor}
+		if nextStatus == models.StatusTerminated {
+			now := s.now()
+			request.EffectiveDate, request.Reason = &now, scimDeprovisionReason
+		}
+		if updated, err = s.employeeService.TransitionEmployee(ctx, employee.ID, nextStatus, request); err != nil {
+			return scim.User{}, err
+		}
+	}
+	return s.userFor(ctx, updated)
+}
+
+// applyUser copies the attributes of a user onto an employee. previous is
+// the user as it was before an update, or nil for a new user; it decides
+// which of the attributes that carry the same field was changed.
+func (s *SCIMServiceImpl) applyUser(ctx context.Context, employee *models.Employee, user scim.User, previous *scim.User) error {
+	var previousNames, previousEmails []string
+	if previous != nil {
+		previousNames, previousEmails = userNames(*previous), userEmails(*previous)
+	}
+	employee.Name = pickChanged(userNames(user), previousNames)
+	if employee.Name == "" {
+		return fmt.Errorf("%w: a user needs a name or displayName", models.ErrValidation)
+	}
+	employee.Email = pickChanged(userEmails(user), previousEmails)
+	if _, err := mail.ParseAddress(employee.Email); err != nil || strings.ContainsAny(employee.Email, "<> ") {
+		return fmt.Errorf("%w: userName must be an email address, got %q", models.ErrValidation, employee.Email)
+	}
+	if user.Title != employee.Position {
+		// A new title unlinks the employee from their catalog position
+		employee.Position, employee.PositionID = user.Title, nil
+	}
+
+	employee.DepartmentID, employee.ManagerID = nil, nil
+	if user.Enterprise == nil {
+		return nil
+	}
+	if user.Enterprise.Department != "" {
+		departmentID, err := s.departmentID(ctx, user.Enterprise.Department)
+		if err != nil {
+			return err
+		}
+		employee.DepartmentID = &departmentID
+	}
+	if manager := user.Enterprise.Manager; manager != nil && manager.Value != "" {
+		managerID, err := strconv.ParseUint(manager.Value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("%w: manager %q is not a user ID", models.ErrValidation, manager.Value)
+		}
+		id := uint(managerID)
+		employee.ManagerID = &id
+	}
+	return nil
+}
+
+// departmentID finds a department by its name, ignoring case
+func (s *SCIMServiceImpl) departmentID(ctx context.Context, name string) (uint, error) {
+	departments, err := s.departmentRepo.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, department := range departments {
+		if strings.EqualFold(department.Name, name) {
+			return department.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: department %q does not exist", models.ErrValidation, name)
+}
+
+// checkUserNameUnique rejects an email address that another employee already
+// uses. userName is not case exact, so addresses that differ only in case
+// clash; the email filter matches exactly, so every employee is compared.
+func (s *SCIMServiceImpl) checkUserNameUnique(ctx context.Context, email string, id uint) error {
+	errTaken := errors.New("taken")
+	var takenBy uint
+	err := s.employeeService.ExportEmployees(ctx, models.EmployeeFilter{}, func(employees []models.Employee) error {
+		for _, employee := range employees {
+			if employee.ID != id && strings.EqualFold(employee.Email, email) {
+				takenBy = employee.ID
+				return errTaken
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errTaken) {
+		return scim.NewError(http.StatusConflict, scim.ErrTypeUniqueness, fmt.Sprintf("userName %q is already taken by user %d", email, takenBy))
+	}
+	return err
+}
+
+// userFor returns the user for an employee, looking up their department's name
+func (s *SCIMServiceImpl) userFor(ctx context.Context, employee models.Employee) (scim.User, error) {
+	var departmentName string
+	if employee.DepartmentID != nil {
+		department, err := s.departmentRepo.FindByID(ctx, *employee.DepartmentID)
+		if err != nil {
+			return scim.User{}, err
+		}
+		departmentName = department.Name
+	}
+	return toSCIMUser(employee, departmentName), nil
+}
+
+// toSCIMUser maps an employee onto a user. The name is split at its first
+// space into the given and family names. Employees on leave are still active.
+func toSCIMUser(employee models.Employee, departmentName string) scim.User {
+	id := strconv.FormatUint(uint64(employee.ID), 10)
+	active := employee.Status == models.StatusActive || employee.Status == models.StatusOnLeave
+	givenName, familyName, _ := strings.Cut(employee.Name, " ")
+	created, lastModified := employee.CreatedAt, employee.UpdatedAt
+	user := scim.User{
+		Schemas:     []string{scim.UserSchema, scim.EnterpriseUserSchema},
+		ID:          id,
+		UserName:    employee.Email,
+		Name:        &scim.Name{Formatted: employee.Name, GivenName: givenName, FamilyName: familyName},
+		DisplayName: employee.Name,
+		Title:       employee.Position,
+		Active:      &active,
+		Emails:      []scim.Email{{Value: employee.Email, Type: "work", Primary: true}},
+		Enterprise:  &scim.EnterpriseUser{EmployeeNumber: id, Department: departmentName},
+		Meta:        &scim.Meta{ResourceType: "User", Created: &created, LastModified: &lastModified},
+	}
+	if employee.ManagerID != nil {
+		user.Enterprise.Manager = &scim.Manager{Value: strconv.FormatUint(uint64(*employee.ManagerID), 10)}
+	}
+	return user
+}
+
+// userNames lists the attributes that can carry a user's name, in order of preference
+func userNames(user scim.User) []string {
+	var formatted, composed string
+	if user.Name != nil {
+		formatted = user.Name.Formatted
+		composed = strings.TrimSpace(user.Name.GivenName + " " + user.Name.FamilyName)
+	}
+	return []string{strings.TrimSpace(formatted), composed, strings.TrimSpace(user.DisplayName)}
+}
+
+// userEmails lists the attributes that can carry a user's email address, in order of preference
+func userEmails(user scim.User) []string {
+	return []string{strings.TrimSpace(user.UserName), strings.TrimSpace(user.PrimaryEmail())}
+}
+
+// pickChanged returns the first value that differs from its previous value,
+// so that an update to any one of several attributes mapped onto the same
+// field takes effect. Without a change it returns the first non-empty value.
+func pickChanged(values, previous []string) string {
+	if previous != nil {
+		for i, value := range values {
+			if value != "" && value != previous[i] {
+				return value
+			}
+		}
+	}
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
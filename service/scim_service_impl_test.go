@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	repomocks "github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/chinmay-sawant/gin-example/scim"
+	"github.com/chinmay-sawant/gin-example/service/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type SCIMServiceTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	employees      *mocks.MockEmployeeService
+	departmentRepo *repomocks.MockDepartmentRepository
+	now            time.Time
+	svc            *SCIMServiceImpl
+}
+
+func (suite *SCIMServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.employees = mocks.NewMockEmployeeService(suite.ctrl)
+	suite.departmentRepo = repomocks.NewMockDepartmentRepository(suite.ctrl)
+	suite.now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	suite.svc = NewSCIMService(suite.employees, suite.departmentRepo).(*SCIMServiceImpl)
+	suite.svc.now = func() time.Time { return suite.now }
+}
+
+func (suite *SCIMServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestSCIMServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SCIMServiceTestSuite))
+}
+
+func uintPtr(id uint) *uint {
+	return &id
+}
+
+// expectUnique expects a userName uniqueness check over the given employees
+func (suite *SCIMServiceTestSuite) expectUnique(employees ...models.Employee) {
+	suite.employees.EXPECT().ExportEmployees(gomock.Any(), models.EmployeeFilter{}, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ models.EmployeeFilter, fn func([]models.Employee) error) error {
+			return fn(employees)
+		})
+}
+
+func (suite *SCIMServiceTestSuite) TestListUsersFiltersAndPages() {
+	suite.departmentRepo.EXPECT().FindAll(gomock.Any()).Return([]models.Department{{ID: 1, Name: "Engineering"}}, nil)
+	suite.employees.EXPECT().ExportEmployees(gomock.Any(), models.EmployeeFilter{}, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ models.EmployeeFilter, fn func([]models.Employee) error) error {
+			suite.NoError(fn([]models.Employee{
+				{ID: 1, Name: "Alice Smith", Email: "alice@example.com", DepartmentID: uintPtr(1), Status: models.StatusActive},
+				{ID: 2, Name: "Bob Jones", Email: "bob@example.com", Status: models.StatusActive},
+			}))
+			return fn([]models.Employee{
+				{ID: 3, Name: "Carol Lee", Email: "carol@example.com", DepartmentID: uintPtr(1), Status: models.StatusOnLeave},
+				{ID: 4, Name: "Dan Ray", Email: "dan@example.com", DepartmentID: uintPtr(1), Status: models.StatusTerminated},
+			})
+		})
+
+	count := 1
+	users, total, err := suite.svc.ListUsers(testCtx, scim.ListQuery{
+		Filter:     `urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "engineering" and active eq true`,
+		StartIndex: 2,
+		Count:      &count,
+	})
+	suite.NoError(err)
+	suite.Equal(2, total)
+	suite.Require().Len(users, 1)
+	suite.Equal("3", users[0].ID)
+	suite.Equal("Carol", users[0].Name.GivenName)
+	suite.Equal("Lee", users[0].Name.FamilyName)
+}
+
+func (suite *SCIMServiceTestSuite) TestListUsersInvalidFilter() {
+	_, _, err := suite.svc.ListUsers(testCtx, scim.ListQuery{Filter: `userName eq`})
+	var scimErr *scim.Error
+	suite.Require().ErrorAs(err, &scimErr)
+	suite.Equal(scim.ErrTypeInvalidFilter, scimErr.ScimType)
+}
+
+func (suite *SCIMServiceTestSuite) TestCreateUser() {
+	inactive := false
+	suite.departmentRepo.EXPECT().FindAll(gomock.Any()).Return([]models.Department{{ID: 1, Name: "Engineering"}, {ID: 2, Name: "Design"}}, nil)
+	suite.expectUnique(models.Employee{ID: 1, Email: "alice@example.com"})
+	suite.employees.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e models.Employee) (models.Employee, error) {
+		suite.Equal(models.Employee{
+			Name: "Fay Wong", Email: "fay@example.com", Position: "Engineer", DepartmentID: uintPtr(2),
+			ManagerID: uintPtr(3), Status: models.StatusOnboarding, JoinDate: suite.now,
+		}, e)
+		e.ID = 6
+		return e, nil
+	})
+	suite.departmentRepo.EXPECT().FindByID(gomock.Any(), uint(2)).Return(models.Department{ID: 2, Name: "Design"}, nil)
+
+	user, err := suite.svc.CreateUser(testCtx, scim.User{
+		Schemas:    []string{scim.UserSchema},
+		ExternalID: "okta-123",
+		UserName:   "fay@example.com",
+		Name:       &scim.Name{GivenName: "Fay", FamilyName: "Wong"},
+		Title:      "Engineer",
+		Active:     &inactive,
+		Enterprise: &scim.EnterpriseUser{Department: "design", Manager: &scim.Manager{Value: "3"}},
+	})
+	suite.NoError(err)
+	suite.Equal("6", user.ID)
+	suite.Equal("Design", user.Enterprise.Department)
+	suite.Equal("6", user.Enterprise.EmployeeNumber)
+	suite.False(user.IsActive())
+}
+
+func (suite *SCIMServiceTestSuite) TestCreateUserRejectsInvalidAttributes() {
+	_, err := suite.svc.CreateUser(testCtx, scim.User{UserName: "fay@example.com"})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	_, err = suite.svc.CreateUser(testCtx, scim.User{UserName: "not-an-email", DisplayName: "Fay"})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	suite.departmentRepo.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+	_, err = suite.svc.CreateUser(testCtx, scim.User{UserName: "fay@example.com", DisplayName: "Fay",
+		Enterprise: &scim.EnterpriseUser{Department: "Sales"}})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	_, err = suite.svc.CreateUser(testCtx, scim.User{UserName: "fay@example.com", DisplayName: "Fay",
+		Enterprise: &scim.EnterpriseUser{Manager: &scim.Manager{Value: "boss"}}})
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *SCIMServiceTestSuite) TestCreateUserRejectsTakenUserName() {
+	suite.expectUnique(models.Employee{ID: 1, Email: "ALICE@example.com"})
+
+	_, err := suite.svc.CreateUser(testCtx, scim.User{UserName: "alice@example.com", DisplayName: "Alice"})
+	var scimErr *scim.Error
+	suite.Require().ErrorAs(err, &scimErr)
+	suite.Equal(scim.ErrTypeUniqueness, scimErr.ScimType)
+	suite.Equal(409, scimErr.StatusCode())
+}
+
+func (suite *SCIMServiceTestSuite) TestPatchUserUpdatesChangedAttribute() {
+	alice := models.Employee{ID: 1, Name: "Alice Smith", Email: "alice@example.com", Position: "Developer",
+		PositionID: uintPtr(1), Salary: 70000, Status: models.StatusActive}
+	suite.employees.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).Return(alice, nil)
+	suite.expectUnique(alice)
+	suite.employees.EXPECT().Update(gomock.Any(), uint(1), gomock.Any()).DoAndReturn(func(_ context.Context, _ uint, e models.Employee) (models.Employee, error) {
+		// Only the family name changed, the rest of the record is kept
+		suite.Equal("Alice Jones", e.Name)
+		suite.Equal(uintPtr(1), e.PositionID)
+		suite.Equal(70000.0, e.Salary)
+		suite.Equal(models.StatusActive, e.Status)
+		return e, nil
+	})
+
+	user, err := suite.svc.PatchUser(testCtx, 1, scim.PatchRequest{
+		Schemas:    []string{scim.PatchOpSchema},
+		Operations: []scim.PatchOperation{{Op: "replace", Path: "name.familyName", Value: "Jones"}},
+	}, "okta")
+	suite.NoError(err)
+	suite.Equal("Alice Jones", user.DisplayName)
+}
+
+func (suite *SCIMServiceTestSuite) TestPatchUserDeactivationTerminates() {
+	alice := models.Employee{ID: 1, Name: "Alice Smith", Email: "alice@example.com", Position: "Developer", Status: models.StatusOnLeave}
+	suite.employees.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).Return(alice, nil)
+	suite.expectUnique(alice)
+	suite.employees.EXPECT().Update(gomock.Any(), uint(1), alice).Return(alice, nil)
+	terminated := alice
+	terminated.Status = models.StatusTerminated
+	suite.employees.EXPECT().TransitionEmployee(gomock.Any(), uint(1), models.StatusTerminated, models.StatusTransitionRequest{
+		EffectiveDate: &suite.now, Reason: scimDeprovisionReason, ChangedBy: "okta",
+	}).Return(terminated, nil)
+
+	user, err := suite.svc.PatchUser(testCtx, 1, scim.PatchRequest{
+		Schemas:    []string{scim.PatchOpSchema},
+		Operations: []scim.PatchOperation{{Op: "Replace", Value: map[string]interface{}{"active": "False"}}},
+	}, "okta")
+	suite.NoError(err)
+	suite.False(user.IsActive())
+}
+
+func (suite *SCIMServiceTestSuite) TestReplaceUserCannotReactivateTerminated() {
+	suite.employees.EXPECT().GetEmployeeByID(gomock.Any(), uint(1), models.EmployeeView{}).Return(
+		models.Employee{ID: 1, Name: "Alice Smith", Email: "alice@example.com", Status: models.StatusTerminated}, nil)
+
+	_, err := suite.svc.ReplaceUser(testCtx, 1, scim.User{UserName: "alice@example.com", DisplayName: "Alice Smith"}, "okta")
+	suite.ErrorIs(err, models.ErrInvalidTransition)
+}
+
+func (suite *SCIMServiceTestSuite) TestReplaceUserActivatesOnboarding() {
+	active := true
+	dan := models.Employee{ID: 4, Name: "Dan Ray", Email: "dan@example.com", Position: "QA", Status: models.StatusOnboarding}
+	suite.employees.EXPECT().GetEmployeeByID(gomock.Any(), uint(4), models.EmployeeView{}).Return(dan, nil)
+	suite.expectUnique(dan)
+	suite.employees.EXPECT().Update(gomock.Any(), uint(4), gomock.Any()).DoAndReturn(func(_ context.Context, _ uint, e models.Employee) (models.Employee, error) {
+		suite.Equal("dan.ray@example.com", e.Email)
+		suite.Empty(e.Position, "a replace clears attributes it leaves out")
+		return e, nil
+	})
+	dan.Status = models.StatusActive
+	suite.employees.EXPECT().TransitionEmployee(gomock.Any(), uint(4), models.StatusActive, models.StatusTransitionRequest{ChangedBy: "okta"}).Return(dan, nil)
+
+	user, err := suite.svc.ReplaceUser(testCtx, 4, scim.User{UserName: "dan.ray@example.com", DisplayName: "Dan Ray", Active: &active}, "okta")
+	suite.NoError(err)
+	suite.True(user.IsActive())
+}
+
+func (suite *SCIMServiceTestSuite) TestGetAndDeleteUserNotFound() {
+	suite.employees.EXPECT().GetEmployeeByID(gomock.Any(), uint(9), models.EmployeeView{}).Return(models.Employee{}, models.ErrEmployeeNotFound)
+	_, err := suite.svc.GetUser(testCtx, 9)
+	suite.ErrorIs(err, models.ErrEmployeeNotFound)
+
+	suite.employees.EXPECT().Delete(gomock.Any(), uint(9)).Return(models.ErrEmployeeNotFound)
+	suite.ErrorIs(suite.svc.DeleteUser(testCtx, 9), models.ErrEmployeeNotFound)
+}
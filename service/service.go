@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// Service is the business logic every resource has: a filtered page of
+// records, and reading, creating, updating and deleting one by its ID
+type Service[T any, ID comparable] interface {
+	List(ctx context.Context, query models.ListQuery) ([]T, int64, error)
+	Get(ctx context.Context, id ID) (T, error)
+	Create(ctx context.Context, entity T) (T, error)
+	Update(ctx context.Context, id ID, entity T) (T, error)
+	Delete(ctx context.Context, id ID) error
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// AfterWrite runs once a create or update has been saved
+type AfterWrite[T any] func(ctx context.Context, saved T) error
+
+// Hooks add the rules of a resource to the generic service
+type Hooks[T any, ID comparable] struct {
+	// BeforeCreate and BeforeUpdate validate an entity and fill in derived
+	// fields before it is saved. The AfterWrite they return, if any, runs
+	// once it has been, with what was saved.
+	BeforeCreate func(ctx context.Context, entity *T) (AfterWrite[T], error)
+	BeforeUpdate func(ctx context.Context, id ID, entity *T) (AfterWrite[T], error)
+	// AfterCommit runs once a created or updated record has been committed,
+	// for side effects outside the database such as search indexing
+	AfterCommit func(ctx context.Context, saved T)
+	// AfterDelete runs once a record has been deleted
+	AfterDelete func(ctx context.Context, id ID)
+}
+
+// serviceOptions holds the optional behaviour of a generic service
+type serviceOptions struct {
+	unitOfWork repo.UnitOfWork
+}
+
+// ServiceOption configures optional generic service behaviour
+type ServiceOption func(*serviceOptions)
+
+// Transactional runs each create and update in a transaction of unitOfWork,
+// together with its hooks, so an AfterWrite that fails rolls the write back
+func Transactional(unitOfWork repo.UnitOfWork) ServiceOption {
+	return func(o *serviceOptions) {
+		o.unitOfWork = unitOfWork
+	}
+}
+
+// CRUDService implements Service over a Repository
+type CRUDService[T any, ID comparable] struct {
+	repository repo.Repository[T, ID]
+	hooks      Hooks[T, ID]
+	options    serviceOptions
+}
+
+// NewService creates the generic service of a resource
+func NewService[T any, ID comparable](repository repo.Repository[T, ID], hooks Hooks[T, ID], opts ...ServiceOption) Service[T, ID] {
+	s := &CRUDService[T, ID]{repository: repository, hooks: hooks}
+	for _, opt := range opts {
+		opt(&s.options)
+	}
+	return s
+}
+
+// List returns one page of the records matching the query and the total match count
+func (s *CRUDService[T, ID]) List(ctx context.Context, query models.ListQuery) ([]T, int64, error) {
+	query.Normalize()
+	return s.repository.List(ctx, query)
+}
+
+// Get returns a record by ID
+func (s *CRUDService[T, ID]) Get(ctx context.Context, id ID) (T, error) {
+	return s.repository.FindByID(ctx, id)
+}
+
+// Create validates and saves a new record
+func (s *CRUDService[T, ID]) Create(ctx context.Context, entity T) (T, error) {
+	return s.save(ctx, func(ctx context.Context) (AfterWrite[T], error) {
+		if s.hooks.BeforeCreate == nil {
+			return nil, nil
+		}
+		return s.hooks.BeforeCreate(ctx, &entity)
+	}, func(ctx context.Context) (T, error) {
+		return s.repository.Create(ctx, entity)
+	})
+}
+
+// Update validates and saves the new state of an existing record
+func (s *CRUDService[T, ID]) Update(ctx context.Context, id ID, entity T) (T, error) {
+	return s.save(ctx, func(ctx context.Context) (AfterWrite[T], error) {
+		if s.hooks.BeforeUpdate == nil {
+			return nil, nil
+		}
+		return s.hooks.BeforeUpdate(ctx, id, &entity)
+	}, func(ctx context.Context) (T, error) {
+		return s.repository.Update(ctx, id, entity)
+	})
+}
+
+// Delete deletes a record by ID
+func (s *CRUDService[T, ID]) Delete(ctx context.Context, id ID) error {
+	if err := s.repository.Delete(ctx, id); err != nil {
+		return err
+	}
+	if s.hooks.AfterDelete != nil {
+		s.hooks.AfterDelete(ctx, id)
+	}
+	return nil
+}
+
+// save runs a before hook, the write and the AfterWrite the hook returned,
+// then AfterCommit once the write has been committed. With a unit of work
+// that is when the outermost unit commits, which may be a caller's. Without
+// one the write is committed even when the AfterWrite fails.
+func (s *CRUDService[T, ID]) save(ctx context.Context, before func(context.Context) (AfterWrite[T], error), write func(context.Context) (T, error)) (T, error) {
+	var saved T
+	written := false
+	err := s.transaction(ctx, func(ctx context.Context) error {
+		after, err := before(ctx)
+		if err != nil {
+			return err
+		}
+		if saved, err = write(ctx); err != nil {
+			return err
+		}
+		written = true
+		if after != nil {
+			if err := after(ctx, saved); err != nil {
+				return err
+			}
+		}
+		if s.options.unitOfWork != nil && s.hooks.AfterCommit != nil {
+			committed := saved
+			repo.AfterCommit(ctx, func(ctx context.Context) { s.hooks.AfterCommit(ctx, committed) })
+		}
+		return nil
+	})
+	if s.options.unitOfWork == nil && written && s.hooks.AfterCommit != nil {
+		s.hooks.AfterCommit(ctx, saved)
+	}
+	return saved, err
+}
+
+// transaction runs fn in a unit of work when the service has one
+func (s *CRUDService[T, ID]) transaction(ctx context.Context, fn func(context.Context) error) error {
+	if s.options.unitOfWork == nil {
+		return fn(ctx)
+	}
+	return s.options.unitOfWork.Do(ctx, fn)
+}
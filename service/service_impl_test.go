@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/db"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+type CRUDServiceTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	repo *mocks.MockRepository[models.Department, uint]
+}
+
+func (suite *CRUDServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mocks.NewMockRepository[models.Department, uint](suite.ctrl)
+}
+
+func (suite *CRUDServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestCRUDServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CRUDServiceTestSuite))
+}
+
+func (suite *CRUDServiceTestSuite) TestListNormalizesPagination() {
+	svc := NewService(suite.repo, Hooks[models.Department, uint]{})
+	expected := models.ListQuery{Pagination: models.Pagination{Page: 1, PageSize: models.DefaultPageSize}}
+	suite.repo.EXPECT().List(gomock.Any(), expected).Return([]models.Department{{ID: 1}}, int64(1), nil)
+
+	departments, total, err := svc.List(testCtx, models.ListQuery{})
+	suite.NoError(err)
+	suite.Len(departments, 1)
+	suite.Equal(int64(1), total)
+}
+
+func (suite *CRUDServiceTestSuite) TestCreateRunsHooksAroundTheWrite() {
+	var followedUp models.Department
+	svc := NewService(suite.repo, Hooks[models.Department, uint]{
+		BeforeCreate: func(_ context.Context, d *models.Department) (AfterWrite[models.Department], error) {
+			if d.Name == "" {
+				return nil, models.ErrValidation
+			}
+			d.Description = "checked"
+			return func(_ context.Context, saved models.Department) error {
+				followedUp = saved
+				return nil
+			}, nil
+		},
+	})
+
+	_, err := svc.Create(testCtx, models.Department{})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	suite.repo.EXPECT().Create(gomock.Any(), models.Department{Name: "Ops", Description: "checked"}).
+		Return(models.Department{ID: 4, Name: "Ops", Description: "checked"}, nil)
+	created, err := svc.Create(testCtx, models.Department{Name: "Ops"})
+	suite.NoError(err)
+	suite.Equal(created, followedUp)
+}
+
+func (suite *CRUDServiceTestSuite) TestUpdateSkipsFollowUpWhenTheWriteFails() {
+	called := false
+	svc := NewService(suite.repo, Hooks[models.Department, uint]{
+		BeforeUpdate: func(_ context.Context, id uint, _ *models.Department) (AfterWrite[models.Department], error) {
+			suite.Equal(uint(4), id)
+			return func(context.Context, models.Department) error {
+				called = true
+				return nil
+			}, nil
+		},
+	})
+
+	suite.repo.EXPECT().Update(gomock.Any(), uint(4), gomock.Any()).Return(models.Department{}, errors.New("db down"))
+	_, err := svc.Update(testCtx, 4, models.Department{Name: "Ops"})
+	suite.Error(err)
+	suite.False(called)
+}
+
+func (suite *CRUDServiceTestSuite) TestDeleteRunsAfterDelete() {
+	var deleted []uint
+	svc := NewService(suite.repo, Hooks[models.Department, uint]{
+		AfterDelete: func(_ context.Context, id uint) { deleted = append(deleted, id) },
+	})
+
+	suite.repo.EXPECT().Delete(gomock.Any(), uint(4)).Return(nil)
+	suite.repo.EXPECT().Delete(gomock.Any(), uint(5)).Return(models.ErrDepartmentNotFound)
+	suite.NoError(svc.Delete(testCtx, 4))
+	suite.ErrorIs(svc.Delete(testCtx, 5), models.ErrDepartmentNotFound)
+	suite.Equal([]uint{4}, deleted)
+}
+
+func (suite *CRUDServiceTestSuite) TestTransactionalWritesCommitBeforeAfterCommit() {
+	type txMarker struct{}
+	unitOfWork := mocks.NewMockUnitOfWork(suite.ctrl)
+	unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(context.WithValue(ctx, txMarker{}, true))
+		})
+	var committed []models.Department
+	svc := NewService(suite.repo, Hooks[models.Department, uint]{
+		BeforeCreate: func(ctx context.Context, d *models.Department) (AfterWrite[models.Department], error) {
+			suite.Equal(true, ctx.Value(txMarker{}))
+			return func(ctx context.Context, saved models.Department) error {
+				suite.Equal(true, ctx.Value(txMarker{}))
+				if saved.Name == "Broken" {
+					return errors.New("follow-up failed")
+				}
+				return nil
+			}, nil
+		},
+		AfterCommit: func(_ context.Context, saved models.Department) { committed = append(committed, saved) },
+	}, Transactional(unitOfWork))
+
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, d models.Department) (models.Department, error) {
+			suite.Equal(true, ctx.Value(txMarker{}))
+			d.ID = 4
+			return d, nil
+		}).Times(2)
+	_, err := svc.Create(testCtx, models.Department{Name: "Broken"})
+	suite.Error(err)
+	suite.Empty(committed, "a rolled back write is not followed up")
+
+	created, err := svc.Create(testCtx, models.Department{Name: "Ops"})
+	suite.NoError(err)
+	suite.Equal([]models.Department{created}, committed)
+}
+
+func (suite *CRUDServiceTestSuite) TestAfterCommitWaitsForTheOutermostUnitOfWork() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+	previous := db.DB
+	db.DB = database
+	defer func() { db.DB = previous }()
+
+	unitOfWork := repo.NewUnitOfWork()
+	var committed []models.Department
+	svc := NewService(suite.repo, Hooks[models.Department, uint]{
+		AfterCommit: func(_ context.Context, saved models.Department) { committed = append(committed, saved) },
+	}, Transactional(unitOfWork))
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(models.Department{ID: 4, Name: "Ops"}, nil).Times(2)
+
+	// The caller's unit of work rolls back after the service's savepoint was released
+	err = unitOfWork.Do(testCtx, func(ctx context.Context) error {
+		if _, err := svc.Create(ctx, models.Department{Name: "Ops"}); err != nil {
+			return err
+		}
+		suite.Empty(committed)
+		return errors.New("caller failed")
+	})
+	suite.Error(err)
+	suite.Empty(committed)
+
+	err = unitOfWork.Do(testCtx, func(ctx context.Context) error {
+		_, err := svc.Create(ctx, models.Department{Name: "Ops"})
+		suite.Empty(committed)
+		return err
+	})
+	suite.NoError(err)
+	suite.Equal([]models.Department{{ID: 4, Name: "Ops"}}, committed)
+}
+
+func (suite *CRUDServiceTestSuite) TestAfterCommitRunsWhenTheWriteWasCommittedWithoutAUnitOfWork() {
+	var committed []models.Department
+	svc := NewService(suite.repo, Hooks[models.Department, uint]{
+		BeforeUpdate: func(context.Context, uint, *models.Department) (AfterWrite[models.Department], error) {
+			return func(context.Context, models.Department) error { return errors.New("follow-up failed") }, nil
+		},
+		AfterCommit: func(_ context.Context, saved models.Department) { committed = append(committed, saved) },
+	})
+
+	suite.repo.EXPECT().Update(gomock.Any(), uint(4), gomock.Any()).Return(models.Department{ID: 4, Name: "Ops"}, nil)
+	_, err := svc.Update(testCtx, 4, models.Department{Name: "Ops"})
+	suite.Error(err)
+	suite.Equal([]models.Department{{ID: 4, Name: "Ops"}}, committed)
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// TagService defines the interface for tagging employees
+type TagService interface {
+	GetAllTags(ctx context.Context) ([]models.TagCount, error)
+	GetEmployeeTags(ctx context.Context, employeeID uint) ([]string, error)
+	AddEmployeeTags(ctx context.Context, employeeID uint, names []string) ([]string, error)
+	RemoveEmployeeTag(ctx context.Context, employeeID uint, name string) ([]string, error)
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+)
+
+// TagServiceImpl implements the TagService interface
+type TagServiceImpl struct {
+	tagRepo      repo.TagRepository
+	employeeRepo repo.EmployeeRepository
+	unitOfWork   repo.UnitOfWork
+}
+
+// NewTagService creates a new instance of TagService. Tags change in a unit
+// of work with the check that their employee exists.
+func NewTagService(tagRepo repo.TagRepository, employeeRepo repo.EmployeeRepository, unitOfWork repo.UnitOfWork) TagService {
+	return &TagServiceImpl{
+		tagRepo:      tagRepo,
+		employeeRepo: employeeRepo,
+		unitOfWork:   unitOfWork,
+	}
+}
+
+// GetAllTags returns the tags employees carry with their employee counts, by name
+func (s *TagServiceImpl) GetAllTags(ctx context.Context) ([]models.TagCount, error) {
+	return s.tagRepo.FindAll(ctx)
+}
+
+// GetEmployeeTags returns the tags of the employee, by name
+func (s *TagServiceImpl) GetEmployeeTags(ctx context.Context, employeeID uint) ([]string, error) {
+	if _, err := s.employeeRepo.FindByID(ctx, employeeID); err != nil {
+		return nil, err
+	}
+	return s.tagRepo.FindByEmployeeID(ctx, employeeID)
+}
+
+// AddEmployeeTags gives the employee the tags and returns all their tags.
+// Names are normalized to lowercase.
+func (s *TagServiceImpl) AddEmployeeTags(ctx context.Context, employeeID uint, names []string) ([]string, error) {
+	tags, err := models.ParseTags(names)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := s.employeeRepo.FindByID(ctx, employeeID); err != nil {
+			return err
+		}
+		if err := s.tagRepo.AddToEmployee(ctx, employeeID, tags); err != nil {
+			return err
+		}
+		result, err = s.tagRepo.FindByEmployeeID(ctx, employeeID)
+		return err
+	})
+	return result, err
+}
+
+// RemoveEmployeeTag takes the tag away from the employee and returns their remaining tags
+func (s *TagServiceImpl) RemoveEmployeeTag(ctx context.Context, employeeID uint, name string) ([]string, error) {
+	tag, err := models.NormalizeTag(name)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if _, err := s.employeeRepo.FindByID(ctx, employeeID); err != nil {
+			return err
+		}
+		if err := s.tagRepo.RemoveFromEmployee(ctx, employeeID, tag); err != nil {
+			return err
+		}
+		result, err = s.tagRepo.FindByEmployeeID(ctx, employeeID)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type TagServiceTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	tagRepo    *mocks.MockTagRepository
+	empRepo    *mocks.MockEmployeeRepository
+	unitOfWork *mocks.MockUnitOfWork
+	svc        TagService
+}
+
+func (suite *TagServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.tagRepo = mocks.NewMockTagRepository(suite.ctrl)
+	suite.empRepo = mocks.NewMockEmployeeRepository(suite.ctrl)
+	suite.unitOfWork = mocks.NewMockUnitOfWork(suite.ctrl)
+	suite.unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	suite.svc = NewTagService(suite.tagRepo, suite.empRepo, suite.unitOfWork)
+}
+
+func (suite *TagServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestTagServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TagServiceTestSuite))
+}
+
+func (suite *TagServiceTestSuite) TestAddEmployeeTagsNormalizesNames() {
+	_, err := suite.svc.AddEmployeeTags(testCtx, 1, []string{"oncall", "on call"})
+	suite.ErrorIs(err, models.ErrValidation)
+
+	suite.empRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{ID: 1}, nil)
+	suite.tagRepo.EXPECT().AddToEmployee(gomock.Any(), uint(1), []string{"on-call", "remote"}).Return(nil)
+	suite.tagRepo.EXPECT().FindByEmployeeID(gomock.Any(), uint(1)).Return([]string{"mentor", "on-call", "remote"}, nil)
+	tags, err := suite.svc.AddEmployeeTags(testCtx, 1, []string{" On-Call", "remote", "on-call"})
+	suite.NoError(err)
+	suite.Equal([]string{"mentor", "on-call", "remote"}, tags)
+}
+
+func (suite *TagServiceTestSuite) TestTagsOfUnknownEmployees() {
+	suite.empRepo.EXPECT().FindByID(gomock.Any(), uint(9)).Return(models.Employee{}, models.ErrEmployeeNotFound).Times(3)
+	_, err := suite.svc.GetEmployeeTags(testCtx, 9)
+	suite.ErrorIs(err, models.ErrEmployeeNotFound)
+	_, err = suite.svc.AddEmployeeTags(testCtx, 9, []string{"remote"})
+	suite.ErrorIs(err, models.ErrEmployeeNotFound)
+	_, err = suite.svc.RemoveEmployeeTag(testCtx, 9, "remote")
+	suite.ErrorIs(err, models.ErrEmployeeNotFound)
+}
+
+func (suite *TagServiceTestSuite) TestRemoveEmployeeTag() {
+	suite.empRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Employee{ID: 1}, nil).Times(2)
+	suite.tagRepo.EXPECT().RemoveFromEmployee(gomock.Any(), uint(1), "remote").Return(nil)
+	suite.tagRepo.EXPECT().FindByEmployeeID(gomock.Any(), uint(1)).Return([]string{"mentor"}, nil)
+	tags, err := suite.svc.RemoveEmployeeTag(testCtx, 1, "Remote")
+	suite.NoError(err)
+	suite.Equal([]string{"mentor"}, tags)
+
+	suite.tagRepo.EXPECT().RemoveFromEmployee(gomock.Any(), uint(1), "oncall").Return(models.ErrTagNotFound)
+	_, err = suite.svc.RemoveEmployeeTag(testCtx, 1, "oncall")
+	suite.ErrorIs(err, models.ErrTagNotFound)
+}
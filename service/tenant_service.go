@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// TenantService defines the interface for managing the tenants sharing the deployment
+type TenantService interface {
+	GetAllTenants(ctx context.Context) ([]models.Tenant, error)
+	GetTenantByID(ctx context.Context, id string) (models.Tenant, error)
+	CreateTenant(ctx context.Context, tenant models.Tenant) (models.Tenant, error)
+	UpdateTenant(ctx context.Context, id string, tenant models.Tenant) (models.Tenant, error)
+	DeleteTenant(ctx context.Context, id string) error
+}
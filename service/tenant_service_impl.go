@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+// TenantServiceImpl implements the TenantService interface
+type TenantServiceImpl struct {
+	tenantRepo repo.TenantRepository
+}
+
+// NewTenantService creates a new instance of TenantService
+func NewTenantService(tenantRepo repo.TenantRepository) TenantService {
+	return &TenantServiceImpl{tenantRepo: tenantRepo}
+}
+
+// GetAllTenants returns every tenant
+func (s *TenantServiceImpl) GetAllTenants(ctx context.Context) ([]models.Tenant, error) {
+	return s.tenantRepo.FindAll(ctx)
+}
+
+// GetTenantByID returns a tenant by ID
+func (s *TenantServiceImpl) GetTenantByID(ctx context.Context, id string) (models.Tenant, error) {
+	return s.tenantRepo.FindByID(ctx, id)
+}
+
+// CreateTenant adds a tenant, which starts without any data
+func (s *TenantServiceImpl) CreateTenant(ctx context.Context, t models.Tenant) (models.Tenant, error) {
+	if err := t.Validate(); err != nil {
+		return models.Tenant{}, err
+	}
+	t.Currency = strings.ToUpper(t.Currency)
+	return s.tenantRepo.Create(ctx, t)
+}
+
+// UpdateTenant replaces a tenant's name and settings
+func (s *TenantServiceImpl) UpdateTenant(ctx context.Context, id string, t models.Tenant) (models.Tenant, error) {
+	t.Currency = strings.ToUpper(t.Currency)
+	return s.tenantRepo.Update(ctx, id, t)
+}
+
+// DeleteTenant removes a tenant without employees. The default tenant,
+// which requests naming no tenant run for, cannot be removed.
+func (s *TenantServiceImpl) DeleteTenant(ctx context.Context, id string) error {
+	if id == tenant.Default {
+		return fmt.Errorf("%w: the default tenant cannot be deleted", models.ErrConflict)
+	}
+	return s.tenantRepo.Delete(ctx, id)
+}
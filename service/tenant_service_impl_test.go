@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/chinmay-sawant/gin-example/tenant"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type TenantServiceTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	repo *mocks.MockTenantRepository
+	svc  TenantService
+}
+
+func (suite *TenantServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mocks.NewMockTenantRepository(suite.ctrl)
+	suite.svc = NewTenantService(suite.repo)
+}
+
+func (suite *TenantServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestTenantServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TenantServiceTestSuite))
+}
+
+func (suite *TenantServiceTestSuite) TestCreateTenant() {
+	expected := models.Tenant{ID: "acme", Name: "Acme GmbH", Currency: "EUR"}
+	suite.repo.EXPECT().Create(gomock.Any(), expected).Return(expected, nil)
+
+	result, err := suite.svc.CreateTenant(testCtx, models.Tenant{ID: "acme", Name: "Acme GmbH", Currency: "eur"})
+	suite.NoError(err)
+	suite.Equal("EUR", result.Currency)
+
+	_, err = suite.svc.CreateTenant(testCtx, models.Tenant{ID: "Acme.Corp", Name: "Acme"})
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *TenantServiceTestSuite) TestDeleteTenant() {
+	suite.ErrorIs(suite.svc.DeleteTenant(testCtx, tenant.Default), models.ErrConflict)
+
+	suite.repo.EXPECT().Delete(gomock.Any(), "acme").Return(models.ErrConflict)
+	suite.ErrorIs(suite.svc.DeleteTenant(testCtx, "acme"), models.ErrConflict)
+}
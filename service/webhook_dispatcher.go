@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/tenant"
+)
+
+// DefaultWebhookPollInterval is how often the dispatcher looks for due deliveries
+const DefaultWebhookPollInterval = time.Second
+
+// RunWebhookDispatcher sends due webhook deliveries every interval until ctx
+// is done. Deliveries are queued by the outbox relay's webhook sink.
+func RunWebhookDispatcher(ctx context.Context, webhookService WebhookService, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultWebhookPollInterval
+	}
+	// Due deliveries are sent for every tenant
+	ctx = tenant.System(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := webhookService.DeliverDue(ctx); err != nil {
+				log.Printf("webhooks: sending due deliveries: %v", err)
+			}
+		}
+	}
+}
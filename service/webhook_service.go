@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// WebhookService defines the interface for webhook subscriptions and their deliveries
+type WebhookService interface {
+	GetAllWebhooks(ctx context.Context) ([]models.Webhook, error)
+	GetWebhookByID(ctx context.Context, id uint) (models.Webhook, error)
+	CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error)
+	UpdateWebhook(ctx context.Context, id uint, webhook models.Webhook) (models.Webhook, error)
+	DeleteWebhook(ctx context.Context, id uint) error
+	GetDeliveries(ctx context.Context, webhookID uint, p models.Pagination) ([]models.WebhookDelivery, int64, error)
+	GetDeadLetters(ctx context.Context, p models.Pagination) ([]models.WebhookDelivery, int64, error)
+	RetryDelivery(ctx context.Context, id uint) (models.WebhookDelivery, error)
+	EnqueueEvent(ctx context.Context, event models.EmployeeEvent) error
+	DeliverDue(ctx context.Context) (int, error)
+}
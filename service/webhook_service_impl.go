@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo"
+	"github.com/chinmay-sawant/gin-example/webhooks"
+)
+
+const (
+	// DefaultWebhookMaxAttempts is how many times a delivery is tried before it is dead-lettered
+	DefaultWebhookMaxAttempts = 8
+	// DefaultWebhookBaseDelay is the wait before the first retry; each later retry waits twice as long
+	DefaultWebhookBaseDelay = 30 * time.Second
+	// DefaultWebhookMaxDelay caps the wait between retries
+	DefaultWebhookMaxDelay = time.Hour
+	// webhookBatchSize is how many due deliveries one DeliverDue call sends
+	webhookBatchSize = 100
+	// webhookWorkers is how many deliveries are sent at the same time
+	webhookWorkers = 8
+	// webhookSecretBytes is the length of generated secrets before hex encoding
+	webhookSecretBytes = 32
+)
+
+// WebhookServiceImpl implements the WebhookService interface
+type WebhookServiceImpl struct {
+	webhookRepo repo.WebhookRepository
+	sender      webhooks.Sender
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	now         func() time.Time
+}
+
+// WebhookServiceOption configures optional WebhookService behaviour
+type WebhookServiceOption func(*WebhookServiceImpl)
+
+// WithWebhookSender sets how deliveries are sent
+func WithWebhookSender(sender webhooks.Sender) WebhookServiceOption {
+	return func(s *WebhookServiceImpl) {
+		if sender != nil {
+			s.sender = sender
+		}
+	}
+}
+
+// WithRetryPolicy sets how many attempts a delivery gets and the exponential
+// backoff between them. Non-positive values keep the defaults.
+func WithRetryPolicy(maxAttempts int, baseDelay, maxDelay time.Duration) WebhookServiceOption {
+	return func(s *WebhookServiceImpl) {
+		if maxAttempts > 0 {
+			s.maxAttempts = maxAttempts
+		}
+		if baseDelay > 0 {
+			s.baseDelay = baseDelay
+		}
+		if maxDelay > 0 {
+			s.maxDelay = maxDelay
+		}
+	}
+}
+
+// NewWebhookService creates a new instance of WebhookService
+func NewWebhookService(webhookRepo repo.WebhookRepository, opts ...WebhookServiceOption) WebhookService {
+	s := &WebhookServiceImpl{
+		webhookRepo: webhookRepo,
+		sender:      webhooks.NewHTTPSender(nil),
+		maxAttempts: DefaultWebhookMaxAttempts,
+		baseDelay:   DefaultWebhookBaseDelay,
+		maxDelay:    DefaultWebhookMaxDelay,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetAllWebhooks returns every webhook without its secret
+func (s *WebhookServiceImpl) GetAllWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	webhooks, err := s.webhookRepo.FindAll(ctx)
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+	return webhooks, err
+}
+
+// GetWebhookByID returns a webhook by ID without its secret
+func (s *WebhookServiceImpl) GetWebhookByID(ctx context.Context, id uint) (models.Webhook, error) {
+	webhook, err := s.webhookRepo.FindByID(ctx, id)
+	webhook.Secret = ""
+	return webhook, err
+}
+
+// CreateWebhook stores a webhook, generating a secret when none is given. The
+// returned webhook is the only place the secret is shown.
+func (s *WebhookServiceImpl) CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	if err := validateWebhookURL(webhook.URL); err != nil {
+		return models.Webhook{}, err
+	}
+	if webhook.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return models.Webhook{}, err
+		}
+		webhook.Secret = secret
+	}
+	return s.webhookRepo.Create(ctx, webhook)
+}
+
+// UpdateWebhook replaces a webhook's settings, keeping its secret when none is given
+func (s *WebhookServiceImpl) UpdateWebhook(ctx context.Context, id uint, webhook models.Webhook) (models.Webhook, error) {
+	if err := validateWebhookURL(webhook.URL); err != nil {
+		return models.Webhook{}, err
+	}
+	if webhook.Secret == "" {
+		existing, err := s.webhookRepo.FindByID(ctx, id)
+		if err != nil {
+			return models.Webhook{}, err
+		}
+		webhook.Secret = existing.Secret
+	}
+	updated, err := s.webhookRepo.Update(ctx, id, webhook)
+	updated.Secret = ""
+	return updated, err
+}
+
+// DeleteWebhook removes a webhook and its deliveries
+func (s *WebhookServiceImpl) DeleteWebhook(ctx context.Context, id uint) error {
+	return s.webhookRepo.Delete(ctx, id)
+}
+
+// GetDeliveries returns one page of a webhook's delivery log and its total size
+func (s *WebhookServiceImpl) GetDeliveries(ctx context.Context, webhookID uint, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	if _, err := s.webhookRepo.FindByID(ctx, webhookID); err != nil {
+		return nil, 0, err
+	}
+	p.Normalize()
+	return s.webhookRepo.FindDeliveries(ctx, webhookID, p)
+}
+
+// GetDeadLetters returns one page of the deliveries that failed every attempt
+func (s *WebhookServiceImpl) GetDeadLetters(ctx context.Context, p models.Pagination) ([]models.WebhookDelivery, int64, error) {
+	p.Normalize()
+	return s.webhookRepo.FindDeadDeliveries(ctx, p)
+}
+
+// RetryDelivery puts a dead delivery back on the queue with a fresh set of attempts
+func (s *WebhookServiceImpl) RetryDelivery(ctx context.Context, id uint) (models.WebhookDelivery, error) {
+	delivery, err := s.webhookRepo.FindDeliveryByID(ctx, id)
+	if err != nil {
+		return delivery, err
+	}
+	if delivery.Status != models.DeliveryDead {
+		return delivery, fmt.Errorf("%w: delivery %d is %s, only dead deliveries can be retried", models.ErrConflict, id, delivery.Status)
+	}
+	delivery.Status = models.DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = s.now()
+	return delivery, s.webhookRepo.UpdateDelivery(ctx, delivery)
+}
+
+// EnqueueEvent queues a delivery of the event for every enabled webhook
+// subscribed to one of its types. A webhook subscribed to several of the
+// types receives one delivery per type.
+func (s *WebhookServiceImpl) EnqueueEvent(ctx context.Context, event models.EmployeeEvent) error {
+	webhooks, err := s.webhookRepo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	var deliveries []models.WebhookDelivery
+	for _, eventType := range models.WebhookEventTypes(event) {
+		payload, err := json.Marshal(models.WebhookPayload{
+			EventID:    event.ID,
+			Type:       eventType,
+			EmployeeID: event.EmployeeID,
+			Employee:   event.Employee,
+			Previous:   event.Previous,
+			OccurredAt: event.OccurredAt,
+		})
+		if err != nil {
+			return err
+		}
+		for _, webhook := range webhooks {
+			if webhook.Disabled || !webhook.Subscribes(eventType) {
+				continue
+			}
+			deliveries = append(deliveries, models.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				EventID:       event.ID,
+				EventType:     eventType,
+				Payload:       payload,
+				Status:        models.DeliveryPending,
+				NextAttemptAt: s.now(),
+			})
+		}
+	}
+	return s.webhookRepo.CreateDeliveries(ctx, deliveries)
+}
+
+// DeliverDue sends the deliveries whose next attempt is due and records each
+// outcome, returning how many were attempted. Failed deliveries are retried
+// with exponential backoff until they run out of attempts and become dead.
+func (s *WebhookServiceImpl) DeliverDue(ctx context.Context) (int, error) {
+	due, err := s.webhookRepo.FindDueDeliveries(ctx, s.now(), webhookBatchSize)
+	if err != nil || len(due) == 0 {
+		return 0, err
+	}
+	webhooks, err := s.webhookRepo.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	byID := make(map[uint]models.Webhook, len(webhooks))
+	for _, webhook := range webhooks {
+		byID[webhook.ID] = webhook
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		slots    = make(chan struct{}, webhookWorkers)
+	)
+	for _, delivery := range due {
+		webhook, ok := byID[delivery.WebhookID]
+		if !ok || webhook.Disabled {
+			// The webhook was disabled or deleted after its deliveries were found
+			continue
+		}
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(delivery models.WebhookDelivery) {
+			defer func() { <-slots; wg.Done() }()
+			result := s.sender.Send(context.Background(), webhook, delivery)
+			if err := s.webhookRepo.UpdateDelivery(ctx, s.recordAttempt(delivery, result)); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(delivery)
+	}
+	wg.Wait()
+	return len(due), firstErr
+}
+
+// recordAttempt applies the outcome of an attempt to the delivery and
+// schedules the next one
+func (s *WebhookServiceImpl) recordAttempt(delivery models.WebhookDelivery, result webhooks.Result) models.WebhookDelivery {
+	attemptedAt := s.now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &attemptedAt
+	delivery.ResponseStatus = result.Status
+	delivery.LastError = ""
+	switch {
+	case result.Succeeded():
+		delivery.Status = models.DeliverySucceeded
+	case delivery.Attempts >= s.maxAttempts:
+		delivery.Status = models.DeliveryDead
+		delivery.LastError = result.Err.Error()
+	default:
+		delivery.LastError = result.Err.Error()
+		delivery.NextAttemptAt = attemptedAt.Add(s.retryDelay(delivery.Attempts))
+	}
+	return delivery
+}
+
+// retryDelay is the wait after the given number of failed attempts: the base
+// delay doubled for every attempt after the first, up to the maximum
+func (s *WebhookServiceImpl) retryDelay(attempts int) time.Duration {
+	delay := s.baseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= s.maxDelay {
+			return s.maxDelay
+		}
+	}
+	return delay
+}
+
+// validateWebhookURL accepts absolute http and https URLs
+func validateWebhookURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: webhook url must be an absolute http or https URL", models.ErrValidation)
+	}
+	return nil
+}
+
+// generateWebhookSecret returns a random hex secret for signing deliveries
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/chinmay-sawant/gin-example/repo/mocks"
+	"github.com/chinmay-sawant/gin-example/webhooks"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// stubSender answers every attempt with a fixed result and records what it was sent
+type stubSender struct {
+	mu     sync.Mutex
+	result webhooks.Result
+	sent   []models.WebhookDelivery
+}
+
+func (s *stubSender) Send(_ context.Context, _ models.Webhook, delivery models.WebhookDelivery) webhooks.Result {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, delivery)
+	return s.result
+}
+
+type WebhookServiceTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	repo   *mocks.MockWebhookRepository
+	sender *stubSender
+	now    time.Time
+	svc    *WebhookServiceImpl
+}
+
+func (suite *WebhookServiceTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mocks.NewMockWebhookRepository(suite.ctrl)
+	suite.sender = &stubSender{result: webhooks.Result{Status: 200}}
+	suite.now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	suite.svc = NewWebhookService(suite.repo, WithWebhookSender(suite.sender),
+		WithRetryPolicy(3, time.Minute, 10*time.Minute)).(*WebhookServiceImpl)
+	suite.svc.now = func() time.Time { return suite.now }
+}
+
+func (suite *WebhookServiceTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func TestWebhookServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookServiceTestSuite))
+}
+
+func (suite *WebhookServiceTestSuite) TestCreateWebhookGeneratesSecret() {
+	suite.repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, webhook models.Webhook) (models.Webhook, error) {
+		webhook.ID = 1
+		return webhook, nil
+	})
+
+	created, err := suite.svc.CreateWebhook(testCtx, models.Webhook{URL: "https://example.com/hook", EventTypes: []models.EmployeeEventType{models.EventEmployeeCreated}})
+	suite.NoError(err)
+	suite.Len(created.Secret, 64)
+
+	_, err = suite.svc.CreateWebhook(testCtx, models.Webhook{URL: "ftp://example.com/hook"})
+	suite.ErrorIs(err, models.ErrValidation)
+}
+
+func (suite *WebhookServiceTestSuite) TestUpdateWebhookKeepsSecret() {
+	changes := models.Webhook{URL: "https://example.com/v2", EventTypes: []models.EmployeeEventType{models.EventEmployeeDeleted}}
+	kept := changes
+	kept.Secret = "s3cret"
+	suite.repo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(models.Webhook{ID: 1, Secret: "s3cret"}, nil)
+	suite.repo.EXPECT().Update(gomock.Any(), uint(1), kept).Return(kept, nil)
+
+	updated, err := suite.svc.UpdateWebhook(testCtx, 1, changes)
+	suite.NoError(err)
+	suite.Empty(updated.Secret)
+}
+
+func (suite *WebhookServiceTestSuite) TestEnqueueEventFansOutDerivedTypes() {
+	suite.repo.EXPECT().FindAll(gomock.Any()).Return([]models.Webhook{
+		{ID: 1, EventTypes: []models.EmployeeEventType{models.EventEmployeeUpdated}},
+		{ID: 2, EventTypes: []models.EmployeeEventType{models.EventEmployeeTerminated, models.EventEmployeeSalaryChanged}},
+		{ID: 3, EventTypes: []models.EmployeeEventType{models.EventEmployeeTerminated}, Disabled: true},
+		{ID: 4, EventTypes: []models.EmployeeEventType{models.EventEmployeeCreated}},
+	}, nil)
+	var queued []models.WebhookDelivery
+	suite.repo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, deliveries []models.WebhookDelivery) error {
+		queued = deliveries
+		return nil
+	})
+
+	previous := models.Employee{ID: 5, Status: models.StatusActive, Salary: 100}
+	err := suite.svc.EnqueueEvent(testCtx, models.EmployeeEvent{
+		ID:         9,
+		Type:       models.EventEmployeeUpdated,
+		EmployeeID: 5,
+		Employee:   models.Employee{ID: 5, Status: models.StatusTerminated, Salary: 100},
+		Previous:   &previous,
+	})
+	suite.NoError(err)
+	suite.Require().Len(queued, 2)
+	suite.Equal(uint(1), queued[0].WebhookID)
+	suite.Equal(models.EventEmployeeUpdated, queued[0].EventType)
+	suite.Equal(uint(2), queued[1].WebhookID)
+	suite.Equal(models.EventEmployeeTerminated, queued[1].EventType)
+	suite.Equal(models.DeliveryPending, queued[1].Status)
+	suite.Equal(suite.now, queued[1].NextAttemptAt)
+
+	var payload models.WebhookPayload
+	suite.NoError(json.Unmarshal(queued[1].Payload, &payload))
+	suite.Equal(uint64(9), payload.EventID)
+	suite.Equal(models.EventEmployeeTerminated, payload.Type)
+	suite.Equal(models.StatusActive, payload.Previous.Status)
+}
+
+func (suite *WebhookServiceTestSuite) TestDeliverDueRetriesWithBackoff() {
+	webhook := models.Webhook{ID: 1, URL: "https://example.com/hook", Secret: "s3cret"}
+	delivery := models.WebhookDelivery{ID: 4, WebhookID: 1, Status: models.DeliveryPending, Attempts: 1}
+	suite.sender.result = webhooks.Result{Status: 503, Err: errors.New("receiver answered 503")}
+	suite.repo.EXPECT().FindDueDeliveries(gomock.Any(), suite.now, webhookBatchSize).Return([]models.WebhookDelivery{delivery}, nil)
+	suite.repo.EXPECT().FindAll(gomock.Any()).Return([]models.Webhook{webhook}, nil)
+	var saved models.WebhookDelivery
+	suite.repo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d models.WebhookDelivery) error {
+		saved = d
+		return nil
+	})
+
+	sent, err := suite.svc.DeliverDue(testCtx)
+	suite.NoError(err)
+	suite.Equal(1, sent)
+	suite.Equal(models.DeliveryPending, saved.Status)
+	suite.Equal(2, saved.Attempts)
+	suite.Equal(503, saved.ResponseStatus)
+	suite.Equal("receiver answered 503", saved.LastError)
+	// The second failure waits twice the base delay
+	suite.Equal(suite.now.Add(2*time.Minute), saved.NextAttemptAt)
+}
+
+func (suite *WebhookServiceTestSuite) TestDeliverDueDeadLettersAfterLastAttempt() {
+	suite.sender.result = webhooks.Result{Err: errors.New("connection refused")}
+	suite.repo.EXPECT().FindDueDeliveries(gomock.Any(), suite.now, webhookBatchSize).Return([]models.WebhookDelivery{
+		{ID: 4, WebhookID: 1, Status: models.DeliveryPending, Attempts: 2},
+		{ID: 5, WebhookID: 2, Status: models.DeliveryPending},
+	}, nil)
+	suite.repo.EXPECT().FindAll(gomock.Any()).Return([]models.Webhook{{ID: 1}, {ID: 2, Disabled: true}}, nil)
+	suite.repo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d models.WebhookDelivery) error {
+		suite.Equal(uint(4), d.ID)
+		suite.Equal(models.DeliveryDead, d.Status)
+		suite.Equal(3, d.Attempts)
+		suite.Equal("connection refused", d.LastError)
+		return nil
+	})
+
+	_, err := suite.svc.DeliverDue(testCtx)
+	suite.NoError(err)
+	// Deliveries of disabled webhooks are not attempted
+	suite.Len(suite.sender.sent, 1)
+}
+
+func (suite *WebhookServiceTestSuite) TestRetryDelay() {
+	suite.Equal(time.Minute, suite.svc.retryDelay(1))
+	suite.Equal(2*time.Minute, suite.svc.retryDelay(2))
+	suite.Equal(8*time.Minute, suite.svc.retryDelay(4))
+	suite.Equal(10*time.Minute, suite.svc.retryDelay(5))
+	suite.Equal(10*time.Minute, suite.svc.retryDelay(40))
+}
+
+func (suite *WebhookServiceTestSuite) TestRetryDelivery() {
+	suite.repo.EXPECT().FindDeliveryByID(gomock.Any(), uint(4)).Return(models.WebhookDelivery{ID: 4, Status: models.DeliveryDead, Attempts: 3}, nil)
+	suite.repo.EXPECT().UpdateDelivery(gomock.Any(), models.WebhookDelivery{ID: 4, Status: models.DeliveryPending, NextAttemptAt: suite.now}).Return(nil)
+
+	delivery, err := suite.svc.RetryDelivery(testCtx, 4)
+	suite.NoError(err)
+	suite.Equal(models.DeliveryPending, delivery.Status)
+
+	suite.repo.EXPECT().FindDeliveryByID(gomock.Any(), uint(5)).Return(models.WebhookDelivery{ID: 5, Status: models.DeliverySucceeded}, nil)
+	_, err = suite.svc.RetryDelivery(testCtx, 5)
+	suite.ErrorIs(err, models.ErrConflict)
+}
+
+func (suite *WebhookServiceTestSuite) TestDeliverDueRetriesAgainstReceiver() {
+	var calls int
+	var received []string
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		suite.True(webhooks.Verify("s3cret", r.Header.Get(webhooks.HeaderTimestamp), r.Header.Get(webhooks.HeaderSignature), body))
+		received = append(received, r.Header.Get(webhooks.HeaderDelivery))
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+	svc := NewWebhookService(suite.repo, WithWebhookSender(webhooks.NewHTTPSender(receiver.Client()))).(*WebhookServiceImpl)
+	svc.now = func() time.Time { return suite.now }
+
+	webhook := models.Webhook{ID: 1, URL: receiver.URL, Secret: "s3cret"}
+	delivery := models.WebhookDelivery{ID: 4, WebhookID: 1, Payload: []byte(`{}`), Status: models.DeliveryPending}
+	suite.repo.EXPECT().FindAll(gomock.Any()).Return([]models.Webhook{webhook}, nil).Times(2)
+	gomock.InOrder(
+		suite.repo.EXPECT().FindDueDeliveries(gomock.Any(), gomock.Any(), webhookBatchSize).Return([]models.WebhookDelivery{delivery}, nil),
+		suite.repo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d models.WebhookDelivery) error {
+			suite.Equal(models.DeliveryPending, d.Status)
+			suite.Equal(http.StatusInternalServerError, d.ResponseStatus)
+			delivery = d
+			return nil
+		}),
+		suite.repo.EXPECT().FindDueDeliveries(gomock.Any(), gomock.Any(), webhookBatchSize).DoAndReturn(func(context.Context, time.Time, int) ([]models.WebhookDelivery, error) {
+			return []models.WebhookDelivery{delivery}, nil
+		}),
+		suite.repo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d models.WebhookDelivery) error {
+			suite.Equal(models.DeliverySucceeded, d.Status)
+			suite.Equal(2, d.Attempts)
+			suite.Empty(d.LastError)
+			return nil
+		}),
+	)
+
+	_, err := svc.DeliverDue(testCtx)
+	suite.NoError(err)
+	suite.now = suite.now.Add(DefaultWebhookBaseDelay)
+	_, err = svc.DeliverDue(testCtx)
+	suite.NoError(err)
+	// Every attempt carries the same delivery ID so the receiver can drop duplicates
+	suite.Equal([]string{"4", "4"}, received)
+}
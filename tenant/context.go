@@ -0,0 +1,46 @@
+// Package tenant isolates the data of the tenants, such as subsidiaries,
+// sharing one deployment. Every request runs for one tenant, carried in its
+// context, and the GORM plugin scopes every query on a tenant-scoped table
+// to that tenant.
+package tenant
+
+import (
+	"context"
+	"errors"
+)
+
+// Default is the tenant of requests that name none, and of the data the
+// deployment held before it had tenants
+const Default = "default"
+
+// ErrNoTenant is returned for queries on tenant-scoped tables from a context without a tenant
+var ErrNoTenant = errors.New("tenant: no tenant in context for a tenant-scoped query")
+
+type contextKey struct{}
+
+// system marks contexts of background work that spans every tenant
+type system struct{}
+
+// NewContext returns a context running for the tenant
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant the context runs for
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(contextKey{}).(string)
+	return id, ok && id != ""
+}
+
+// System returns a context for background work across tenants, such as the
+// outbox relay. Queries from it are not scoped, and records it creates must
+// name their tenant.
+func System(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, system{})
+}
+
+// IsSystem reports whether the context was returned by System
+func IsSystem(ctx context.Context) bool {
+	_, ok := ctx.Value(contextKey{}).(system)
+	return ok
+}
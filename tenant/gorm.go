@@ -0,0 +1,163 @@
+package tenant
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// ErrUnscopedSQL is returned for raw SQL on tenant-scoped tables that does not filter on tenant_id
+var ErrUnscopedSQL = errors.New("tenant: raw SQL on a tenant-scoped table must filter on tenant_id")
+
+// Plugin is a GORM plugin scoping tenant-scoped tables, those of models with
+// a TenantID field, to the tenant of the statement's context:
+//
+//   - queries, counts, updates and deletes only see the tenant's rows;
+//   - created records are stamped with the tenant, whatever they held;
+//   - raw SQL naming a tenant-scoped table is rejected unless it filters on
+//     tenant_id itself, because raw SQL cannot be rewritten safely.
+//
+// Statements from a context without a tenant fail with ErrNoTenant, and
+// statements from a System context are not scoped.
+type Plugin struct {
+	models []interface{}
+	tables map[string]bool
+	raw    *regexp.Regexp
+}
+
+// NewPlugin returns the plugin for the tenant-scoped models, whose tables
+// the raw SQL check looks for
+func NewPlugin(models ...interface{}) *Plugin {
+	return &Plugin{models: models, tables: make(map[string]bool)}
+}
+
+func (p *Plugin) Name() string {
+	return "tenant"
+}
+
+func (p *Plugin) Initialize(db *gorm.DB) error {
+	cache := &sync.Map{}
+	var names []string
+	for _, model := range p.models {
+		s, err := schema.Parse(model, cache, db.NamingStrategy)
+		if err != nil {
+			return err
+		}
+		if s.LookUpField("TenantID") == nil {
+			return fmt.Errorf("tenant: %s has no TenantID field", s.Name)
+		}
+		p.tables[s.Table] = true
+		names = append(names, regexp.QuoteMeta(s.Table))
+	}
+	sort.Strings(names)
+	p.raw = regexp.MustCompile(`(?i)\b(` + strings.Join(names, "|") + `)\b`)
+
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Create().Before("gorm:create").Register("tenant:create", p.stamp),
+		callbacks.Query().Before("gorm:query").Register("tenant:query", p.scope),
+		callbacks.Row().Before("gorm:row").Register("tenant:row", p.scope),
+		callbacks.Update().Before("gorm:update").Register("tenant:update", p.scope),
+		callbacks.Delete().Before("gorm:delete").Register("tenant:delete", p.scope),
+		callbacks.Raw().Before("gorm:raw").Register("tenant:raw", p.checkRaw),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scoped reports whether the statement reads or writes a tenant-scoped table
+func (p *Plugin) scoped(stmt *gorm.Statement) bool {
+	if stmt.Schema != nil && stmt.Schema.LookUpField("TenantID") != nil {
+		return true
+	}
+	return p.tables[stmt.Table]
+}
+
+// scope adds the tenant condition to statements on tenant-scoped tables
+func (p *Plugin) scope(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || IsSystem(stmt.Context) {
+		return
+	}
+	if stmt.SQL.Len() > 0 {
+		// Built by Raw, so there is nothing to add the condition to
+		p.checkRaw(db)
+		return
+	}
+	if !p.scoped(stmt) {
+		return
+	}
+	id, ok := FromContext(stmt.Context)
+	if !ok {
+		db.AddError(ErrNoTenant)
+		return
+	}
+	stmt.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "tenant_id"}, Value: id},
+	}})
+}
+
+// stamp sets the tenant of records created in tenant-scoped tables
+func (p *Plugin) stamp(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || stmt.Schema == nil {
+		return
+	}
+	field := stmt.Schema.LookUpField("TenantID")
+	if field == nil {
+		return
+	}
+	id, ok := FromContext(stmt.Context)
+	system := IsSystem(stmt.Context)
+	if !ok && !system {
+		db.AddError(ErrNoTenant)
+		return
+	}
+
+	stampOne := func(record reflect.Value) {
+		if system {
+			// Background work names the tenant of each record it creates
+			if _, zero := field.ValueOf(stmt.Context, record); zero {
+				db.AddError(ErrNoTenant)
+			}
+			return
+		}
+		if err := field.Set(stmt.Context, record, id); err != nil {
+			db.AddError(err)
+		}
+	}
+	switch stmt.ReflectValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < stmt.ReflectValue.Len(); i++ {
+			stampOne(reflect.Indirect(stmt.ReflectValue.Index(i)))
+		}
+	case reflect.Struct:
+		stampOne(stmt.ReflectValue)
+	}
+}
+
+// checkRaw rejects raw SQL naming a tenant-scoped table without filtering on tenant_id
+func (p *Plugin) checkRaw(db *gorm.DB) {
+	stmt := db.Statement
+	if db.Error != nil || IsSystem(stmt.Context) {
+		return
+	}
+	sql := stmt.SQL.String()
+	if strings.Contains(strings.ToLower(sql), "tenant_id") {
+		return
+	}
+	if table := p.raw.FindString(sql); table != "" {
+		db.AddError(fmt.Errorf("%w: %s", ErrUnscopedSQL, table))
+	}
+}
@@ -0,0 +1,102 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type note struct {
+	ID       uint
+	TenantID string
+	Body     string
+}
+
+type PluginTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	acme context.Context
+	beta context.Context
+}
+
+func (suite *PluginTestSuite) SetupTest() {
+	database, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	suite.Require().NoError(err)
+	// Every connection to :memory: opens its own empty database
+	sqlDB, err := database.DB()
+	suite.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+	suite.Require().NoError(database.AutoMigrate(&note{}))
+	suite.Require().NoError(database.Use(NewPlugin(&note{})))
+	suite.db = database
+
+	suite.acme = NewContext(context.Background(), "acme")
+	suite.beta = NewContext(context.Background(), "beta")
+	suite.Require().NoError(suite.db.WithContext(suite.acme).Create(&note{Body: "acme"}).Error)
+	suite.Require().NoError(suite.db.WithContext(suite.beta).Create(&[]note{{Body: "beta"}, {Body: "beta", TenantID: "acme"}}).Error)
+}
+
+func TestPluginTestSuite(t *testing.T) {
+	suite.Run(t, new(PluginTestSuite))
+}
+
+func (suite *PluginTestSuite) TestCreateStampsTenant() {
+	var notes []note
+	suite.NoError(suite.db.WithContext(System(context.Background())).Order("id").Find(&notes).Error)
+	suite.Require().Len(notes, 3)
+	suite.Equal("acme", notes[0].TenantID)
+	// A record naming another tenant is still created for the caller's
+	suite.Equal("beta", notes[1].TenantID)
+	suite.Equal("beta", notes[2].TenantID)
+}
+
+func (suite *PluginTestSuite) TestQueriesOnlySeeTheirTenant() {
+	var notes []note
+	suite.NoError(suite.db.WithContext(suite.acme).Find(&notes).Error)
+	suite.Require().Len(notes, 1)
+	suite.Equal("acme", notes[0].Body)
+
+	var count int64
+	suite.NoError(suite.db.WithContext(suite.beta).Model(&note{}).Count(&count).Error)
+	suite.Equal(int64(2), count)
+
+	// Another tenant's row is not found by its ID either
+	suite.ErrorIs(suite.db.WithContext(suite.beta).First(&note{}, notes[0].ID).Error, gorm.ErrRecordNotFound)
+}
+
+func (suite *PluginTestSuite) TestWritesOnlyReachTheirTenant() {
+	result := suite.db.WithContext(suite.acme).Model(&note{}).Where("1 = 1").Update("body", "changed")
+	suite.NoError(result.Error)
+	suite.Equal(int64(1), result.RowsAffected)
+
+	result = suite.db.WithContext(suite.acme).Where("1 = 1").Delete(&note{})
+	suite.NoError(result.Error)
+	suite.Equal(int64(1), result.RowsAffected)
+
+	var count int64
+	suite.NoError(suite.db.WithContext(suite.beta).Model(&note{}).Where("body = ?", "beta").Count(&count).Error)
+	suite.Equal(int64(2), count)
+}
+
+func (suite *PluginTestSuite) TestRawSQLMustFilterOnTenant() {
+	var bodies []string
+	err := suite.db.WithContext(suite.acme).Raw("SELECT body FROM notes").Scan(&bodies).Error
+	suite.ErrorIs(err, ErrUnscopedSQL)
+	suite.ErrorIs(suite.db.WithContext(suite.acme).Exec("DELETE FROM notes").Error, ErrUnscopedSQL)
+
+	suite.NoError(suite.db.WithContext(suite.acme).Raw("SELECT body FROM notes WHERE tenant_id = ?", "acme").Scan(&bodies).Error)
+	suite.Equal([]string{"acme"}, bodies)
+}
+
+func (suite *PluginTestSuite) TestStatementsNeedATenant() {
+	suite.ErrorIs(suite.db.WithContext(context.Background()).Find(&[]note{}).Error, ErrNoTenant)
+	suite.ErrorIs(suite.db.WithContext(context.Background()).Create(&note{Body: "orphan"}).Error, ErrNoTenant)
+
+	// System contexts see every tenant but must name the tenant of what they create
+	system := System(context.Background())
+	suite.ErrorIs(suite.db.WithContext(system).Create(&note{Body: "orphan"}).Error, ErrNoTenant)
+	suite.NoError(suite.db.WithContext(system).Create(&note{Body: "relayed", TenantID: "beta"}).Error)
+}
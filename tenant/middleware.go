@@ -0,0 +1,110 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+)
+
+// HeaderTenant is the request header naming the tenant a request is for
+const HeaderTenant = "X-Tenant-ID"
+
+// Finder looks a tenant up, failing with models.ErrTenantNotFound for unknown tenants
+type Finder func(ctx context.Context, id string) (models.Tenant, error)
+
+// ErrForeignTenant is returned when a request names another tenant than the one its token is bound to
+var ErrForeignTenant = errors.New("tenant: the token is bound to another tenant")
+
+// ErrUnboundTenant is returned when a caller whose token is not bound to a
+// tenant names one other than the default without being allowed to manage tenants
+var ErrUnboundTenant = errors.New("tenant: only tokens bound to the tenant or allowed to manage tenants may name it")
+
+// Resolve returns the tenant of a request from the tenant the caller's token
+// is bound to and the one the request names, which may be empty. Without
+// either it is the default tenant. Only callers allowed to manage tenants may
+// name another tenant than their token's, and callers whose token is not
+// bound to a tenant may otherwise only name the default one. Unknown tenants
+// fail with models.ErrTenantNotFound.
+func Resolve(ctx context.Context, find Finder, principal auth.Principal, requested string) (string, error) {
+	id := principal.Tenant
+	if requested != "" && requested != principal.Tenant {
+		switch {
+		case principal.Can(auth.PermTenantManage):
+		case principal.Tenant != "":
+			return "", fmt.Errorf("%w %q", ErrForeignTenant, principal.Tenant)
+		case requested != Default:
+			return "", fmt.Errorf("%w %q", ErrUnboundTenant, requested)
+		}
+		id = requested
+	}
+	if id == "" {
+		id = Default
+	}
+	if _, err := find(ctx, id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Middleware resolves the tenant of each request and runs the rest of the
+// chain with it in the request context. The tenant is, in order:
+//
+//   - the tenant claim of the caller's token;
+//   - the X-Tenant-ID header;
+//   - the subdomain of baseDomain the request was sent to, when baseDomain is set;
+//   - the default tenant.
+//
+// A header or subdomain naming another tenant than the token's is rejected
+// with 403 unless the caller may manage tenants, and so is one naming any
+// tenant but the default when the token is not bound to one. Unknown tenants
+// are rejected with 400. It must run after auth.Middleware.
+func Middleware(find Finder, baseDomain string) gin.HandlerFunc {
+	baseDomain = strings.ToLower(strings.TrimPrefix(baseDomain, "."))
+	return func(c *gin.Context) {
+		requested := strings.ToLower(strings.TrimSpace(c.GetHeader(HeaderTenant)))
+		if sub := subdomain(c.Request.Host, baseDomain); sub != "" {
+			if requested != "" && requested != sub {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "The " + HeaderTenant + " header and the subdomain name different tenants"})
+				return
+			}
+			requested = sub
+		}
+
+		id, err := Resolve(c.Request.Context(), find, auth.FromContext(c), requested)
+		switch {
+		case errors.Is(err, ErrForeignTenant), errors.Is(err, ErrUnboundTenant):
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		case errors.Is(err, models.ErrTenantNotFound):
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		case err != nil:
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Request = c.Request.WithContext(NewContext(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+// subdomain returns the single label host has in front of baseDomain, or ""
+func subdomain(host, baseDomain string) string {
+	if baseDomain == "" {
+		return ""
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	label, ok := strings.CutSuffix(strings.ToLower(host), "."+baseDomain)
+	if !ok || label == "" || strings.Contains(label, ".") {
+		return ""
+	}
+	return label
+}
@@ -0,0 +1,124 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chinmay-sawant/gin-example/auth"
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+	r         *gin.Engine
+	principal auth.Principal
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.principal = auth.Principal{Subject: "alice", Roles: []string{auth.RoleHR}}
+	find := func(_ context.Context, id string) (models.Tenant, error) {
+		if id != Default && id != "acme" && id != "beta" {
+			return models.Tenant{}, models.ErrTenantNotFound
+		}
+		return models.Tenant{ID: id}, nil
+	}
+
+	gin.SetMode(gin.TestMode)
+	suite.r = gin.New()
+	suite.r.Use(func(c *gin.Context) {
+		auth.SetPrincipal(c, suite.principal)
+	})
+	suite.r.Use(Middleware(find, "hr.example.com"))
+	suite.r.GET("/tenant", func(c *gin.Context) {
+		id, _ := FromContext(c.Request.Context())
+		c.String(http.StatusOK, id)
+	})
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) request(host, header string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/tenant", nil)
+	req.Host = host
+	if header != "" {
+		req.Header.Set(HeaderTenant, header)
+	}
+	suite.r.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *MiddlewareTestSuite) TestResolvesTenant() {
+	// Only tenant managers may name a tenant their token is not bound to
+	suite.principal = auth.Principal{Subject: "root", Roles: []string{auth.RoleAdmin}}
+	for _, tc := range []struct {
+		name, host, header, want string
+	}{
+		{"default", "localhost:8080", "", Default},
+		{"header", "localhost:8080", "ACME", "acme"},
+		{"subdomain", "beta.hr.example.com:443", "", "beta"},
+		{"header and subdomain agree", "acme.hr.example.com", "acme", "acme"},
+		{"nested subdomain is ignored", "x.acme.hr.example.com", "", Default},
+	} {
+		w := suite.request(tc.host, tc.header)
+		suite.Equal(http.StatusOK, w.Code, tc.name)
+		suite.Equal(tc.want, w.Body.String(), tc.name)
+	}
+}
+
+func (suite *MiddlewareTestSuite) TestTokenClaimBindsTenant() {
+	suite.principal.Tenant = "acme"
+	w := suite.request("localhost", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("acme", w.Body.String())
+
+	w = suite.request("localhost", "acme")
+	suite.Equal(http.StatusOK, w.Code)
+
+	w = suite.request("localhost", "beta")
+	suite.Equal(http.StatusForbidden, w.Code)
+	w = suite.request("beta.hr.example.com", "")
+	suite.Equal(http.StatusForbidden, w.Code)
+}
+
+func (suite *MiddlewareTestSuite) TestTenantManagersMayNameAnotherTenant() {
+	suite.principal = auth.Principal{Subject: "root", Roles: []string{auth.RoleAdmin}, Tenant: "acme"}
+	w := suite.request("localhost", "beta")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("beta", w.Body.String())
+	w = suite.request("beta.hr.example.com", "")
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("beta", w.Body.String())
+}
+
+func (suite *MiddlewareTestSuite) TestUnboundCallersCannotNameTenant() {
+	for _, principal := range []auth.Principal{auth.Anonymous, suite.principal} {
+		suite.principal = principal
+		w := suite.request("localhost", "acme")
+		suite.Equal(http.StatusForbidden, w.Code, principal.Subject)
+		w = suite.request("beta.hr.example.com", "")
+		suite.Equal(http.StatusForbidden, w.Code, principal.Subject)
+
+		// The default tenant is theirs already
+		w = suite.request("localhost", Default)
+		suite.Equal(http.StatusOK, w.Code, principal.Subject)
+		suite.Equal(Default, w.Body.String(), principal.Subject)
+	}
+}
+
+func (suite *MiddlewareTestSuite) TestRejectsUnknownOrConflictingTenant() {
+	suite.principal = auth.Principal{Subject: "root", Roles: []string{auth.RoleAdmin}}
+	w := suite.request("localhost", "globex")
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), "tenant not found")
+
+	w = suite.request("acme.hr.example.com", "beta")
+	suite.Equal(http.StatusBadRequest, w.Code)
+	suite.Contains(w.Body.String(), HeaderTenant)
+}
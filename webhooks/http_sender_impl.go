@@ -0,0 +1,63 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// DefaultTimeout bounds one delivery attempt when the sender is given no client
+const DefaultTimeout = 10 * time.Second
+
+// maxErrorBody is how much of a failed response is kept in the delivery's last error
+const maxErrorBody = 512
+
+type httpSenderImpl struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPSender returns a Sender that POSTs signed JSON with the client, or
+// with a client limited to DefaultTimeout when client is nil
+func NewHTTPSender(client *http.Client) Sender {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+	return &httpSenderImpl{client: client, now: time.Now}
+}
+
+func (s *httpSenderImpl) Send(ctx context.Context, webhook models.Webhook, delivery models.WebhookDelivery) Result {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return Result{Err: err}
+	}
+	sentAt := s.now()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "gin-example-webhooks/1.0")
+	request.Header.Set(HeaderEvent, string(delivery.EventType))
+	request.Header.Set(HeaderDelivery, strconv.FormatUint(uint64(delivery.ID), 10))
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(sentAt.Unix(), 10))
+	request.Header.Set(HeaderSignature, Sign(webhook.Secret, sentAt, delivery.Payload))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return Result{Err: err}
+	}
+	defer response.Body.Close()
+
+	result := Result{Status: response.StatusCode}
+	if !result.Succeeded() {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBody))
+		result.Err = fmt.Errorf("receiver answered %s: %s", response.Status, strings.TrimSpace(string(body)))
+	}
+	// Drain the rest so the connection can be reused
+	io.Copy(io.Discard, response.Body)
+	return result
+}
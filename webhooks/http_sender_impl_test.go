@@ -0,0 +1,96 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/chinmay-sawant/gin-example/models"
+	"github.com/stretchr/testify/suite"
+)
+
+type HTTPSenderTestSuite struct {
+	suite.Suite
+	requests chan *http.Request
+	bodies   chan []byte
+	status   int
+	receiver *httptest.Server
+	sender   Sender
+}
+
+func (suite *HTTPSenderTestSuite) SetupTest() {
+	suite.requests = make(chan *http.Request, 1)
+	suite.bodies = make(chan []byte, 1)
+	suite.status = http.StatusNoContent
+	suite.receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		suite.requests <- r
+		suite.bodies <- body
+		w.WriteHeader(suite.status)
+		io.WriteString(w, "busy")
+	}))
+	suite.sender = NewHTTPSender(suite.receiver.Client())
+}
+
+func (suite *HTTPSenderTestSuite) TearDownTest() {
+	suite.receiver.Close()
+}
+
+func TestHTTPSenderTestSuite(t *testing.T) {
+	suite.Run(t, new(HTTPSenderTestSuite))
+}
+
+func (suite *HTTPSenderTestSuite) delivery() (models.Webhook, models.WebhookDelivery) {
+	webhook := models.Webhook{ID: 1, URL: suite.receiver.URL, Secret: "s3cret"}
+	delivery := models.WebhookDelivery{ID: 42, WebhookID: 1, EventType: models.EventEmployeeCreated, Payload: []byte(`{"event_id":7}`)}
+	return webhook, delivery
+}
+
+func (suite *HTTPSenderTestSuite) TestSendSignsPayload() {
+	webhook, delivery := suite.delivery()
+
+	result := suite.sender.Send(context.Background(), webhook, delivery)
+	suite.True(result.Succeeded())
+	suite.Equal(http.StatusNoContent, result.Status)
+
+	request := <-suite.requests
+	body := <-suite.bodies
+	suite.Equal(http.MethodPost, request.Method)
+	suite.Equal("application/json", request.Header.Get("Content-Type"))
+	suite.Equal("employee.created", request.Header.Get(HeaderEvent))
+	suite.Equal("42", request.Header.Get(HeaderDelivery))
+	suite.JSONEq(`{"event_id":7}`, string(body))
+	suite.True(Verify("s3cret", request.Header.Get(HeaderTimestamp), request.Header.Get(HeaderSignature), body))
+	suite.False(Verify("other", request.Header.Get(HeaderTimestamp), request.Header.Get(HeaderSignature), body))
+	suite.False(Verify("s3cret", request.Header.Get(HeaderTimestamp), request.Header.Get(HeaderSignature), []byte(`{"event_id":8}`)))
+}
+
+func (suite *HTTPSenderTestSuite) TestSendReportsRejectedDelivery() {
+	suite.status = http.StatusServiceUnavailable
+	webhook, delivery := suite.delivery()
+
+	result := suite.sender.Send(context.Background(), webhook, delivery)
+	suite.False(result.Succeeded())
+	suite.Equal(http.StatusServiceUnavailable, result.Status)
+	suite.EqualError(result.Err, "receiver answered 503 Service Unavailable: busy")
+}
+
+func (suite *HTTPSenderTestSuite) TestSendReportsUnreachableReceiver() {
+	webhook, delivery := suite.delivery()
+	suite.receiver.Close()
+
+	result := suite.sender.Send(context.Background(), webhook, delivery)
+	suite.False(result.Succeeded())
+	suite.Zero(result.Status)
+	suite.Error(result.Err)
+}
+
+func (suite *HTTPSenderTestSuite) TestSignIsStable() {
+	at := time.Unix(1700000000, 0)
+	suite.Equal(Sign("key", at, []byte("body")), Sign("key", at, []byte("body")))
+	suite.NotEqual(Sign("key", at, []byte("body")), Sign("key", at.Add(time.Second), []byte("body")))
+	suite.False(Verify("key", "not-a-time", Sign("key", at, []byte("body")), []byte("body")))
+}
@@ -0,0 +1,33 @@
+// Package webhooks signs and sends webhook deliveries over HTTP.
+package webhooks
+
+import (
+	"context"
+
+	"github.com/chinmay-sawant/gin-example/models"
+)
+
+// Headers sent with every delivery attempt
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Result is the outcome of one delivery attempt. Status is zero when no
+// response arrived.
+type Result struct {
+	Status int
+	Err    error
+}
+
+// Succeeded reports whether the receiver accepted the delivery
+func (r Result) Succeeded() bool {
+	return r.Err == nil && r.Status >= 200 && r.Status < 300
+}
+
+// Sender makes one attempt to deliver a payload to a webhook
+type Sender interface {
+	Send(ctx context.Context, webhook models.Webhook, delivery models.WebhookDelivery) Result
+}
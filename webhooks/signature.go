@@ -0,0 +1,34 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const signaturePrefix = "sha256="
+
+// Sign returns the X-Webhook-Signature value for a body sent at the given
+// time: the hex HMAC-SHA256 of "<unix timestamp>.<body>" keyed with the
+// webhook's secret. Signing the timestamp lets receivers reject replays.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature and timestamp headers match the body.
+// Receivers should also reject timestamps too far from their own clock.
+func Verify(secret, timestamp, signature string, body []byte) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	expected := Sign(secret, time.Unix(seconds, 0), body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}